@@ -0,0 +1,61 @@
+// +build acceptance alarming
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/alarming/v2/alarms"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestAlarmsCRUD(t *testing.T) {
+	client, err := clients.NewAlarmingV2Client()
+	th.AssertNoErr(t, err)
+
+	createOpts := alarms.CreateOpts{
+		Name: tools.RandomString("TESTACCT-", 8),
+		Type: alarms.TypeEvent,
+		EventRule: &alarms.EventRule{
+			EventType: "compute.instance.update",
+		},
+	}
+
+	alarm, err := alarms.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer alarms.Delete(client, alarm.AlarmID)
+
+	tools.PrintResource(t, alarm)
+
+	state, err := alarms.SetState(client, alarm.AlarmID, alarms.StateOK).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, alarms.StateOK, state)
+
+	state, err = alarms.GetState(client, alarm.AlarmID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, alarms.StateOK, state)
+
+	enabled := false
+	updateOpts := alarms.UpdateOpts{
+		Name:      createOpts.Name,
+		Type:      alarms.TypeEvent,
+		Enabled:   &enabled,
+		EventRule: createOpts.EventRule,
+	}
+
+	newAlarm, err := alarms.Update(client, alarm.AlarmID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, newAlarm.Enabled)
+
+	allPages, err := alarms.ListHistory(client, alarm.AlarmID, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allChanges, err := alarms.ExtractAlarmChanges(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, change := range allChanges {
+		tools.PrintResource(t, change)
+	}
+}
@@ -0,0 +1,2 @@
+// Package v2 contains acceptance tests for the OpenStack Alarming v2 service.
+package v2
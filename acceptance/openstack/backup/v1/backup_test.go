@@ -0,0 +1,89 @@
+// +build acceptance backup
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/actions"
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/jobs"
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/sessions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestJobsCRUD(t *testing.T) {
+	client, err := clients.NewBackupV1Client()
+	th.AssertNoErr(t, err)
+
+	createOpts := jobs.CreateOpts{
+		Description: tools.RandomString("TESTACC-", 8),
+		JobSchedule: jobs.ScheduleOpts{
+			ScheduleInterval: "1 days",
+		},
+		JobActions: []actions.CreateOpts{
+			{
+				FreezerAction: actions.FreezerActionOpts{
+					Action:       actions.ActionBackup,
+					Mode:         "fs",
+					BackupName:   tools.RandomString("TESTACC-", 8),
+					PathToBackup: "/etc",
+					Container:    tools.RandomString("TESTACC-", 8),
+				},
+			},
+		},
+	}
+
+	jobID, err := jobs.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer jobs.Delete(client, jobID)
+
+	description := tools.RandomString("TESTACC-", 8)
+	updateOpts := jobs.UpdateOpts{
+		Description: &description,
+	}
+
+	_, err = jobs.Update(client, jobID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	job, err := jobs.Get(client, jobID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, job)
+
+	th.AssertEquals(t, job.Description, description)
+}
+
+func TestSessionsCRUD(t *testing.T) {
+	client, err := clients.NewBackupV1Client()
+	th.AssertNoErr(t, err)
+
+	createOpts := sessions.CreateOpts{
+		Description: tools.RandomString("TESTACC-", 8),
+	}
+
+	sessionID, err := sessions.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer sessions.Delete(client, sessionID)
+
+	session, err := sessions.Get(client, sessionID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, session)
+
+	allPages, err := sessions.List(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allSessions, err := sessions.ExtractSessions(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, s := range allSessions {
+		if s.SessionID == sessionID {
+			found = true
+		}
+	}
+
+	th.AssertEquals(t, found, true)
+}
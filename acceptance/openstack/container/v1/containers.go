@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/container/v1/containers"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// RunContainer will create and start a container with a random name.
+// An error will be returned if the container could not be created.
+func RunContainer(t *testing.T, client *gophercloud.ServiceClient) (*containers.Container, error) {
+	name := tools.RandomString("TESTACC-", 8)
+	t.Logf("Attempting to run container %s", name)
+
+	createOpts := containers.CreateOpts{
+		Run:     true,
+		Name:    name,
+		Image:   "cirros",
+		Command: []string{"sleep", "1000000"},
+	}
+
+	container, err := containers.Create(client, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := WaitForContainerStatus(client, container.UUID, "Running"); err != nil {
+		return nil, err
+	}
+
+	t.Logf("Successfully ran container %s", name)
+
+	container, err = containers.Get(client, container.UUID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	th.AssertEquals(t, container.Name, name)
+
+	return container, nil
+}
+
+// DeleteContainer will stop and delete a container. A fatal error will occur
+// if the container could not be deleted. This works best when used as a
+// deferred function.
+func DeleteContainer(t *testing.T, client *gophercloud.ServiceClient, id string) {
+	t.Logf("Attempting to delete container %s", id)
+
+	err := containers.Delete(client, id, containers.DeleteOpts{Stop: true}).ExtractErr()
+	if err != nil {
+		t.Fatalf("Unable to delete container %s: %v", id, err)
+	}
+
+	t.Logf("Deleted container: %s", id)
+}
+
+// WaitForContainerStatus will poll a container's status until it either
+// matches the specified status or the status becomes Error.
+func WaitForContainerStatus(client *gophercloud.ServiceClient, uuid, status string) error {
+	return tools.WaitFor(func() (bool, error) {
+		container, err := containers.Get(client, uuid).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if container.Status == status {
+			return true, nil
+		}
+
+		if container.Status == "Error" {
+			return false, fmt.Errorf("Container in ERROR state")
+		}
+
+		return false, nil
+	})
+}
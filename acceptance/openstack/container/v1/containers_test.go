@@ -0,0 +1,67 @@
+// +build acceptance containers
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/container/v1/containers"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestContainersRunExecKill(t *testing.T) {
+	clients.SkipRelease(t, "stable/mitaka")
+	clients.SkipRelease(t, "stable/newton")
+	clients.SkipRelease(t, "stable/ocata")
+	clients.SkipRelease(t, "stable/pike")
+	clients.SkipRelease(t, "stable/queens")
+
+	client, err := clients.NewContainerV1Client()
+	th.AssertNoErr(t, err)
+
+	client.Microversion = "1.20"
+
+	container, err := RunContainer(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteContainer(t, client, container.UUID)
+
+	tools.PrintResource(t, container)
+
+	allPages, err := containers.List(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allContainers, err := containers.ExtractContainers(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, v := range allContainers {
+		if v.UUID == container.UUID {
+			found = true
+		}
+	}
+
+	th.AssertEquals(t, found, true)
+
+	output, err := containers.Execute(client, container.UUID, containers.ExecuteOpts{
+		Command: "echo hello",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, output.ExitCode, 0)
+
+	tools.PrintResource(t, output)
+
+	logs, err := containers.Logs(client, container.UUID, nil).Extract()
+	th.AssertNoErr(t, err)
+
+	t.Logf("Container logs: %s", logs)
+
+	err = containers.Kill(client, container.UUID, containers.KillOpts{
+		Signal: "SIGKILL",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = WaitForContainerStatus(client, container.UUID, "Stopped")
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,101 @@
+// +build acceptance dataprocessing
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/clusters"
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/clustertemplates"
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/jobexecutions"
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/nodegrouptemplates"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestTemplatesCRUD(t *testing.T) {
+	client, err := clients.NewDataProcessingV1Client()
+	th.AssertNoErr(t, err)
+
+	choices, err := clients.AcceptanceTestChoicesFromEnv()
+	th.AssertNoErr(t, err)
+
+	ngtCreateOpts := nodegrouptemplates.CreateOpts{
+		Name:          tools.RandomString("TESTACC-", 8),
+		PluginName:    "vanilla",
+		HadoopVersion: "2.7.1",
+		NodeProcesses: []string{"datanode", "nodemanager"},
+		FlavorID:      choices.FlavorID,
+	}
+
+	ngt, err := nodegrouptemplates.Create(client, ngtCreateOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer nodegrouptemplates.Delete(client, ngt.ID)
+
+	tools.PrintResource(t, ngt)
+
+	ctCreateOpts := clustertemplates.CreateOpts{
+		Name:          tools.RandomString("TESTACC-", 8),
+		PluginName:    "vanilla",
+		HadoopVersion: "2.7.1",
+		NodeGroups: []clustertemplates.NodeGroupOpts{
+			{
+				Name:                "worker",
+				Count:               1,
+				NodeGroupTemplateID: ngt.ID,
+			},
+		},
+	}
+
+	ct, err := clustertemplates.Create(client, ctCreateOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer clustertemplates.Delete(client, ct.ID)
+
+	tools.PrintResource(t, ct)
+
+	description := tools.RandomString("TESTACC-", 8)
+	ctUpdateOpts := clustertemplates.UpdateOpts{
+		Description: &description,
+	}
+
+	ct, err = clustertemplates.Update(client, ct.ID, ctUpdateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	ct, err = clustertemplates.Get(client, ct.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, ct)
+
+	th.AssertEquals(t, ct.Description, description)
+}
+
+func TestClustersList(t *testing.T) {
+	client, err := clients.NewDataProcessingV1Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := clusters.List(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allClusters, err := clusters.ExtractClusters(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, cluster := range allClusters {
+		tools.PrintResource(t, cluster)
+	}
+}
+
+func TestJobExecutionsList(t *testing.T) {
+	client, err := clients.NewDataProcessingV1Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := jobexecutions.List(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allJobExecutions, err := jobexecutions.ExtractJobExecutions(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, jobExecution := range allJobExecutions {
+		tools.PrintResource(t, jobExecution)
+	}
+}
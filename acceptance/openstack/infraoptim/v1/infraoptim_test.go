@@ -0,0 +1,75 @@
+// +build acceptance infraoptim
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/actionplans"
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/audits"
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/audittemplates"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestAuditTemplatesCRUD(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewInfraOptimV1Client()
+	th.AssertNoErr(t, err)
+
+	createOpts := audittemplates.CreateOpts{
+		Name: tools.RandomString("TESTACC-", 8),
+		Goal: "dummy",
+	}
+
+	auditTemplate, err := audittemplates.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer audittemplates.Delete(client, auditTemplate.UUID)
+
+	tools.PrintResource(t, auditTemplate)
+
+	description := tools.RandomString("TESTACC-", 8)
+	updateOpts := audittemplates.UpdateOpts{
+		audittemplates.UpdateOperation{
+			Op:    audittemplates.ReplaceOp,
+			Path:  "/description",
+			Value: description,
+		},
+	}
+
+	auditTemplate, err = audittemplates.Update(client, auditTemplate.UUID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, auditTemplate)
+
+	th.AssertEquals(t, auditTemplate.Description, description)
+}
+
+func TestAuditsList(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewInfraOptimV1Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := audits.ListDetail(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allAudits, err := audits.ExtractAudits(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, audit := range allAudits {
+		tools.PrintResource(t, audit)
+
+		allPages, err := actionplans.List(client, actionplans.ListOpts{AuditUUID: audit.UUID}).AllPages()
+		th.AssertNoErr(t, err)
+
+		allActionPlans, err := actionplans.ExtractActionPlans(allPages)
+		th.AssertNoErr(t, err)
+
+		for _, actionPlan := range allActionPlans {
+			tools.PrintResource(t, actionPlan)
+		}
+	}
+}
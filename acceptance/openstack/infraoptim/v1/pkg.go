@@ -0,0 +1,3 @@
+// Package v1 contains acceptance tests for the OpenStack Infrastructure
+// Optimization v1 service.
+package v1
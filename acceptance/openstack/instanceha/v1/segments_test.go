@@ -0,0 +1,71 @@
+// +build acceptance instanceha
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/instanceha/v1/hosts"
+	"github.com/gophercloud/gophercloud/openstack/instanceha/v1/notifications"
+	"github.com/gophercloud/gophercloud/openstack/instanceha/v1/segments"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestSegmentsCRUD(t *testing.T) {
+	client, err := clients.NewInstanceHAV1Client()
+	th.AssertNoErr(t, err)
+
+	createOpts := segments.CreateOpts{
+		Name:           tools.RandomString("TESTACC-", 8),
+		RecoveryMethod: segments.RecoveryMethodAuto,
+		ServiceType:    "compute",
+	}
+
+	segment, err := segments.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer segments.Delete(client, segment.UUID)
+
+	tools.PrintResource(t, segment)
+
+	description := tools.RandomString("TESTACC-", 8)
+	updateOpts := segments.UpdateOpts{
+		Description:    &description,
+		RecoveryMethod: segments.RecoveryMethodReservedHost,
+	}
+
+	segment, err = segments.Update(client, segment.UUID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	segment, err = segments.Get(client, segment.UUID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, segment)
+
+	th.AssertEquals(t, segment.Description, description)
+	th.AssertEquals(t, segment.RecoveryMethod, segments.RecoveryMethodReservedHost)
+
+	allPages, err := hosts.List(client, segment.UUID, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allHosts, err := hosts.ExtractHosts(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, len(allHosts), 0)
+}
+
+func TestNotificationsList(t *testing.T) {
+	client, err := clients.NewInstanceHAV1Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := notifications.List(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allNotifications, err := notifications.ExtractNotifications(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, notification := range allNotifications {
+		tools.PrintResource(t, notification)
+	}
+}
@@ -1,4 +1,4 @@
-// +build acceptance containers capsules
+// +build acceptance networking loadbalancer amphorae
 
 package v2
 
@@ -0,0 +1,72 @@
+// +build acceptance networking loadbalancer flavors
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/flavorprofiles"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/flavors"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestFlavorsCRUD(t *testing.T) {
+	clients.RequireAdmin(t)
+	clients.SkipRelease(t, "stable/mitaka")
+	clients.SkipRelease(t, "stable/newton")
+	clients.SkipRelease(t, "stable/ocata")
+	clients.SkipRelease(t, "stable/pike")
+	clients.SkipRelease(t, "stable/queens")
+	clients.SkipRelease(t, "stable/rocky")
+
+	client, err := clients.NewLoadBalancerV2Client()
+	th.AssertNoErr(t, err)
+
+	flavorProfile, err := CreateFlavorProfile(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteFlavorProfile(t, client, flavorProfile)
+
+	tools.PrintResource(t, flavorProfile)
+
+	flavorProfileUpdateOpts := flavorprofiles.UpdateOpts{
+		FlavorData: "{\"loadbalancer_topology\": \"ACTIVE_STANDBY\"}",
+	}
+
+	flavorProfile, err = flavorprofiles.Update(client, flavorProfile.ID, flavorProfileUpdateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, flavorProfileUpdateOpts.FlavorData, flavorProfile.FlavorData)
+
+	flavor, err := CreateFlavor(t, client, flavorProfile)
+	th.AssertNoErr(t, err)
+	defer DeleteFlavor(t, client, flavor)
+
+	tools.PrintResource(t, flavor)
+
+	enabled := false
+	description := ""
+	flavorUpdateOpts := flavors.UpdateOpts{
+		Description: &description,
+		Enabled:     &enabled,
+	}
+
+	_, err = flavors.Update(client, flavor.ID, flavorUpdateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	newFlavor, err := flavors.Get(client, flavor.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, newFlavor)
+
+	th.AssertEquals(t, description, newFlavor.Description)
+	th.AssertEquals(t, enabled, newFlavor.Enabled)
+
+	allPages, err := flavors.List(client, flavors.ListOpts{FlavorProfileID: flavorProfile.ID}).AllPages()
+	th.AssertNoErr(t, err)
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(allFlavors))
+	th.AssertEquals(t, flavor.ID, allFlavors[0].ID)
+}
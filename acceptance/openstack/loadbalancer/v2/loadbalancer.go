@@ -7,6 +7,8 @@ import (
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/flavorprofiles"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
@@ -279,6 +281,88 @@ func CreateL7Rule(t *testing.T, client *gophercloud.ServiceClient, policyID stri
 	return rule, nil
 }
 
+// CreateFlavorProfile will create a flavor profile with a random name. An
+// error will be returned if the flavor profile could not be created.
+func CreateFlavorProfile(t *testing.T, client *gophercloud.ServiceClient) (*flavorprofiles.FlavorProfile, error) {
+	flavorProfileName := tools.RandomString("TESTACCT-", 8)
+
+	t.Logf("Attempting to create flavor profile %s", flavorProfileName)
+
+	createOpts := flavorprofiles.CreateOpts{
+		Name:         flavorProfileName,
+		ProviderName: "amphora",
+		FlavorData:   "{\"loadbalancer_topology\": \"SINGLE\"}",
+	}
+
+	flavorProfile, err := flavorprofiles.Create(client, createOpts).Extract()
+	if err != nil {
+		return flavorProfile, err
+	}
+
+	t.Logf("Successfully created flavor profile %s", flavorProfileName)
+
+	th.AssertEquals(t, flavorProfileName, flavorProfile.Name)
+	th.AssertEquals(t, createOpts.ProviderName, flavorProfile.ProviderName)
+
+	return flavorProfile, nil
+}
+
+// CreateFlavor will create a flavor with a random name using the given flavor
+// profile. An error will be returned if the flavor could not be created.
+func CreateFlavor(t *testing.T, client *gophercloud.ServiceClient, flavorProfile *flavorprofiles.FlavorProfile) (*flavors.Flavor, error) {
+	flavorName := tools.RandomString("TESTACCT-", 8)
+
+	t.Logf("Attempting to create flavor %s", flavorName)
+
+	createOpts := flavors.CreateOpts{
+		Name:            flavorName,
+		Description:     "Flavor for Gophercloud acceptance tests.",
+		FlavorProfileID: flavorProfile.ID,
+	}
+
+	flavor, err := flavors.Create(client, createOpts).Extract()
+	if err != nil {
+		return flavor, err
+	}
+
+	t.Logf("Successfully created flavor %s", flavorName)
+
+	th.AssertEquals(t, flavorName, flavor.Name)
+	th.AssertEquals(t, flavorProfile.ID, flavor.FlavorProfileID)
+
+	return flavor, nil
+}
+
+// DeleteFlavor will delete a specified flavor. A fatal error will occur if
+// the flavor could not be deleted. This works best when used as a deferred
+// function.
+func DeleteFlavor(t *testing.T, client *gophercloud.ServiceClient, flavor *flavors.Flavor) {
+	t.Logf("Attempting to delete flavor %s", flavor.ID)
+
+	if err := flavors.Delete(client, flavor.ID).ExtractErr(); err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			t.Fatalf("Unable to delete flavor %s: %v", flavor.ID, err)
+		}
+	}
+
+	t.Logf("Successfully deleted flavor %s", flavor.ID)
+}
+
+// DeleteFlavorProfile will delete a specified flavor profile. A fatal error
+// will occur if the flavor profile could not be deleted. This works best when
+// used as a deferred function.
+func DeleteFlavorProfile(t *testing.T, client *gophercloud.ServiceClient, flavorProfile *flavorprofiles.FlavorProfile) {
+	t.Logf("Attempting to delete flavor profile %s", flavorProfile.ID)
+
+	if err := flavorprofiles.Delete(client, flavorProfile.ID).ExtractErr(); err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			t.Fatalf("Unable to delete flavor profile %s: %v", flavorProfile.ID, err)
+		}
+	}
+
+	t.Logf("Successfully deleted flavor profile %s", flavorProfile.ID)
+}
+
 // DeleteL7Policy will delete a specified l7 policy. A fatal error will occur if
 // the l7 policy could not be deleted. This works best when used as a deferred
 // function.
@@ -9,6 +9,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/messaging/v2/claims"
 	"github.com/gophercloud/gophercloud/openstack/messaging/v2/messages"
 	"github.com/gophercloud/gophercloud/openstack/messaging/v2/queues"
+	"github.com/gophercloud/gophercloud/openstack/messaging/v2/subscriptions"
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
@@ -145,6 +146,32 @@ func DeleteClaim(t *testing.T, client *gophercloud.ServiceClient, queueName stri
 	return err
 }
 
+func CreateSubscription(t *testing.T, client *gophercloud.ServiceClient, queueName string) (string, error) {
+	createOpts := subscriptions.CreateOpts{
+		Subscriber: "mailto:gophercloud@example.com",
+		TTL:        3600,
+	}
+
+	t.Logf("Attempting to create subscription on queue: %s", queueName)
+	subscriptionID, err := subscriptions.Create(client, queueName, createOpts).Extract()
+	if err != nil {
+		t.Fatalf("Unable to create subscription: %v", err)
+	}
+
+	t.Logf("Created subscription: %s", subscriptionID)
+	return subscriptionID, err
+}
+
+func DeleteSubscription(t *testing.T, client *gophercloud.ServiceClient, queueName string, subscriptionID string) {
+	t.Logf("Attempting to delete subscription: %s", subscriptionID)
+	err := subscriptions.Delete(client, queueName, subscriptionID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Unable to delete subscription %s: %v", subscriptionID, err)
+	}
+
+	t.Logf("Deleted subscription: %s", subscriptionID)
+}
+
 func ExtractIDs(claim []claims.Messages) ([]string, []string) {
 	var claimIDs []string
 	var messageID []string
@@ -0,0 +1,61 @@
+// +build acceptance messaging subscriptions
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/messaging/v2/subscriptions"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestCRUDSubscription(t *testing.T) {
+	clientID := "3381af92-2b9e-11e3-b191-71861300734c"
+
+	client, err := clients.NewMessagingV2Client(clientID)
+	if err != nil {
+		t.Fatalf("Unable to create a messaging service client: %v", err)
+	}
+
+	createdQueueName, err := CreateQueue(t, client)
+	defer DeleteQueue(t, client, createdQueueName)
+
+	subscriptionID, err := CreateSubscription(t, client, createdQueueName)
+	th.AssertNoErr(t, err)
+	defer DeleteSubscription(t, client, createdQueueName, subscriptionID)
+
+	updateOpts := subscriptions.UpdateOpts{
+		TTL: 7200,
+	}
+
+	t.Logf("Attempting to update subscription: %s", subscriptionID)
+	err = subscriptions.Update(client, createdQueueName, subscriptionID, updateOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	subscription, err := subscriptions.Get(client, createdQueueName, subscriptionID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, subscription)
+	th.AssertEquals(t, 7200, subscription.TTL)
+
+	found := false
+	err = subscriptions.List(client, createdQueueName, nil).EachPage(func(page pagination.Page) (bool, error) {
+		allSubscriptions, err := subscriptions.ExtractSubscriptions(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, s := range allSubscriptions {
+			if s.ID == subscriptionID {
+				found = true
+			}
+		}
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, found)
+}
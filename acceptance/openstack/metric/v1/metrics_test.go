@@ -0,0 +1,58 @@
+// +build acceptance metric
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/metric/v1/measures"
+	"github.com/gophercloud/gophercloud/openstack/metric/v1/metrics"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestMetricsCRUD(t *testing.T) {
+	client, err := clients.NewMetricV1Client()
+	th.AssertNoErr(t, err)
+
+	createOpts := metrics.CreateOpts{
+		ArchivePolicyName: "low",
+		Name:              tools.RandomString("TESTACCT-", 8),
+		Unit:              "B",
+	}
+
+	metric, err := metrics.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer metrics.Delete(client, metric.ID)
+
+	tools.PrintResource(t, metric)
+
+	timestamp := time.Now().UTC().Truncate(time.Minute)
+	measuresOpts := measures.CreateOpts{
+		Measures: []measures.MeasureOpts{
+			{
+				Timestamp: &timestamp,
+				Value:     42,
+			},
+		},
+	}
+
+	err = measures.Create(client, metric.ID, measuresOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	allPages, err := measures.List(client, metric.ID, measures.ListOpts{Refresh: true}).AllPages()
+	th.AssertNoErr(t, err)
+
+	allMeasures, err := measures.ExtractMeasures(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, m := range allMeasures {
+		tools.PrintResource(t, m)
+	}
+
+	newMetric, err := metrics.Get(client, metric.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, createOpts.Name, newMetric.Name)
+}
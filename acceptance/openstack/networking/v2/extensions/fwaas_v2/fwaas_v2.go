@@ -7,6 +7,8 @@ import (
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/rules"
 	th "github.com/gophercloud/gophercloud/testhelper"
 )
@@ -65,3 +67,92 @@ func DeleteRule(t *testing.T, client *gophercloud.ServiceClient, ruleID string)
 
 	t.Logf("Deleted rule: %s", ruleID)
 }
+
+// CreatePolicy will create a Firewall Policy with a random name and given
+// rule. An error will be returned if the policy could not be created.
+func CreatePolicy(t *testing.T, client *gophercloud.ServiceClient, ruleID string) (*policies.Policy, error) {
+	policyName := tools.RandomString("TESTACC-", 8)
+	policyDescription := tools.RandomString("TESTACC-DESC-", 8)
+
+	t.Logf("Attempting to create policy %s", policyName)
+
+	createOpts := policies.CreateOpts{
+		Name:        policyName,
+		Description: policyDescription,
+		Rules: []string{
+			ruleID,
+		},
+	}
+
+	policy, err := policies.Create(client, createOpts).Extract()
+	if err != nil {
+		return policy, err
+	}
+
+	t.Logf("Successfully created policy %s", policyName)
+
+	th.AssertEquals(t, policy.Name, policyName)
+	th.AssertEquals(t, policy.Description, policyDescription)
+	th.AssertEquals(t, len(policy.Rules), 1)
+
+	return policy, nil
+}
+
+// DeletePolicy will delete a policy with a specified ID. A fatal error will
+// occur if the delete was not successful. This works best when used as a
+// deferred function.
+func DeletePolicy(t *testing.T, client *gophercloud.ServiceClient, policyID string) {
+	t.Logf("Attempting to delete policy: %s", policyID)
+
+	err := policies.Delete(client, policyID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Unable to delete policy %s: %v", policyID, err)
+	}
+
+	t.Logf("Deleted policy: %s", policyID)
+}
+
+// CreateGroup will create a Firewall Group with a random name and the given
+// ingress and egress policies. An error will be returned if the group could
+// not be created.
+func CreateGroup(t *testing.T, client *gophercloud.ServiceClient, ingressPolicyID, egressPolicyID string) (*groups.Group, error) {
+	groupName := tools.RandomString("TESTACC-", 8)
+	groupDescription := tools.RandomString("TESTACC-DESC-", 8)
+
+	t.Logf("Attempting to create firewall group %s", groupName)
+
+	createOpts := groups.CreateOpts{
+		Name:                    groupName,
+		Description:             groupDescription,
+		IngressFirewallPolicyID: ingressPolicyID,
+		EgressFirewallPolicyID:  egressPolicyID,
+	}
+
+	group, err := groups.Create(client, createOpts).Extract()
+	if err != nil {
+		return group, err
+	}
+
+	t.Logf("Successfully created firewall group %s", groupName)
+
+	th.AssertEquals(t, group.Name, groupName)
+	th.AssertEquals(t, group.Description, groupDescription)
+	th.AssertEquals(t, group.IngressFirewallPolicyID, ingressPolicyID)
+	th.AssertEquals(t, group.EgressFirewallPolicyID, egressPolicyID)
+
+	return group, nil
+}
+
+// DeleteGroup will delete a firewall group with a specified ID. A fatal error
+// will occur if the delete was not successful. This works best when used as a
+// deferred function.
+func DeleteGroup(t *testing.T, client *gophercloud.ServiceClient, groupID string) {
+	t.Logf("Attempting to delete firewall group: %s", groupID)
+
+	err := groups.Delete(client, groupID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Unable to delete firewall group %s: %v", groupID, err)
+	}
+
+	t.Logf("Deleted firewall group: %s", groupID)
+}
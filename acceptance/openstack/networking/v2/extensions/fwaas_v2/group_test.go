@@ -0,0 +1,72 @@
+// +build acceptance networking fwaas_v2
+
+package fwaas_v2
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/groups"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestGroupCRUD(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	rule, err := CreateRule(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteRule(t, client, rule.ID)
+
+	ingressPolicy, err := CreatePolicy(t, client, rule.ID)
+	th.AssertNoErr(t, err)
+	defer DeletePolicy(t, client, ingressPolicy.ID)
+
+	egressPolicy, err := CreatePolicy(t, client, rule.ID)
+	th.AssertNoErr(t, err)
+	defer DeletePolicy(t, client, egressPolicy.ID)
+
+	group, err := CreateGroup(t, client, ingressPolicy.ID, egressPolicy.ID)
+	th.AssertNoErr(t, err)
+	defer DeleteGroup(t, client, group.ID)
+
+	tools.PrintResource(t, group)
+
+	description := "Some group description"
+	updateOpts := groups.UpdateOpts{
+		Description: &description,
+	}
+
+	updatedGroup, err := groups.Update(client, group.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, updatedGroup.Description, description)
+
+	updatedGroup, err = groups.RemoveIngressPolicy(client, group.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, updatedGroup.IngressFirewallPolicyID, "")
+
+	updatedGroup, err = groups.RemoveEgressPolicy(client, group.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, updatedGroup.EgressFirewallPolicyID, "")
+
+	newGroup, err := groups.Get(client, group.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, newGroup)
+
+	allPages, err := groups.List(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allGroups, err := groups.ExtractGroups(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, g := range allGroups {
+		if g.ID == newGroup.ID {
+			found = true
+		}
+	}
+
+	th.AssertEquals(t, found, true)
+}
@@ -0,0 +1,98 @@
+// +build acceptance networking fwaas_v2
+
+package fwaas_v2
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/policies"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestPolicyCRUD(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	rule, err := CreateRule(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteRule(t, client, rule.ID)
+
+	tools.PrintResource(t, rule)
+
+	policy, err := CreatePolicy(t, client, rule.ID)
+	th.AssertNoErr(t, err)
+	defer DeletePolicy(t, client, policy.ID)
+
+	tools.PrintResource(t, policy)
+
+	name := ""
+	description := ""
+	updateOpts := policies.UpdateOpts{
+		Name:        &name,
+		Description: &description,
+	}
+
+	_, err = policies.Update(client, policy.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	newPolicy, err := policies.Get(client, policy.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, newPolicy)
+	th.AssertEquals(t, newPolicy.Name, name)
+	th.AssertEquals(t, newPolicy.Description, description)
+
+	allPages, err := policies.List(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allPolicies, err := policies.ExtractPolicies(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, p := range allPolicies {
+		if p.ID == newPolicy.ID {
+			found = true
+		}
+	}
+
+	th.AssertEquals(t, found, true)
+}
+
+func TestPolicyInsertRemoveRule(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	rule1, err := CreateRule(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteRule(t, client, rule1.ID)
+
+	rule2, err := CreateRule(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteRule(t, client, rule2.ID)
+
+	policy, err := CreatePolicy(t, client, rule1.ID)
+	th.AssertNoErr(t, err)
+	defer DeletePolicy(t, client, policy.ID)
+
+	insertOpts := policies.InsertRuleOpts{
+		ID:           rule2.ID,
+		InsertBefore: rule1.ID,
+	}
+
+	updatedPolicy, err := policies.InsertRule(client, policy.ID, insertOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, updatedPolicy)
+	th.AssertEquals(t, len(updatedPolicy.Rules), 2)
+	th.AssertEquals(t, updatedPolicy.Rules[0], rule2.ID)
+	th.AssertEquals(t, updatedPolicy.Rules[1], rule1.ID)
+
+	updatedPolicy, err = policies.RemoveRule(client, policy.ID, rule1.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, updatedPolicy)
+	th.AssertEquals(t, len(updatedPolicy.Rules), 1)
+	th.AssertEquals(t, updatedPolicy.Rules[0], rule2.ID)
+}
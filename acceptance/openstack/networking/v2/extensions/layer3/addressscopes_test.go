@@ -8,6 +8,7 @@ import (
 	"github.com/gophercloud/gophercloud/acceptance/clients"
 	"github.com/gophercloud/gophercloud/acceptance/tools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/addressscopes"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	th "github.com/gophercloud/gophercloud/testhelper"
 )
 
@@ -51,3 +52,28 @@ func TestAddressScopesCRUD(t *testing.T) {
 
 	th.AssertEquals(t, found, true)
 }
+
+func TestAddressScopesSubnetPoolAssociation(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	// Create an address-scope
+	addressScope, err := CreateAddressScope(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteAddressScope(t, client, addressScope.ID)
+
+	// Create a subnetpool within the address-scope
+	createOpts := subnetpools.CreateOpts{
+		Name:             tools.RandomString("TESTACC-", 8),
+		Prefixes:         []string{"10.0.0.0/8"},
+		DefaultPrefixLen: 24,
+		AddressScopeID:   addressScope.ID,
+	}
+
+	subnetPool, err := subnetpools.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer subnetpools.Delete(client, subnetPool.ID)
+
+	tools.PrintResource(t, subnetPool)
+	th.AssertEquals(t, subnetPool.AddressScopeID, addressScope.ID)
+}
@@ -1,3 +1,5 @@
+// +build acceptance networking layer3 portforwarding
+
 package layer3
 
 import (
@@ -1,3 +1,5 @@
+// +build acceptance networking qos rules
+
 package rules
 
 import (
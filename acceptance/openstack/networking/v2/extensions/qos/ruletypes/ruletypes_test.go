@@ -1,3 +1,5 @@
+// +build acceptance networking qos ruletypes
+
 package ruletypes
 
 import (
@@ -0,0 +1,28 @@
+// +build acceptance placement allocationcandidates
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/placement/v1/allocationcandidates"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestAllocationCandidatesList(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewPlacementV1Client()
+	th.AssertNoErr(t, err)
+
+	client.Microversion = "1.29"
+	candidates, err := allocationcandidates.List(client, allocationcandidates.ListOpts{
+		Resources: "VCPU:1,MEMORY_MB:64",
+		Limit:     5,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, candidates)
+}
@@ -1,3 +1,5 @@
+// +build acceptance placement resourceproviders
+
 package v1
 
 import (
@@ -42,6 +44,47 @@ func TestResourceProviderCreate(t *testing.T) {
 	client.Microversion = "1.20"
 	resourceProvider, err := resourceproviders.Create(client, createOpts).Extract()
 	th.AssertNoErr(t, err)
+	defer resourceproviders.Delete(client, resourceProvider.UUID)
+
+	tools.PrintResource(t, resourceProvider)
+
+	newName := tools.RandomString("TESTACC-", 8)
+	updateOpts := resourceproviders.UpdateOpts{
+		Name: newName,
+	}
+
+	resourceProvider, err = resourceproviders.Update(client, resourceProvider.UUID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	resourceProvider, err = resourceproviders.Get(client, resourceProvider.UUID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, newName, resourceProvider.Name)
 
 	tools.PrintResource(t, resourceProvider)
 }
+
+func TestResourceProviderUsagesInventoriesTraits(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewPlacementV1Client()
+	th.AssertNoErr(t, err)
+
+	client.Microversion = "1.20"
+	resourceProvider, err := resourceproviders.Create(client, resourceproviders.CreateOpts{
+		Name: tools.RandomString("TESTACC-", 8),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer resourceproviders.Delete(client, resourceProvider.UUID)
+
+	usages, err := resourceproviders.GetUsages(client, resourceProvider.UUID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, usages)
+
+	inventories, err := resourceproviders.GetInventories(client, resourceProvider.UUID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, inventories)
+
+	traits, err := resourceproviders.GetTraits(client, resourceProvider.UUID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, traits)
+}
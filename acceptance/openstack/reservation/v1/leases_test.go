@@ -0,0 +1,86 @@
+// +build acceptance reservation
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/reservation/v1/hosts"
+	"github.com/gophercloud/gophercloud/openstack/reservation/v1/leases"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestHostsList(t *testing.T) {
+	client, err := clients.NewReservationV1Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := hosts.List(client).AllPages()
+	th.AssertNoErr(t, err)
+
+	allHosts, err := hosts.ExtractHosts(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, host := range allHosts {
+		tools.PrintResource(t, host)
+	}
+}
+
+func TestLeasesCRUD(t *testing.T) {
+	client, err := clients.NewReservationV1Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := hosts.List(client).AllPages()
+	th.AssertNoErr(t, err)
+
+	allHosts, err := hosts.ExtractHosts(allPages)
+	th.AssertNoErr(t, err)
+
+	if len(allHosts) == 0 {
+		t.Skip("No hosts are available for reservation")
+	}
+
+	start := time.Now().UTC().Add(24 * time.Hour)
+	end := start.Add(time.Hour)
+
+	createOpts := leases.CreateOpts{
+		Name:      tools.RandomString("TESTACC-", 8),
+		StartDate: start.Format(leases.DateFormat),
+		EndDate:   end.Format(leases.DateFormat),
+		Reservations: []leases.ReservationOpts{
+			{
+				ResourceType: leases.ResourceTypeHost,
+				Min:          1,
+				Max:          1,
+			},
+		},
+	}
+
+	lease, err := leases.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer leases.Delete(client, lease.ID)
+
+	tools.PrintResource(t, lease)
+
+	th.AssertEquals(t, lease.Name, createOpts.Name)
+	th.AssertEquals(t, len(lease.Reservations), 1)
+
+	newName := tools.RandomString("TESTACC-", 8)
+	updateOpts := leases.UpdateOpts{
+		Name:       newName,
+		ProlongFor: "1h",
+	}
+
+	lease, err = leases.Update(client, lease.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	lease, err = leases.Get(client, lease.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, lease)
+
+	th.AssertEquals(t, lease.Name, newName)
+	th.AssertEquals(t, lease.EndDate.Equal(end.Add(time.Hour).Truncate(time.Minute)), true)
+}
@@ -0,0 +1,2 @@
+// Package v1 contains acceptance tests for the OpenStack Reservation v1 service.
+package v1
@@ -1,3 +1,5 @@
+// +build acceptance sharedfilesystems
+
 package messages
 
 import (
@@ -1,3 +1,5 @@
+// +build acceptance sharedfilesystems
+
 package v2
 
 import (
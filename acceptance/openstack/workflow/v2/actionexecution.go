@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/workflow/v2/actionexecutions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// CreateActionExecution runs the std.echo action synchronously.
+func CreateActionExecution(t *testing.T, client *gophercloud.ServiceClient) (*actionexecutions.ActionExecution, error) {
+	description := tools.RandomString("action_execution_", 5)
+
+	t.Logf("Attempting to create action execution: %s", description)
+	createOpts := actionexecutions.CreateOpts{
+		Name:        "std.echo",
+		Description: description,
+		Input: map[string]interface{}{
+			"output": "Hello World!",
+		},
+		Params: map[string]interface{}{
+			"save_result": true,
+			"run_sync":    true,
+		},
+	}
+	actionExecution, err := actionexecutions.Create(client, createOpts).Extract()
+	if err != nil {
+		return actionExecution, err
+	}
+
+	t.Logf("Action execution created: %s", description)
+
+	th.AssertEquals(t, actionExecution.Name, "std.echo")
+	th.AssertEquals(t, actionExecution.State, "SUCCESS")
+
+	return actionExecution, nil
+}
+
+// DeleteActionExecution deletes an action execution.
+func DeleteActionExecution(t *testing.T, client *gophercloud.ServiceClient, actionExecution *actionexecutions.ActionExecution) {
+	err := actionexecutions.Delete(client, actionExecution.ID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Unable to delete action execution %s: %v", actionExecution.ID, err)
+	}
+	t.Logf("Deleted action execution: %s", actionExecution.ID)
+}
@@ -0,0 +1,55 @@
+// +build acceptance workflow actionexecutions
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/acceptance/clients"
+	"github.com/gophercloud/gophercloud/acceptance/tools"
+	"github.com/gophercloud/gophercloud/openstack/workflow/v2/actionexecutions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestActionExecutionsCreate(t *testing.T) {
+	client, err := clients.NewWorkflowV2Client()
+	th.AssertNoErr(t, err)
+
+	actionExecution, err := CreateActionExecution(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteActionExecution(t, client, actionExecution)
+
+	tools.PrintResource(t, actionExecution)
+
+	actual, err := actionexecutions.Get(client, actionExecution.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, actionExecution.ID, actual.ID)
+	th.AssertEquals(t, "Hello World!", actual.Output["result"])
+}
+
+func TestActionExecutionsList(t *testing.T) {
+	client, err := clients.NewWorkflowV2Client()
+	th.AssertNoErr(t, err)
+
+	actionExecution, err := CreateActionExecution(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteActionExecution(t, client, actionExecution)
+
+	allPages, err := actionexecutions.List(client, actionexecutions.ListOpts{
+		Name: "std.echo",
+	}).AllPages()
+	th.AssertNoErr(t, err)
+
+	list, err := actionexecutions.ExtractActionExecutions(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, ae := range list {
+		if ae.ID == actionExecution.ID {
+			found = true
+		}
+	}
+	th.AssertEquals(t, true, found)
+
+	tools.PrintResource(t, list)
+}
@@ -1,3 +1,5 @@
+// +build acceptance workflow crontriggers
+
 package v2
 
 import (
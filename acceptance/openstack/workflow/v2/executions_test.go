@@ -1,3 +1,5 @@
+// +build acceptance workflow executions
+
 package v2
 
 import (
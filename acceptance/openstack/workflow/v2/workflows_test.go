@@ -1,3 +1,5 @@
+// +build acceptance workflow workflows
+
 package v2
 
 import (
@@ -0,0 +1,118 @@
+package gophercloud
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BulkOpts configures the execution of RunBulk.
+type BulkOpts struct {
+	// Context cancels the bulk run: items which have not been started when
+	// it is done fail with its error. Defaults to context.Background.
+	Context context.Context
+
+	// Concurrency is the maximum number of items processed at once.
+	// Defaults to 10.
+	Concurrency int
+
+	// Retries is the number of times a failed item is retried. Defaults to
+	// no retries.
+	Retries int
+
+	// RetryInterval is the delay before an item is retried.
+	RetryInterval time.Duration
+
+	// IsRetryable reports whether an error is worth retrying. By default,
+	// all errors are retried.
+	IsRetryable func(error) bool
+
+	// Logger, if set, is told about retried items.
+	Logger Logger
+}
+
+// RunBulk runs op for each of the given IDs, e.g. to delete many servers,
+// using a bounded pool of workers. Failed items are retried according to
+// opts. If any item fails, an ErrBulk listing the failures in the order of
+// ids is returned.
+func RunBulk(opts BulkOpts, ids []string, op func(id string) error) error {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 10
+	}
+	if concurrency > len(ids) {
+		concurrency = len(ids)
+	}
+
+	errs := make([]error, len(ids))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if ctx.Err() != nil {
+					errs[i] = waitForError(ctx)
+					continue
+				}
+				errs[i] = runBulkItem(ctx, opts, ids[i], op)
+			}
+		}()
+	}
+
+feed:
+	for i := range ids {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			for j := i; j < len(ids); j++ {
+				errs[j] = waitForError(ctx)
+			}
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	var failed []BulkItemError
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, BulkItemError{ID: ids[i], Err: err})
+		}
+	}
+	if len(failed) > 0 {
+		return ErrBulk{Total: len(ids), Errors: failed}
+	}
+	return nil
+}
+
+func runBulkItem(ctx context.Context, opts BulkOpts, id string, op func(id string) error) error {
+	for attempt := 0; ; attempt++ {
+		err := op(id)
+		if err == nil {
+			return nil
+		}
+		if attempt >= opts.Retries || (opts.IsRetryable != nil && !opts.IsRetryable(err)) {
+			return err
+		}
+
+		if opts.Logger != nil {
+			opts.Logger.Log(LogWarn, "retrying bulk operation", "id", id, "attempt", attempt+1, "error", err)
+		}
+
+		timer := time.NewTimer(opts.RetryInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package gophercloud
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ResponseCache caches the responses of successful GET requests, e.g. to
+// avoid looking up the same flavors, images or networks over and over again.
+// It is enabled by setting it as the Cache of a ProviderClient:
+//
+//	cache := gophercloud.NewResponseCache(0)
+//	cache.SetTTL("/flavors", 10*time.Minute)
+//	cache.SetTTL("/images", 5*time.Minute)
+//	cache.SetTTL("/networks", time.Minute)
+//	provider.Cache = cache
+//
+// Changes made through the client do not update the cache: call Invalidate
+// after changing a cached resource, or use short TTLs. Responses are cached
+// per token, URL and request headers, so that e.g. different microversions
+// are cached separately, and clients sharing a cache never get responses
+// fetched with another token, e.g. one scoped to another project. As a
+// consequence, cached responses are not reused after re-authentication.
+//
+// Only JSON responses of at most MaxBodySize bytes are cached; other
+// responses, such as image downloads, are streamed as usual. A ResponseCache
+// is safe for concurrent use.
+type ResponseCache struct {
+	// MaxEntries limits the number of cached responses. When it is reached,
+	// the response closest to expiry is evicted. Zero means no limit.
+	MaxEntries int
+
+	// MaxBodySize limits the size of cached response bodies. Larger
+	// responses are not cached. Defaults to DefaultMaxCachedBodySize.
+	MaxBodySize int64
+
+	mut        sync.Mutex
+	defaultTTL time.Duration
+	ttls       map[string]time.Duration
+	entries    map[string]cacheEntry
+}
+
+// DefaultMaxCachedBodySize is the default MaxBodySize of a ResponseCache.
+const DefaultMaxCachedBodySize = 1 << 20
+
+type cacheEntry struct {
+	url        string
+	statusCode int
+	header     http.Header
+	body       []byte
+	expires    time.Time
+}
+
+// NewResponseCache creates a ResponseCache. defaultTTL applies to the URLs
+// which match no path set with SetTTL. If it is zero, only those URLs are
+// cached.
+func NewResponseCache(defaultTTL time.Duration) *ResponseCache {
+	return &ResponseCache{
+		defaultTTL: defaultTTL,
+		ttls:       make(map[string]time.Duration),
+		entries:    make(map[string]cacheEntry),
+	}
+}
+
+// SetTTL sets how long the responses for a collection and its members are
+// cached. path is matched against whole segments at the end of URL paths,
+// allowing for one more segment: "/flavors" matches ".../flavors",
+// ".../flavors/detail" and ".../flavors/{id}", but neither
+// ".../flavors/{id}/os-extra_specs" nor ".../old-flavors". If several paths
+// match a URL, the longest one applies. A TTL of zero disables caching of
+// the matching URLs.
+func (c *ResponseCache) SetTTL(path string, ttl time.Duration) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.ttls[path] = ttl
+}
+
+// Invalidate removes the cached responses of all URLs starting with prefix,
+// e.g. the URL of a resource which was changed. The query string of the
+// cached URLs is ignored, so invalidating a collection URL also invalidates
+// all its filtered lists.
+func (c *ResponseCache) Invalidate(prefix string) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	for key, e := range c.entries {
+		if strings.HasPrefix(strings.SplitN(e.url, "?", 2)[0], prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Purge removes all cached responses.
+func (c *ResponseCache) Purge() {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.entries = make(map[string]cacheEntry)
+}
+
+// Len returns the number of cached responses, including expired ones which
+// have not been evicted yet.
+func (c *ResponseCache) Len() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return len(c.entries)
+}
+
+// ttl returns the TTL of a URL path.
+func (c *ResponseCache) ttl(path string) time.Duration {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	ttl, match := c.defaultTTL, ""
+	for p, t := range c.ttls {
+		if len(p) > len(match) && matchesSegments(path, p) {
+			ttl, match = t, p
+		}
+	}
+	return ttl
+}
+
+// matchesSegments reports whether the segments of rule end path, or end it
+// but for its last segment.
+func matchesSegments(path, rule string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	ruleSegments := strings.Split(strings.Trim(rule, "/"), "/")
+
+	for extra := 0; extra <= 1; extra++ {
+		end := len(segments) - extra
+		if end < len(ruleSegments) {
+			break
+		}
+		match := true
+		for i, s := range ruleSegments {
+			if segments[end-len(ruleSegments)+i] != s {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheKey identifies a GET request by its URL and the headers which may
+// change the response. The token is part of the key, as a hash, since the
+// response depends on the project and roles it is scoped to.
+func cacheKey(req *http.Request) string {
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		switch name {
+		case "X-Auth-Token", "User-Agent":
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString(req.URL.String())
+	for _, name := range names {
+		b.WriteString("\n" + name + ": " + strings.Join(req.Header[name], ", "))
+	}
+	if token := req.Header.Get("X-Auth-Token"); token != "" {
+		sum := sha256.Sum256([]byte(token))
+		b.WriteString("\ntoken: " + hex.EncodeToString(sum[:]))
+	}
+	return b.String()
+}
+
+func (c *ResponseCache) get(key string, req *http.Request) (*http.Response, bool) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.statusCode, http.StatusText(e.statusCode)),
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        cloneHeader(e.header),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}, true
+}
+
+// put caches a response for ttl if it is a JSON document of at most
+// MaxBodySize bytes. It then reads the body of resp and replaces it with an
+// in-memory copy. Other responses are left to be streamed.
+func (c *ResponseCache) put(key string, resp *http.Response, ttl time.Duration) error {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "application/json" {
+		return nil
+	}
+	max := c.MaxBodySize
+	if max <= 0 {
+		max = DefaultMaxCachedBodySize
+	}
+	if resp.ContentLength > max {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, max+1))
+	if err != nil {
+		resp.Body.Close()
+		return err
+	}
+	if int64(len(body)) > max {
+		// Too large to be cached: put back what was read in front of the rest.
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return nil
+	}
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if c.MaxEntries > 0 && len(c.entries) >= c.MaxEntries {
+		if _, ok := c.entries[key]; !ok {
+			c.evict()
+		}
+	}
+
+	c.entries[key] = cacheEntry{
+		url:        resp.Request.URL.String(),
+		statusCode: resp.StatusCode,
+		header:     cloneHeader(resp.Header),
+		body:       body,
+		expires:    time.Now().Add(ttl),
+	}
+	return nil
+}
+
+// evict removes the entry closest to expiry.
+func (c *ResponseCache) evict() {
+	var oldest string
+	var expires time.Time
+	for key, e := range c.entries {
+		if oldest == "" || e.expires.Before(expires) {
+			oldest, expires = key, e.expires
+		}
+	}
+	delete(c.entries, oldest)
+}
@@ -0,0 +1,312 @@
+package gophercloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ExplainDecodeError returns an ErrDecode which tells where in the response
+// body the error err of an extraction into to happened, e.g.
+//
+//	err := r.ExtractIntoSlicePtr(&servers, "servers")
+//	err = r.ExplainDecodeError(err, &servers, "servers")
+//
+// label is the one passed to ExtractIntoStructPtr or ExtractIntoSlicePtr, or
+// empty for ExtractInto. The extraction functions return the error of the JSON
+// decoder as is; ExplainDecodeError is an opt-in for callers which want to
+// report where a response does not match. Errors other than a
+// *json.UnmarshalTypeError, and errors of results with a streamed body, which
+// is not kept after the extraction, are returned unchanged.
+func (r Result) ExplainDecodeError(err error, to interface{}, label string) error {
+	if _, ok := err.(*json.UnmarshalTypeError); !ok {
+		return err
+	}
+	if _, ok := r.Body.(io.Reader); ok {
+		return err
+	}
+
+	body := r.Body
+	if label != "" {
+		m, ok := body.(map[string]interface{})
+		if !ok {
+			return err
+		}
+		body = m[label]
+	}
+	b, e := json.Marshal(body)
+	if e != nil {
+		return err
+	}
+	return explainDecodeError(err, b, to, label)
+}
+
+// explainDecodeError turns an error from decoding the JSON document b into
+// to into an ErrDecode which tells where in the document decoding failed. path
+// is the location of b in the response body, if b is only a part of it. If the
+// location cannot be found, err is returned unchanged.
+func explainDecodeError(err error, b []byte, to interface{}, path string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*json.SyntaxError); ok {
+		return err
+	}
+
+	t := reflect.TypeOf(to)
+	v := reflect.ValueOf(to)
+	for v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Interface && !v.Elem().IsNil() {
+		v = v.Elem().Elem()
+		t = v.Type()
+	}
+	if t == nil || t.Kind() != reflect.Ptr {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var data interface{}
+	if dec.Decode(&data) != nil {
+		return err
+	}
+
+	if e := locateDecodeError(data, t.Elem(), path); e != nil {
+		e.Err = err
+		return *e
+	}
+	return err
+}
+
+// locateDecodeError walks data along the type t and returns an ErrDecode for
+// the innermost value which cannot be decoded into its type.
+func locateDecodeError(data interface{}, t reflect.Type, path string) *ErrDecode {
+	custom := reflect.PtrTo(t).Implements(unmarshalerType) || t == reflect.TypeOf(time.Time{})
+	if custom || isScalarKind(t.Kind()) {
+		if decodes(data, t) {
+			return nil
+		}
+		// Look for a more precise location inside types with an
+		// UnmarshalJSON method, which usually decode into their fields.
+		if custom && t.Kind() == reflect.Struct {
+			if e := locateInStruct(data, t, path); e != nil {
+				return e
+			}
+		}
+		return &ErrDecode{Path: rootPath(path), Expected: describeType(t), Actual: describeValue(data)}
+	}
+
+	if data == nil {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return locateDecodeError(data, t.Elem(), path)
+	case reflect.Interface:
+		return nil
+	case reflect.Struct:
+		if _, ok := data.(map[string]interface{}); !ok {
+			break
+		}
+		return locateInStruct(data, t, path)
+	case reflect.Slice, reflect.Array:
+		items, ok := data.([]interface{})
+		if !ok {
+			if t.Elem().Kind() == reflect.Uint8 {
+				// []byte is decoded from a base64 string.
+				if decodes(data, t) {
+					return nil
+				}
+			}
+			break
+		}
+		for i, item := range items {
+			if e := locateDecodeError(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i)); e != nil {
+				return e
+			}
+		}
+		return nil
+	case reflect.Map:
+		m, ok := data.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if e := locateDecodeError(m[k], t.Elem(), joinPath(path, k)); e != nil {
+				return e
+			}
+		}
+		return nil
+	default:
+		return nil
+	}
+
+	return &ErrDecode{Path: rootPath(path), Expected: describeType(t), Actual: describeValue(data)}
+}
+
+// locateInStruct checks the fields of the struct type t which are present in
+// the JSON object data.
+func locateInStruct(data interface{}, t reflect.Type, path string) *ErrDecode {
+	m, ok := data.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	// Embedded types with an UnmarshalJSON method decode the whole object.
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && reflect.PtrTo(f.Type).Implements(unmarshalerType) {
+			if e := locateDecodeError(data, f.Type, path); e != nil {
+				return e
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fields := jsonFields(t)
+	for _, k := range keys {
+		f, ok := fields[k]
+		if !ok {
+			for name, field := range fields {
+				if strings.EqualFold(name, k) {
+					f, ok = field, true
+					break
+				}
+			}
+		}
+		if !ok {
+			continue
+		}
+		if e := locateDecodeError(m[k], f.Type, joinPath(path, k)); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// jsonFields returns the fields of a struct type by their JSON names,
+// including the fields of embedded structs. Fields with the ",string" option
+// are skipped.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			for k, v := range jsonFields(ft) {
+				if _, ok := fields[k]; !ok {
+					fields[k] = v
+				}
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if len(parts) > 1 && strings.Contains(","+strings.Join(parts[1:], ",")+",", ",string,") {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f
+	}
+	return fields
+}
+
+// decodes reports whether data can be decoded into a new value of type t.
+func decodes(data interface{}, t reflect.Type) bool {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, reflect.New(t).Interface()) == nil
+}
+
+func isScalarKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+func describeType(t reflect.Type) string {
+	if t == reflect.TypeOf(time.Time{}) {
+		return "RFC3339 string"
+	}
+	if reflect.PtrTo(t).Implements(unmarshalerType) || isScalarKind(t.Kind()) {
+		return t.String()
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map:
+		return "object"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	}
+	return t.String()
+}
+
+func describeValue(data interface{}) string {
+	const maxLen = 40
+
+	switch v := data.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return fmt.Sprintf("boolean %t", v)
+	case json.Number:
+		return "number " + v.String()
+	case string:
+		if len(v) > maxLen {
+			v = v[:maxLen] + "..."
+		}
+		return fmt.Sprintf("string %q", v)
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", data)
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func rootPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
@@ -0,0 +1,170 @@
+package gophercloud
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DeprecationNotice holds the deprecation signals of a response: Warning
+// headers (RFC 7234), the Deprecation header (RFC 9745), the Sunset header
+// (RFC 8594) and the links to their documentation.
+type DeprecationNotice struct {
+	// Method is the HTTP method of the request.
+	Method string
+
+	// URL is the URL of the request.
+	URL string
+
+	// Warnings holds the texts of the Warning headers.
+	Warnings []string
+
+	// Deprecated is true if the response has a Deprecation header.
+	Deprecated bool
+
+	// DeprecatedAt is the date of the Deprecation header, if it has one.
+	DeprecatedAt time.Time
+
+	// Sunset is the date of the Sunset header, after which the resource is
+	// expected to become unavailable. It is zero if the header is missing.
+	Sunset time.Time
+
+	// Link is the URL of a Link header with the "deprecation" or "sunset"
+	// relation type, which documents the deprecation.
+	Link string
+}
+
+// ParseDeprecationNotice extracts the deprecation signals from the headers
+// of a response. ok is false if there are none.
+func ParseDeprecationNotice(h http.Header) (notice DeprecationNotice, ok bool) {
+	for _, value := range h[http.CanonicalHeaderKey("Warning")] {
+		notice.Warnings = append(notice.Warnings, parseWarnings(value)...)
+	}
+
+	if value := strings.TrimSpace(h.Get("Deprecation")); value != "" {
+		notice.Deprecated = value != "false"
+		notice.DeprecatedAt = parseDeprecationDate(value)
+	}
+
+	if value := strings.TrimSpace(h.Get("Sunset")); value != "" {
+		notice.Sunset, _ = http.ParseTime(value)
+	}
+
+	for _, value := range h[http.CanonicalHeaderKey("Link")] {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			for _, param := range parts[1:] {
+				param = strings.TrimSpace(param)
+				if !strings.HasPrefix(strings.ToLower(param), "rel=") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(param[len("rel="):], `"`)) {
+					if rel == "deprecation" || (rel == "sunset" && notice.Link == "") {
+						notice.Link = target
+					}
+				}
+			}
+		}
+	}
+
+	ok = len(notice.Warnings) > 0 || notice.Deprecated || !notice.Sunset.IsZero()
+	return notice, ok
+}
+
+// parseDeprecationDate parses the value of a Deprecation header, which is
+// either a structured date such as "@1688169599" or, in older drafts, an
+// HTTP date or "true".
+func parseDeprecationDate(value string) time.Time {
+	if strings.HasPrefix(value, "@") {
+		if seconds, err := strconv.ParseInt(value[1:], 10, 64); err == nil {
+			return time.Unix(seconds, 0).UTC()
+		}
+	}
+	t, _ := http.ParseTime(value)
+	return t
+}
+
+// parseWarnings returns the texts of the warnings of a Warning header value,
+// e.g. `299 - "Deprecated API" "Wed, 21 Oct 2015 07:28:00 GMT"`. A value which
+// does not follow that format is returned as is.
+func parseWarnings(value string) []string {
+	var texts []string
+	rest := strings.TrimSpace(value)
+	for rest != "" {
+		// warn-code and warn-agent
+		fields := strings.SplitN(rest, " ", 3)
+		if len(fields) < 3 || len(fields[0]) != 3 {
+			return append(texts, strings.TrimSpace(rest))
+		}
+		if _, err := strconv.Atoi(fields[0]); err != nil {
+			return append(texts, strings.TrimSpace(rest))
+		}
+
+		text, remainder, ok := readQuoted(strings.TrimSpace(fields[2]))
+		if !ok {
+			return append(texts, strings.TrimSpace(rest))
+		}
+		texts = append(texts, text)
+
+		// optional warn-date
+		remainder = strings.TrimSpace(remainder)
+		if strings.HasPrefix(remainder, `"`) {
+			_, remainder, _ = readQuoted(remainder)
+			remainder = strings.TrimSpace(remainder)
+		}
+		rest = strings.TrimSpace(strings.TrimPrefix(remainder, ","))
+	}
+	return texts
+}
+
+// readQuoted reads a quoted string from the start of s.
+func readQuoted(s string) (text, rest string, ok bool) {
+	if !strings.HasPrefix(s, `"`) {
+		return "", s, false
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			}
+		case '"':
+			return b.String(), s[i+1:], true
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", s, false
+}
+
+// Deprecation returns the deprecation signals of the response headers of
+// the result. It requires the Header of the result to be set, which is the
+// case for results which expose response headers.
+func (r Result) Deprecation() (DeprecationNotice, bool) {
+	return ParseDeprecationNotice(r.Header)
+}
+
+// reportDeprecation passes the deprecation signals of a response, if any, to
+// the DeprecationHandler and Logger of the client.
+func (client *ProviderClient) reportDeprecation(method, url string, h http.Header) {
+	if client.DeprecationHandler == nil && client.Logger == nil {
+		return
+	}
+
+	notice, ok := ParseDeprecationNotice(h)
+	if !ok {
+		return
+	}
+	notice.Method = method
+	notice.URL = url
+
+	client.logEvent(LogWarn, "deprecated API", "method", method, "url", url, "warnings", notice.Warnings, "sunset", notice.Sunset)
+	if client.DeprecationHandler != nil {
+		client.DeprecationHandler(notice)
+	}
+}
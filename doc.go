@@ -103,6 +103,22 @@ intermediary processing on each page, you can use the AllPages method:
 	allPages, err := servers.List(client, nil).AllPages()
 	allServers, err := servers.ExtractServers(allPages)
 
+Logging
+
+Set a Logger on the provider client to see what Gophercloud is doing, e.g.
+which requests it sends, when it re-authenticates and which pages it fetches:
+
+	provider.Logger = gophercloud.NewStdLogger(nil, gophercloud.LogInfo)
+
+With Go 1.21 and later, NewSlogLogger logs through log/slog instead.
+
+Retries
+
+Services may throttle clients with 429 responses. Set Retry on the provider
+client to retry such requests, honoring the Retry-After header:
+
+	provider.Retry = &gophercloud.RetryOpts{MaxRetries: 5}
+
 This top-level package contains utility functions and data types that are used
 throughout the provider and service packages. Of particular note for end users
 are the AuthOptions and EndpointOpts structs.
@@ -0,0 +1,119 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// PlannedRequest is a request which was not sent because its ProviderClient
+// is in dry-run mode.
+type PlannedRequest struct {
+	// Method is the HTTP method of the request, e.g. "POST".
+	Method string
+
+	// URL is the URL of the request.
+	URL string
+
+	// Header holds the headers of the request, without the authentication
+	// token.
+	Header http.Header
+
+	// Body is the rendered JSON body of the request. It is nil for requests
+	// without a body and for requests with a raw body, e.g. object uploads,
+	// which are not read. It may hold credentials, e.g. passwords.
+	Body []byte
+}
+
+// RequestPlan collects the requests of a ProviderClient in dry-run mode, e.g.
+// to show users what a tool would change:
+//
+//	plan := new(gophercloud.RequestPlan)
+//	provider.DryRun = plan.Record
+//
+//	// Create, update and delete resources.
+//
+//	for _, r := range plan.Requests() {
+//		fmt.Printf("%s %s %s\n", r.Method, r.URL, r.Body)
+//	}
+//
+// A RequestPlan is safe for concurrent use.
+type RequestPlan struct {
+	mut      sync.Mutex
+	requests []PlannedRequest
+}
+
+// Record adds a request to the plan.
+func (p *RequestPlan) Record(r PlannedRequest) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	p.requests = append(p.requests, r)
+}
+
+// Requests returns the recorded requests in the order they were made.
+func (p *RequestPlan) Requests() []PlannedRequest {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	return append([]PlannedRequest(nil), p.requests...)
+}
+
+// isMutating reports whether requests with the given method change resources.
+func isMutating(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS":
+		return false
+	}
+	return true
+}
+
+// dryRun hands a request to client.DryRun instead of sending it, and returns
+// a synthetic response with the first expected status code and an empty JSON
+// object as body.
+func (client *ProviderClient) dryRun(req *http.Request, options *RequestOpts, body []byte) (*http.Response, error) {
+	header := cloneHeader(req.Header)
+	header.Del("X-Auth-Token")
+
+	// Bodies may hold credentials, e.g. the password of a new user, so they
+	// are only logged at debug level.
+	client.logEvent(LogInfo, "dry run, request not sent", "method", req.Method, "url", req.URL.String())
+	if body != nil {
+		client.logEvent(LogDebug, "dry run request body", "url", req.URL.String(), "body", string(body))
+	}
+	client.DryRun(PlannedRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: header,
+		Body:   body,
+	})
+
+	code := http.StatusOK
+	okc := options.OkCodes
+	if okc == nil {
+		okc = defaultOkCodes(req.Method)
+	}
+	if len(okc) > 0 {
+		code = okc[0]
+	}
+
+	const synthetic = "{}"
+	if options.JSONResponse != nil {
+		if err := json.Unmarshal([]byte(synthetic), options.JSONResponse); err != nil {
+			return nil, err
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{applicationJSON}},
+		Body:          ioutil.NopCloser(strings.NewReader(synthetic)),
+		ContentLength: int64(len(synthetic)),
+		Request:       req,
+	}, nil
+}
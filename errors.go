@@ -197,6 +197,20 @@ func (e ErrDefault503) Error() string {
 		" overloading or maintenance. This is a temporary condition. Try again later."
 }
 
+// Unwrap returns the ErrUnexpectedResponseCode of the error, so that
+// errors.As can retrieve the details of the response from any ErrDefault*
+// type.
+func (e ErrDefault400) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault401) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault403) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault404) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault405) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault408) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault409) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault429) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault500) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault503) Unwrap() error { return e.ErrUnexpectedResponseCode }
+
 // Err400er is the interface resource error types implement to override the error message
 // from a 400 error.
 type Err400er interface {
@@ -278,6 +292,11 @@ func (e ErrUnableToReauthenticate) Error() string {
 	return e.choseErrString()
 }
 
+// Unwrap returns the error of the re-authentication.
+func (e ErrUnableToReauthenticate) Unwrap() error {
+	return e.ErrOriginal
+}
+
 // ErrErrorAfterReauthentication is the error type returned when reauthentication
 // succeeds, but an error occurs afterword (usually an HTTP error).
 type ErrErrorAfterReauthentication struct {
@@ -290,6 +309,11 @@ func (e ErrErrorAfterReauthentication) Error() string {
 	return e.choseErrString()
 }
 
+// Unwrap returns the error of the request after the re-authentication.
+func (e ErrErrorAfterReauthentication) Unwrap() error {
+	return e.ErrOriginal
+}
+
 // ErrServiceNotFound is returned when no service in a service catalog matches
 // the provided EndpointOpts. This is generally returned by provider service
 // factory methods like "NewComputeV2()" and can mean that a service is not
@@ -355,6 +379,71 @@ func (e ErrUnexpectedType) Error() string {
 	return e.choseErrString()
 }
 
+// ErrUnknownField is the error passed to Result.UnknownFieldHandler when a
+// response body contains a field which the type it is extracted into does not
+// know.
+type ErrUnknownField struct {
+	BaseError
+	Field string
+}
+
+func (e ErrUnknownField) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Unknown field [%s] in response body", e.Field)
+	return e.choseErrString()
+}
+
+// ErrDecode is returned by Result.ExplainDecodeError when a value of the
+// response body does not match the type it is extracted into.
+type ErrDecode struct {
+	BaseError
+
+	// Path is the location of the value in the response body, e.g.
+	// "servers[3].created".
+	Path string
+
+	// Expected describes the Go type the value is decoded into.
+	Expected string
+
+	// Actual describes the value.
+	Actual string
+
+	// Err is the error returned by the JSON decoder, e.g. a
+	// *json.UnmarshalTypeError. Use errors.As to check for it.
+	Err error
+}
+
+func (e ErrDecode) Error() string {
+	e.DefaultErrString = fmt.Sprintf("%s: expected %s, got %s", e.Path, e.Expected, e.Actual)
+	return e.choseErrString()
+}
+
+// Unwrap returns the error of the JSON decoder.
+func (e ErrDecode) Unwrap() error {
+	return e.Err
+}
+
+// BulkItemError is the error of a single item of a bulk run.
+type BulkItemError struct {
+	ID  string
+	Err error
+}
+
+// ErrBulk is the error returned by RunBulk when some of its items failed.
+type ErrBulk struct {
+	BaseError
+	Total  int
+	Errors []BulkItemError
+}
+
+func (e ErrBulk) Error() string {
+	failures := make([]string, len(e.Errors))
+	for i, item := range e.Errors {
+		failures[i] = fmt.Sprintf("[%s]: %s", item.ID, item.Err)
+	}
+	e.DefaultErrString = fmt.Sprintf("%d of %d operations failed: %s", len(e.Errors), e.Total, strings.Join(failures, "; "))
+	return e.choseErrString()
+}
+
 func unacceptedAttributeErr(attribute string) string {
 	return fmt.Sprintf("The base Identity V3 API does not accept authentication by %s", attribute)
 }
@@ -0,0 +1,47 @@
+// Command gencrud generates a standard CRUD resource package from a JSON
+// resource description. See package gencrud for the description format.
+//
+// It is meant to be run through go:generate from the directory of the new
+// package:
+//
+//	//go:generate go run github.com/gophercloud/gophercloud/internal/gencrud/cmd/gencrud -spec widget.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gophercloud/gophercloud/internal/gencrud"
+)
+
+func main() {
+	specPath := flag.String("spec", "", "path to the JSON resource description")
+	outDir := flag.String("out", ".", "directory of the generated package")
+	flag.Parse()
+
+	if *specPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*specPath, *outDir); err != nil {
+		fmt.Fprintf(os.Stderr, "gencrud: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, outDir string) error {
+	f, err := os.Open(specPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	spec, err := gencrud.Load(f)
+	if err != nil {
+		return fmt.Errorf("reading %s: %s", specPath, err)
+	}
+
+	return gencrud.Write(spec, outDir)
+}
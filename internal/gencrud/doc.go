@@ -0,0 +1,42 @@
+/*
+Package gencrud generates the boilerplate of a standard CRUD resource package:
+requests.go, results.go, urls.go and doc.go, as well as a testing package with
+fixtures and unit tests for every request.
+
+The generated package follows the layout of the hand-written service
+packages. It provides List, Get, Create, Update and Delete requests for a
+resource whose request and response bodies are wrapped in a single key, which
+is how most OpenStack APIs behave. Packages which deviate from that, e.g. by
+using JSON patch updates or extra actions, are best started from the generated
+code and edited by hand afterwards.
+
+A resource is described in JSON:
+
+	{
+	  "package": "widgets",
+	  "import_path": "github.com/gophercloud/gophercloud/openstack/example/v1/widgets",
+	  "resource": "Widget",
+	  "service": "Example service",
+	  "path": "widgets",
+	  "key": "widget",
+	  "list_style": "linked",
+	  "fields": [
+	    {"name": "ID", "json": "id", "type": "string", "doc": "is the unique ID of the widget."},
+	    {"name": "Name", "json": "name", "type": "string", "create": true, "required": true, "update": true, "filter": true},
+	    {"name": "Size", "json": "size", "type": "int", "create": true},
+	    {"name": "Tags", "json": "tags", "type": "[]string", "create": true, "update": true},
+	    {"name": "CreatedAt", "json": "created_at", "type": "time.Time"}
+	  ]
+	}
+
+The list style is one of "single", "linked" or "marker" and selects the
+pagination page base used by List. Supported field types are string, int,
+bool, []string, map[string]string and time.Time. Fields of type time.Time
+are read-only.
+
+The cmd/gencrud command writes the package to the current directory, so it
+can be run from a go:generate directive:
+
+	//go:generate go run github.com/gophercloud/gophercloud/internal/gencrud/cmd/gencrud -spec widget.json
+*/
+package gencrud
@@ -0,0 +1,478 @@
+package gencrud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// List styles supported by Spec.ListStyle. They map to the pagination page
+// bases used throughout Gophercloud.
+const (
+	// ListStyleSingle lists resources with a pagination.SinglePageBase.
+	ListStyleSingle = "single"
+
+	// ListStyleLinked lists resources with a pagination.LinkedPageBase which
+	// follows the "<collection_key>_links" next link.
+	ListStyleLinked = "linked"
+
+	// ListStyleMarker lists resources with a pagination.MarkerPageBase which
+	// uses the ID of the last resource as the next marker.
+	ListStyleMarker = "marker"
+)
+
+// Spec describes a resource for which a package is generated.
+type Spec struct {
+	// Package is the name of the generated package, e.g. "widgets".
+	Package string `json:"package"`
+
+	// ImportPath is the import path of the generated package. It is used by
+	// the generated tests.
+	ImportPath string `json:"import_path"`
+
+	// Resource is the Go name of the resource, e.g. "Widget".
+	Resource string `json:"resource"`
+
+	// Plural is the Go name of a collection of resources. Defaults to
+	// Resource with an "s" appended.
+	Plural string `json:"plural"`
+
+	// Noun is the human readable name of the resource used in doc comments.
+	// Defaults to Resource in lower case.
+	Noun string `json:"noun"`
+
+	// Service is the human readable name of the service used in the package
+	// documentation, e.g. "Compute service".
+	Service string `json:"service"`
+
+	// Path is the URL path of the collection relative to the service
+	// endpoint, e.g. "widgets".
+	Path string `json:"path"`
+
+	// Key is the JSON key wrapping a single resource, e.g. "widget".
+	Key string `json:"key"`
+
+	// CollectionKey is the JSON key wrapping a list of resources, e.g.
+	// "widgets". Defaults to Path.
+	CollectionKey string `json:"collection_key"`
+
+	// ListStyle is one of "single", "linked" or "marker". Defaults to
+	// "single".
+	ListStyle string `json:"list_style"`
+
+	// CreateCode is the status code returned by a successful Create request.
+	// Defaults to 201.
+	CreateCode int `json:"create_code"`
+
+	// Fields are the attributes of the resource. A string field named "ID"
+	// is required.
+	Fields []Field `json:"fields"`
+}
+
+// Field describes a single attribute of a resource.
+type Field struct {
+	// Name is the Go name of the field, e.g. "Name".
+	Name string `json:"name"`
+
+	// JSON is the JSON key of the field, e.g. "name".
+	JSON string `json:"json"`
+
+	// Type is the Go type of the field. Supported types are string, int,
+	// bool, []string, map[string]string and time.Time. Fields of type
+	// time.Time are read-only.
+	Type string `json:"type"`
+
+	// Doc is the doc comment of the field, without the leading field name,
+	// e.g. "is the name of the widget.".
+	Doc string `json:"doc"`
+
+	// Create marks the field as settable in CreateOpts.
+	Create bool `json:"create"`
+
+	// Required marks the field as required in CreateOpts.
+	Required bool `json:"required"`
+
+	// Update marks the field as settable in UpdateOpts.
+	Update bool `json:"update"`
+
+	// Filter marks the field as a query parameter in ListOpts.
+	Filter bool `json:"filter"`
+}
+
+var (
+	identRe   = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	packageRe = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+	sampleJSON = map[string]string{
+		"int":               `1`,
+		"bool":              `true`,
+		"[]string":          `["%s"]`,
+		"map[string]string": `{"key": "%s"}`,
+		"time.Time":         `"2019-01-02T03:04:05Z"`,
+	}
+
+	sampleGo = map[string]string{
+		"int":               `1`,
+		"bool":              `true`,
+		"[]string":          `[]string{"%s"}`,
+		"map[string]string": `map[string]string{"key": "%s"}`,
+		"time.Time":         `time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)`,
+	}
+
+	filterTypes = map[string]bool{
+		"string": true,
+		"int":    true,
+		"bool":   true,
+	}
+)
+
+// sampleID is the ID of the resource used in the generated fixtures.
+const sampleID = "2b0d6d55-1d6c-4c1a-9a3c-6e1f8fd7d3ab"
+
+// Load reads a JSON encoded Spec.
+func Load(r io.Reader) (*Spec, error) {
+	var s Spec
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Validate checks the Spec and fills in its defaults.
+func (s *Spec) Validate() error {
+	if !packageRe.MatchString(s.Package) {
+		return fmt.Errorf("invalid package name [%s]", s.Package)
+	}
+	if s.ImportPath == "" {
+		return fmt.Errorf("missing import_path")
+	}
+	if !identRe.MatchString(s.Resource) {
+		return fmt.Errorf("invalid resource name [%s]", s.Resource)
+	}
+	if s.Plural == "" {
+		s.Plural = s.Resource + "s"
+	}
+	if !identRe.MatchString(s.Plural) || s.Plural == s.Resource {
+		return fmt.Errorf("invalid plural name [%s]", s.Plural)
+	}
+	if s.Noun == "" {
+		s.Noun = strings.ToLower(s.Resource)
+	}
+	if s.Service == "" {
+		s.Service = "OpenStack service"
+	}
+	if s.Path == "" {
+		return fmt.Errorf("missing path")
+	}
+	if s.Key == "" {
+		return fmt.Errorf("missing key")
+	}
+	if s.CollectionKey == "" {
+		s.CollectionKey = s.Path
+	}
+	switch s.ListStyle {
+	case "":
+		s.ListStyle = ListStyleSingle
+	case ListStyleSingle, ListStyleLinked, ListStyleMarker:
+	default:
+		return fmt.Errorf("invalid list style [%s]", s.ListStyle)
+	}
+	if s.CreateCode == 0 {
+		s.CreateCode = 201
+	}
+
+	seen := make(map[string]bool)
+	hasID := false
+	for i, f := range s.Fields {
+		if !identRe.MatchString(f.Name) {
+			return fmt.Errorf("invalid field name [%s]", f.Name)
+		}
+		if seen[f.Name] {
+			return fmt.Errorf("duplicate field [%s]", f.Name)
+		}
+		seen[f.Name] = true
+		if f.JSON == "" {
+			return fmt.Errorf("missing json key for field [%s]", f.Name)
+		}
+		if _, ok := sampleJSON[f.Type]; !ok && f.Type != "string" {
+			return fmt.Errorf("unsupported type [%s] for field [%s]", f.Type, f.Name)
+		}
+		if f.Required && !f.Create {
+			return fmt.Errorf("field [%s] is required but not settable on create", f.Name)
+		}
+		if f.Type == "time.Time" && (f.Create || f.Update) {
+			// BuildRequestBody cannot serialize times, see the trusts
+			// package for how to format them by hand.
+			return fmt.Errorf("field [%s] of type [time.Time] cannot be set on create or update", f.Name)
+		}
+		if f.Filter && !filterTypes[f.Type] {
+			return fmt.Errorf("field [%s] of type [%s] cannot be a filter", f.Name, f.Type)
+		}
+		if f.Filter && s.Paged() && (f.JSON == "limit" || f.JSON == "marker" || f.Name == "Limit" || f.Name == "Marker") {
+			return fmt.Errorf("filter [%s] clashes with the pagination parameters", f.Name)
+		}
+		if strings.TrimSpace(f.Doc) == "" {
+			s.Fields[i].Doc = fmt.Sprintf("is the %s of the %s.", strings.Replace(f.JSON, "_", " ", -1), s.Noun)
+		}
+		if f.Name == "ID" {
+			if f.Type != "string" {
+				return fmt.Errorf("field [ID] must be a string")
+			}
+			hasID = true
+		}
+	}
+	if !hasID {
+		return fmt.Errorf("missing string field [ID]")
+	}
+
+	return nil
+}
+
+// Generate validates the Spec and renders the package. The returned map is
+// keyed by file paths relative to the package directory.
+func Generate(s *Spec) (map[string][]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte, len(templates))
+	for name, tmpl := range templates {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, s); err != nil {
+			return nil, fmt.Errorf("rendering %s: %s", name, err)
+		}
+
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("formatting %s: %s", name, err)
+		}
+		files[name] = src
+	}
+
+	return files, nil
+}
+
+// Write renders the package and writes its files below dir.
+func Write(s *Spec, dir string) error {
+	files, err := Generate(s)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, files[name], 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The helpers below are used by the templates.
+
+// CreateFields returns the fields settable in CreateOpts.
+func (s *Spec) CreateFields() []Field {
+	var fields []Field
+	for _, f := range s.Fields {
+		if f.Create {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// UpdateFields returns the fields settable in UpdateOpts.
+func (s *Spec) UpdateFields() []Field {
+	var fields []Field
+	for _, f := range s.Fields {
+		if f.Update {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// FilterFields returns the fields used as query parameters in ListOpts.
+func (s *Spec) FilterFields() []Field {
+	var fields []Field
+	for _, f := range s.Fields {
+		if f.Filter {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// Paged reports whether the List request accepts limit and marker.
+func (s *Spec) Paged() bool {
+	return s.ListStyle != ListStyleSingle
+}
+
+// UsesTime reports whether any field is a time.Time.
+func (s *Spec) UsesTime() bool {
+	for _, f := range s.Fields {
+		if f.Type == "time.Time" {
+			return true
+		}
+	}
+	return false
+}
+
+// PluralNoun returns the human readable name of a collection of resources.
+func (s *Spec) PluralNoun() string {
+	return s.Noun + "s"
+}
+
+// Article returns the indefinite article of the Noun.
+func (s *Spec) Article() string {
+	if strings.ContainsAny(s.Noun[:1], "aeiouAEIOU") {
+		return "an"
+	}
+	return "a"
+}
+
+// Var returns a variable name for a single resource.
+func (s *Spec) Var() string {
+	return lowerFirst(s.Resource)
+}
+
+// PluralVar returns a variable name for a collection of resources.
+func (s *Spec) PluralVar() string {
+	return lowerFirst(s.Plural)
+}
+
+// SampleID returns the ID used in the generated fixtures.
+func (s *Spec) SampleID() string {
+	return sampleID
+}
+
+// SampleBody returns the JSON representation of the sample resource.
+func (s *Spec) SampleBody() string {
+	return jsonObject(s.Fields, "  ")
+}
+
+// SampleListBody returns the JSON representation of the sample resource
+// within a list.
+func (s *Spec) SampleListBody() string {
+	return jsonObject(s.Fields, "    ")
+}
+
+// CreateBody returns the JSON body of the sample Create request.
+func (s *Spec) CreateBody() string {
+	return jsonObject(s.CreateFields(), "  ")
+}
+
+// UpdateBody returns the JSON body of the sample Update request.
+func (s *Spec) UpdateBody() string {
+	return jsonObject(s.UpdateFields(), "  ")
+}
+
+// UpdateType returns the type of the field in UpdateOpts.
+func (f Field) UpdateType() string {
+	return "*" + f.Type
+}
+
+// DocLine returns the doc comment of the field.
+func (f Field) DocLine() string {
+	return f.Name + " " + strings.TrimSpace(f.Doc)
+}
+
+// SampleJSON returns the JSON value of the field in the fixtures.
+func (f Field) SampleJSON() string {
+	if f.Name == "ID" {
+		return fmt.Sprintf("%q", sampleID)
+	}
+	if f.Type == "string" {
+		return fmt.Sprintf("%q", f.sampleString())
+	}
+	return strings.Replace(sampleJSON[f.Type], "%s", f.sampleString(), -1)
+}
+
+// SampleGo returns the Go value of the field in the fixtures.
+func (f Field) SampleGo() string {
+	if f.Name == "ID" {
+		return "ResourceID"
+	}
+	if f.Type == "string" {
+		return fmt.Sprintf("%q", f.sampleString())
+	}
+	return strings.Replace(sampleGo[f.Type], "%s", f.sampleString(), -1)
+}
+
+// Var returns the name of the local variable holding the field value in the
+// generated Update test.
+func (f Field) Var() string {
+	return "new" + f.Name
+}
+
+func (f Field) sampleString() string {
+	return strings.Replace(f.JSON, "_", "-", -1) + "-value"
+}
+
+func jsonObject(fields []Field, indent string) string {
+	if len(fields) == 0 {
+		return "{}"
+	}
+
+	lines := make([]string, len(fields))
+	for i, f := range fields {
+		lines[i] = fmt.Sprintf("%s  %q: %s", indent, f.JSON, f.SampleJSON())
+	}
+	return "{\n" + strings.Join(lines, ",\n") + "\n" + indent + "}"
+}
+
+func lowerFirst(s string) string {
+	// Keep leading initialisms readable, e.g. "IPPool" becomes "ipPool".
+	i := 0
+	for i < len(s) && s[i] >= 'A' && s[i] <= 'Z' {
+		i++
+	}
+	switch {
+	case i == 0:
+		return s
+	case i == 1 || i == len(s):
+		return strings.ToLower(s[:i]) + s[i:]
+	default:
+		return strings.ToLower(s[:i-1]) + s[i-1:]
+	}
+}
+
+var templates = map[string]*template.Template{
+	"doc.go":                   mustParse("doc.go", docTemplate),
+	"requests.go":              mustParse("requests.go", requestsTemplate),
+	"results.go":               mustParse("results.go", resultsTemplate),
+	"urls.go":                  mustParse("urls.go", urlsTemplate),
+	"testing/doc.go":           mustParse("testing/doc.go", testingDocTemplate),
+	"testing/fixtures.go":      mustParse("testing/fixtures.go", fixturesTemplate),
+	"testing/requests_test.go": mustParse("testing/requests_test.go", requestsTestTemplate),
+}
+
+func mustParse(name, text string) *template.Template {
+	// Templates use {{bt}} for backticks, since they are raw strings
+	// themselves.
+	funcs := template.FuncMap{
+		"bt": func() string { return "`" },
+	}
+	return template.Must(template.New(name).Funcs(funcs).Parse(text))
+}
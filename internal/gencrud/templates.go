@@ -0,0 +1,560 @@
+package gencrud
+
+const docTemplate = `/*
+Package {{.Package}} manages and retrieves {{.PluralNoun}} of the {{.Service}}.
+
+Example to List {{.Plural}}
+
+	allPages, err := {{.Package}}.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	all{{.Plural}}, err := {{.Package}}.Extract{{.Plural}}(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, {{.Var}} := range all{{.Plural}} {
+		fmt.Printf("%+v\n", {{.Var}})
+	}
+
+Example to Create {{.Article}} {{.Resource}}
+
+	createOpts := {{.Package}}.CreateOpts{
+{{- range .CreateFields}}{{if .Required}}
+		{{.Name}}: {{.SampleGo}},
+{{- end}}{{end}}
+	}
+
+	{{.Var}}, err := {{.Package}}.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete {{.Article}} {{.Resource}}
+
+	err := {{.Package}}.Delete(client, {{.Var}}ID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package {{.Package}}
+`
+
+const requestsTemplate = `package {{.Package}}
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	To{{.Resource}}ListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+{{- range .FilterFields}}
+	// {{.DocLine}}
+	{{.Name}} {{.Type}} {{bt}}q:"{{.JSON}}"{{bt}}
+{{end}}
+{{- if .Paged}}
+	// Limit limits the number of {{.PluralNoun}} to return.
+	Limit int {{bt}}q:"limit"{{bt}}
+
+	// Marker is the ID of the last {{.Noun}} of the previous page.
+	Marker string {{bt}}q:"marker"{{bt}}
+{{- end}}
+}
+
+// To{{.Resource}}ListQuery formats a ListOpts into a query string.
+func (opts ListOpts) To{{.Resource}}ListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// {{.PluralNoun}}.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.To{{.Resource}}ListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+{{- if eq .ListStyle "marker"}}
+		p := {{.Resource}}Page{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+{{- else if eq .ListStyle "linked"}}
+		return {{.Resource}}Page{pagination.LinkedPageBase{PageResult: r}}
+{{- else}}
+		return {{.Resource}}Page{pagination.SinglePageBase(r)}
+{{- end}}
+	})
+}
+
+// Get retrieves a specific {{.Noun}} based on its unique ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	To{{.Resource}}CreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new {{.Noun}}.
+type CreateOpts struct {
+{{- range $i, $f := .CreateFields}}{{if $i}}
+{{end}}
+	// {{.DocLine}}
+	{{.Name}} {{.Type}} {{bt}}json:"{{.JSON}}{{if .Required}}" required:"true"{{else}},omitempty"{{end}}{{bt}}
+{{- end}}
+}
+
+// To{{.Resource}}CreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) To{{.Resource}}CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "{{.Key}}")
+}
+
+// Create requests the creation of a new {{.Noun}}.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.To{{.Resource}}CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{ {{- .CreateCode -}} },
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	To{{.Resource}}UpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of {{.Article}} {{.Noun}} to update.
+// Only the fields which are set are sent.
+type UpdateOpts struct {
+{{- range $i, $f := .UpdateFields}}{{if $i}}
+{{end}}
+	// {{.DocLine}}
+	{{.Name}} {{.UpdateType}} {{bt}}json:"{{.JSON}},omitempty"{{bt}}
+{{- end}}
+}
+
+// To{{.Resource}}UpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) To{{.Resource}}UpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "{{.Key}}")
+}
+
+// Update requests the update of {{.Article}} {{.Noun}}.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.To{{.Resource}}UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete requests the deletion of {{.Article}} {{.Noun}}.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
+`
+
+const resultsTemplate = `package {{.Package}}
+
+import (
+{{- if .UsesTime}}
+	"time"
+{{end}}
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// {{.Resource}} represents {{.Article}} {{.Noun}}.
+type {{.Resource}} struct {
+{{- range $i, $f := .Fields}}{{if $i}}
+{{end}}
+	// {{.DocLine}}
+	{{.Name}} {{.Type}} {{bt}}json:"{{.JSON}}"{{bt}}
+{{- end}}
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as {{.Article}} {{.Resource}}.
+func (r commonResult) Extract() (*{{.Resource}}, error) {
+	var s struct {
+		{{.Resource}} *{{.Resource}} {{bt}}json:"{{.Key}}"{{bt}}
+	}
+	err := r.ExtractInto(&s)
+	return s.{{.Resource}}, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as {{.Article}} {{.Resource}}.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as {{.Article}} {{.Resource}}.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as {{.Article}} {{.Resource}}.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// {{.Resource}}Page is a single page of {{.Resource}} results.
+type {{.Resource}}Page struct {
+{{- if eq .ListStyle "marker"}}
+	pagination.MarkerPageBase
+{{- else if eq .ListStyle "linked"}}
+	pagination.LinkedPageBase
+{{- else}}
+	pagination.SinglePageBase
+{{- end}}
+}
+{{- if eq .ListStyle "linked"}}
+
+// NextPageURL is invoked when a paginated collection of {{.PluralNoun}} has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (r {{.Resource}}Page) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link {{bt}}json:"{{.CollectionKey}}_links"{{bt}}
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// PreviousPageURL is invoked when the pager seeks to traverse back over the
+// previous page of a paginated collection of {{.PluralNoun}}.
+func (r {{.Resource}}Page) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link {{bt}}json:"{{.CollectionKey}}_links"{{bt}}
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+{{- end}}
+{{- if eq .ListStyle "marker"}}
+
+// LastMarker returns the ID of the last {{.Noun}} in a {{.Resource}}Page.
+func (r {{.Resource}}Page) LastMarker() (string, error) {
+	{{.PluralVar}}, err := Extract{{.Plural}}(r)
+	if err != nil {
+		return "", err
+	}
+	if len({{.PluralVar}}) == 0 {
+		return "", nil
+	}
+	return {{.PluralVar}}[len({{.PluralVar}})-1].ID, nil
+}
+{{- end}}
+
+// IsEmpty determines whether or not a {{.Resource}}Page contains any results.
+func (r {{.Resource}}Page) IsEmpty() (bool, error) {
+	{{.PluralVar}}, err := Extract{{.Plural}}(r)
+	return len({{.PluralVar}}) == 0, err
+}
+
+// Extract{{.Plural}} returns a slice of {{.Plural}} contained in a single page
+// of results.
+func Extract{{.Plural}}(r pagination.Page) ([]{{.Resource}}, error) {
+	var s struct {
+		{{.Plural}} []{{.Resource}} {{bt}}json:"{{.CollectionKey}}"{{bt}}
+	}
+	err := (r.({{.Resource}}Page)).ExtractInto(&s)
+	return s.{{.Plural}}, err
+}
+`
+
+const urlsTemplate = `package {{.Package}}
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "{{.Path}}"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+`
+
+const testingDocTemplate = `// {{.Package}} unit tests
+package testing
+`
+
+const fixturesTemplate = `package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+{{- if .UsesTime}}
+	"time"
+{{- end}}
+
+	"{{.ImportPath}}"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ResourceID is the ID of the {{.Noun}} used in the fixtures.
+const ResourceID = "{{.SampleID}}"
+
+// ListResponse is a sample response to a List request.
+const ListResponse = {{bt}}
+{
+  "{{.CollectionKey}}": [
+    {{.SampleListBody}}
+  ]
+}
+{{bt}}
+
+// GetResponse is a sample response to a Get, Create or Update request.
+const GetResponse = {{bt}}
+{
+  "{{.Key}}": {{.SampleBody}}
+}
+{{bt}}
+
+// CreateRequest is a sample request to create {{.Article}} {{.Noun}}.
+const CreateRequest = {{bt}}
+{
+  "{{.Key}}": {{.CreateBody}}
+}
+{{bt}}
+
+// UpdateRequest is a sample request to update {{.Article}} {{.Noun}}.
+const UpdateRequest = {{bt}}
+{
+  "{{.Key}}": {{.UpdateBody}}
+}
+{{bt}}
+
+// First{{.Resource}} is the expected {{.Noun}} in the responses above.
+var First{{.Resource}} = {{.Package}}.{{.Resource}}{
+{{- range .Fields}}
+	{{.Name}}: {{.SampleGo}},
+{{- end}}
+}
+
+// HandleListSuccessfully sets up the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/{{.Path}}", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+{{- if eq .ListStyle "marker"}}
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprint(w, ListResponse)
+		case ResourceID:
+			fmt.Fprint(w, {{bt}}{"{{.CollectionKey}}": []}{{bt}})
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+{{- else}}
+		fmt.Fprint(w, ListResponse)
+{{- end}}
+	})
+}
+
+// HandleGetSuccessfully sets up the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/{{.Path}}/"+ResourceID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully sets up the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/{{.Path}}", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader({{.CreateCode}})
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully sets up the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/{{.Path}}/"+ResourceID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleDeleteSuccessfully sets up the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/{{.Path}}/"+ResourceID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+`
+
+const requestsTestTemplate = `package testing
+
+import (
+	"testing"
+
+	"{{.ImportPath}}"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList{{.Plural}}(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := {{.Package}}.List(fake.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := {{.Package}}.Extract{{.Plural}}(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []{{.Package}}.{{.Resource}}{First{{.Resource}}}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet{{.Resource}}(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := {{.Package}}.Get(fake.ServiceClient(), ResourceID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &First{{.Resource}}, actual)
+}
+
+func TestCreate{{.Resource}}(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := {{.Package}}.CreateOpts{
+{{- range .CreateFields}}
+		{{.Name}}: {{.SampleGo}},
+{{- end}}
+	}
+
+	actual, err := {{.Package}}.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &First{{.Resource}}, actual)
+}
+
+func TestUpdate{{.Resource}}(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+{{range .UpdateFields}}
+	{{.Var}} := {{.SampleGo}}
+{{- end}}
+	updateOpts := {{.Package}}.UpdateOpts{
+{{- range .UpdateFields}}
+		{{.Name}}: &{{.Var}},
+{{- end}}
+	}
+
+	actual, err := {{.Package}}.Update(fake.ServiceClient(), ResourceID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &First{{.Resource}}, actual)
+}
+
+func TestDelete{{.Resource}}(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := {{.Package}}.Delete(fake.ServiceClient(), ResourceID)
+	th.AssertNoErr(t, res.Err)
+}
+`
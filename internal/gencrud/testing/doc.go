@@ -0,0 +1,2 @@
+// gencrud unit tests
+package testing
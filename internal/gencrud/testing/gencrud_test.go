@@ -0,0 +1,127 @@
+package testing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/internal/gencrud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const specJSON = `
+{
+  "package": "widgets",
+  "import_path": "github.com/gophercloud/gophercloud/openstack/example/v1/widgets",
+  "resource": "Widget",
+  "path": "widgets",
+  "key": "widget",
+  "list_style": "marker",
+  "fields": [
+    {"name": "ID", "json": "id", "type": "string"},
+    {"name": "Name", "json": "name", "type": "string", "create": true, "required": true, "update": true, "filter": true},
+    {"name": "Tags", "json": "tags", "type": "[]string", "create": true, "update": true},
+    {"name": "CreatedAt", "json": "created_at", "type": "time.Time"}
+  ]
+}
+`
+
+func loadSpec(t *testing.T) *gencrud.Spec {
+	spec, err := gencrud.Load(strings.NewReader(specJSON))
+	th.AssertNoErr(t, err)
+	return spec
+}
+
+func TestLoadDefaults(t *testing.T) {
+	spec := loadSpec(t)
+	th.AssertNoErr(t, spec.Validate())
+
+	th.CheckEquals(t, "Widgets", spec.Plural)
+	th.CheckEquals(t, "widget", spec.Noun)
+	th.CheckEquals(t, "widgets", spec.CollectionKey)
+	th.CheckEquals(t, 201, spec.CreateCode)
+	th.CheckEquals(t, "is the name of the widget.", spec.Fields[1].Doc)
+}
+
+func TestLoadUnknownField(t *testing.T) {
+	_, err := gencrud.Load(strings.NewReader(`{"package": "widgets", "colour": "blue"}`))
+	if err == nil {
+		t.Fatal("Expected an error for an unknown attribute")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	files, err := gencrud.Generate(loadSpec(t))
+	th.AssertNoErr(t, err)
+
+	for _, name := range []string{
+		"doc.go",
+		"requests.go",
+		"results.go",
+		"urls.go",
+		"testing/doc.go",
+		"testing/fixtures.go",
+		"testing/requests_test.go",
+	} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("Expected %s to be generated", name)
+		}
+	}
+	th.CheckEquals(t, 7, len(files))
+
+	requests := string(files["requests.go"])
+	th.AssertEquals(t, true, strings.Contains(requests, "Name string `json:\"name\" required:\"true\"`"))
+	th.AssertEquals(t, true, strings.Contains(requests, "Tags *[]string `json:\"tags,omitempty\"`"))
+	th.AssertEquals(t, true, strings.Contains(requests, "Marker string `q:\"marker\"`"))
+
+	results := string(files["results.go"])
+	th.AssertEquals(t, true, strings.Contains(results, "pagination.MarkerPageBase"))
+	th.AssertEquals(t, true, strings.Contains(results, "func (r WidgetPage) LastMarker() (string, error)"))
+	th.AssertEquals(t, true, strings.Contains(results, "CreatedAt time.Time `json:\"created_at\"`"))
+}
+
+func TestGenerateListStyles(t *testing.T) {
+	expected := map[string]string{
+		gencrud.ListStyleSingle: "pagination.SinglePageBase",
+		gencrud.ListStyleLinked: "pagination.LinkedPageBase",
+		gencrud.ListStyleMarker: "pagination.MarkerPageBase",
+	}
+
+	for style, base := range expected {
+		spec := loadSpec(t)
+		spec.ListStyle = style
+
+		files, err := gencrud.Generate(spec)
+		th.AssertNoErr(t, err)
+		results := string(files["results.go"])
+		th.AssertEquals(t, true, strings.Contains(results, base))
+
+		linked := style == gencrud.ListStyleLinked
+		th.AssertEquals(t, linked, strings.Contains(results, "func (r WidgetPage) NextPageURL() (string, error)"))
+		th.AssertEquals(t, linked, strings.Contains(results, "func (r WidgetPage) PreviousPageURL() (string, error)"))
+	}
+}
+
+func TestGenerateInvalidSpec(t *testing.T) {
+	invalid := map[string]func(*gencrud.Spec){
+		"bad package":    func(s *gencrud.Spec) { s.Package = "Widgets" },
+		"no path":        func(s *gencrud.Spec) { s.Path = "" },
+		"bad list style": func(s *gencrud.Spec) { s.ListStyle = "offset" },
+		"no ID":          func(s *gencrud.Spec) { s.Fields = s.Fields[1:] },
+		"bad type":       func(s *gencrud.Spec) { s.Fields[2].Type = "[]int" },
+		"time update":    func(s *gencrud.Spec) { s.Fields[3].Update = true },
+		"slice filter":   func(s *gencrud.Spec) { s.Fields[2].Filter = true },
+		"optional only":  func(s *gencrud.Spec) { s.Fields[0].Required = true },
+		"marker filter": func(s *gencrud.Spec) {
+			s.Fields = append(s.Fields, gencrud.Field{Name: "Marker", JSON: "marker", Type: "string", Filter: true})
+		},
+	}
+
+	for name, modify := range invalid {
+		spec := loadSpec(t)
+		modify(spec)
+
+		if _, err := gencrud.Generate(spec); err == nil {
+			t.Errorf("Expected an error for spec with %s", name)
+		}
+	}
+}
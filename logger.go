@@ -0,0 +1,97 @@
+package gophercloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// LogLevel is the severity of a log message.
+type LogLevel int
+
+const (
+	// LogDebug is used for routine events such as fetching a page.
+	LogDebug LogLevel = iota
+
+	// LogInfo is used for notable events such as re-authentication.
+	LogInfo
+
+	// LogWarn is used for failures which are recovered from, such as a
+	// retried operation.
+	LogWarn
+
+	// LogError is used for failures which are returned to the caller.
+	LogError
+)
+
+// String returns the upper case name of the level, e.g. "DEBUG".
+func (l LogLevel) String() string {
+	switch l {
+	case LogDebug:
+		return "DEBUG"
+	case LogInfo:
+		return "INFO"
+	case LogWarn:
+		return "WARN"
+	case LogError:
+		return "ERROR"
+	}
+	return fmt.Sprintf("LEVEL(%d)", int(l))
+}
+
+// Logger receives messages about what Gophercloud is doing, such as
+// re-authenticating, retrying or fetching pages. keysAndValues holds
+// alternating keys and values which describe the event, e.g.
+// "url", "https://...", "status", 401.
+//
+// A Logger can be set on a ProviderClient and in BulkOpts. Use
+// NewStdLogger to log through the log package, NewSlogLogger (Go 1.21 and
+// later) to log through log/slog, or LoggerFunc to forward messages to any
+// other logging library.
+type Logger interface {
+	Log(level LogLevel, msg string, keysAndValues ...interface{})
+}
+
+// LoggerFunc is an adapter to use an ordinary function as a Logger.
+type LoggerFunc func(level LogLevel, msg string, keysAndValues ...interface{})
+
+// Log calls f.
+func (f LoggerFunc) Log(level LogLevel, msg string, keysAndValues ...interface{}) {
+	f(level, msg, keysAndValues...)
+}
+
+type stdLogger struct {
+	logger   *log.Logger
+	minLevel LogLevel
+}
+
+// NewStdLogger returns a Logger which writes messages of at least minLevel
+// to logger, or to the standard logger if logger is nil. Messages are
+// formatted as:
+//
+//	[INFO] re-authenticating url=https://compute.example.com/v2.1/servers
+func NewStdLogger(logger *log.Logger, minLevel LogLevel) Logger {
+	return stdLogger{logger: logger, minLevel: minLevel}
+}
+
+func (l stdLogger) Log(level LogLevel, msg string, keysAndValues ...interface{}) {
+	if level < l.minLevel {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] %s", level, msg)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 < len(keysAndValues) {
+			fmt.Fprintf(&b, " %v=%v", keysAndValues[i], keysAndValues[i+1])
+		} else {
+			fmt.Fprintf(&b, " %v", keysAndValues[i])
+		}
+	}
+
+	if l.logger != nil {
+		l.logger.Print(b.String())
+	} else {
+		log.Print(b.String())
+	}
+}
@@ -0,0 +1,40 @@
+//go:build go1.21
+// +build go1.21
+
+package gophercloud
+
+import (
+	"context"
+	"log/slog"
+)
+
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger returns a Logger which writes messages to logger, or to the
+// default slog.Logger if logger is nil.
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return slogLogger{logger: logger}
+}
+
+func (l slogLogger) Log(level LogLevel, msg string, keysAndValues ...interface{}) {
+	logger := l.logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	var slogLevel slog.Level
+	switch level {
+	case LogDebug:
+		slogLevel = slog.LevelDebug
+	case LogInfo:
+		slogLevel = slog.LevelInfo
+	case LogWarn:
+		slogLevel = slog.LevelWarn
+	default:
+		slogLevel = slog.LevelError
+	}
+
+	logger.Log(context.Background(), slogLevel, msg, keysAndValues...)
+}
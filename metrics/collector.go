@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// CollectorOpts configures a Collector.
+type CollectorOpts struct {
+	// Namespace is the prefix of the metric names. Defaults to "gophercloud".
+	Namespace string
+
+	// ConstLabels are added to every metric, e.g. to tell several clouds
+	// apart.
+	ConstLabels prometheus.Labels
+
+	// Buckets are the upper bounds, in seconds, of the latency histogram
+	// buckets. Defaults to prometheus.DefBuckets.
+	Buckets []float64
+}
+
+// Collector is a prometheus.Collector of request metrics. It implements
+// gophercloud.RequestObserver, so it is attached by setting it as the
+// Observer of a ProviderClient. One Collector may observe several
+// ProviderClients.
+type Collector struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+var labels = []string{"service", "method", "code"}
+
+// NewCollector creates a Collector and registers it with reg.
+func NewCollector(reg prometheus.Registerer, opts CollectorOpts) (*Collector, error) {
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = "gophercloud"
+	}
+
+	buckets := opts.Buckets
+	if buckets == nil {
+		buckets = prometheus.DefBuckets
+	}
+
+	c := &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "requests_total",
+			Help:        "Number of HTTP requests sent to OpenStack services.",
+			ConstLabels: opts.ConstLabels,
+		}, labels),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "request_errors_total",
+			Help:        "Number of HTTP requests to OpenStack services which failed or returned a status code of 400 or above.",
+			ConstLabels: opts.ConstLabels,
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Latency of HTTP requests to OpenStack services.",
+			ConstLabels: opts.ConstLabels,
+			Buckets:     buckets,
+		}, labels),
+	}
+
+	err := reg.Register(c)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.errors.Describe(ch)
+	c.duration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.errors.Collect(ch)
+	c.duration.Collect(ch)
+}
+
+// ObserveRequest implements gophercloud.RequestObserver.
+func (c *Collector) ObserveRequest(e gophercloud.RequestEvent) {
+	code := "error"
+	if e.Err == nil {
+		code = strconv.Itoa(e.StatusCode)
+	}
+
+	c.requests.WithLabelValues(e.Service, e.Method, code).Inc()
+	if e.Err != nil || e.StatusCode >= 400 {
+		c.errors.WithLabelValues(e.Service, e.Method, code).Inc()
+	}
+	c.duration.WithLabelValues(e.Service, e.Method, code).Observe(e.Duration.Seconds())
+}
@@ -0,0 +1,42 @@
+/*
+Package metrics exports Prometheus metrics about the HTTP requests sent by
+Gophercloud.
+
+The Collector counts requests and failed requests, and records the latency of
+requests in a histogram. All metrics are labeled by service type (e.g.
+"compute"), HTTP method and status code. Requests which did not receive a
+response have the status code "error".
+
+The package is a module of its own, so that Gophercloud itself does not
+depend on the Prometheus client library.
+
+Example to Collect Metrics
+
+	collector, err := metrics.NewCollector(prometheus.DefaultRegisterer, metrics.CollectorOpts{})
+	if err != nil {
+		panic(err)
+	}
+
+	provider, err := openstack.NewClient(authOpts.IdentityEndpoint)
+	if err != nil {
+		panic(err)
+	}
+	provider.Observer = collector
+
+	err = openstack.Authenticate(provider, authOpts)
+	if err != nil {
+		panic(err)
+	}
+
+The exported metrics are:
+
+	gophercloud_requests_total{service,method,code}
+	gophercloud_request_errors_total{service,method,code}
+	gophercloud_request_duration_seconds{service,method,code}
+
+A request is counted as an error if no response was received or the status
+code is 400 or above. The error rate of a service can thus be computed as:
+
+	rate(gophercloud_request_errors_total[5m]) / rate(gophercloud_requests_total[5m])
+*/
+package metrics
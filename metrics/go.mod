@@ -0,0 +1,21 @@
+module github.com/gophercloud/gophercloud/metrics
+
+go 1.11
+
+require (
+	github.com/gophercloud/gophercloud v0.8.1-0.20261016162808-fe18f66567eb
+	github.com/prometheus/client_golang v1.4.1
+)
+
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.1.1 // indirect
+	github.com/golang/protobuf v1.3.2 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
+	github.com/prometheus/client_model v0.2.0 // indirect
+	github.com/prometheus/common v0.9.1 // indirect
+	github.com/prometheus/procfs v0.0.8 // indirect
+	golang.org/x/sys v0.0.0-20200122134326-e047566fdf82 // indirect
+)
+
+replace github.com/gophercloud/gophercloud => ../
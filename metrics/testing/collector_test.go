@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/metrics"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollector(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	collector, err := metrics.NewCollector(reg, metrics.CollectorOpts{})
+	th.AssertNoErr(t, err)
+
+	collector.ObserveRequest(gophercloud.RequestEvent{Service: "compute", Method: "GET", StatusCode: 200, Duration: time.Millisecond})
+	collector.ObserveRequest(gophercloud.RequestEvent{Service: "compute", Method: "GET", StatusCode: 200, Duration: time.Millisecond})
+	collector.ObserveRequest(gophercloud.RequestEvent{Service: "compute", Method: "DELETE", StatusCode: 404, Duration: time.Millisecond})
+	collector.ObserveRequest(gophercloud.RequestEvent{Service: "network", Method: "POST", Err: errors.New("connection refused")})
+
+	expected := `
+# HELP gophercloud_requests_total Number of HTTP requests sent to OpenStack services.
+# TYPE gophercloud_requests_total counter
+gophercloud_requests_total{code="200",method="GET",service="compute"} 2
+gophercloud_requests_total{code="404",method="DELETE",service="compute"} 1
+gophercloud_requests_total{code="error",method="POST",service="network"} 1
+# HELP gophercloud_request_errors_total Number of HTTP requests to OpenStack services which failed or returned a status code of 400 or above.
+# TYPE gophercloud_request_errors_total counter
+gophercloud_request_errors_total{code="404",method="DELETE",service="compute"} 1
+gophercloud_request_errors_total{code="error",method="POST",service="network"} 1
+`
+	err = testutil.GatherAndCompare(reg, strings.NewReader(expected), "gophercloud_requests_total", "gophercloud_request_errors_total")
+	th.AssertNoErr(t, err)
+
+	families, err := reg.Gather()
+	th.AssertNoErr(t, err)
+	durations := 0
+	for _, family := range families {
+		if family.GetName() == "gophercloud_request_duration_seconds" {
+			durations = len(family.GetMetric())
+		}
+	}
+	th.CheckEquals(t, 3, durations)
+}
+
+func TestCollectorOpts(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	collector, err := metrics.NewCollector(reg, metrics.CollectorOpts{
+		Namespace:   "cloud",
+		ConstLabels: prometheus.Labels{"cloud": "production"},
+		Buckets:     []float64{0.1, 1},
+	})
+	th.AssertNoErr(t, err)
+
+	collector.ObserveRequest(gophercloud.RequestEvent{Service: "image", Method: "GET", StatusCode: 200, Duration: 500 * time.Millisecond})
+
+	expected := `
+# HELP cloud_request_duration_seconds Latency of HTTP requests to OpenStack services.
+# TYPE cloud_request_duration_seconds histogram
+cloud_request_duration_seconds_bucket{cloud="production",code="200",method="GET",service="image",le="0.1"} 0
+cloud_request_duration_seconds_bucket{cloud="production",code="200",method="GET",service="image",le="1"} 1
+cloud_request_duration_seconds_bucket{cloud="production",code="200",method="GET",service="image",le="+Inf"} 1
+cloud_request_duration_seconds_sum{cloud="production",code="200",method="GET",service="image"} 0.5
+cloud_request_duration_seconds_count{cloud="production",code="200",method="GET",service="image"} 1
+`
+	err = testutil.GatherAndCompare(reg, strings.NewReader(expected), "cloud_request_duration_seconds")
+	th.AssertNoErr(t, err)
+
+	_, err = metrics.NewCollector(reg, metrics.CollectorOpts{Namespace: "cloud", ConstLabels: prometheus.Labels{"cloud": "production"}})
+	if err == nil {
+		t.Errorf("Expected an error registering the same metrics twice")
+	}
+}
+
+func TestCollectorObservesServiceClient(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	reg := prometheus.NewRegistry()
+	collector, err := metrics.NewCollector(reg, metrics.CollectorOpts{})
+	th.AssertNoErr(t, err)
+
+	sc := client.ServiceClient()
+	sc.Type = "compute"
+	sc.Observer = collector
+
+	_, err = sc.Get(sc.ServiceURL("servers"), nil, nil)
+	th.AssertNoErr(t, err)
+
+	expected := `
+# HELP gophercloud_requests_total Number of HTTP requests sent to OpenStack services.
+# TYPE gophercloud_requests_total counter
+gophercloud_requests_total{code="200",method="GET",service="compute"} 1
+`
+	err = testutil.GatherAndCompare(reg, strings.NewReader(expected), "gophercloud_requests_total")
+	th.AssertNoErr(t, err)
+}
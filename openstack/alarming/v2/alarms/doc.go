@@ -0,0 +1,160 @@
+/*
+Package alarms provides the ability to manage alarms, their state and their
+history through the OpenStack Alarming (Aodh) v2 API.
+
+Example of Listing alarms
+
+	listOpts := alarms.ListOpts{
+		Query: []alarms.Query{
+			{
+				Field: "state",
+				Value: alarms.StateAlarm,
+			},
+		},
+	}
+
+	allPages, err := alarms.List(alarmingClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAlarms, err := alarms.ExtractAlarms(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, alarm := range allAlarms {
+		fmt.Printf("%+v\n", alarm)
+	}
+
+Example of Getting an alarm
+
+	alarmID := "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3"
+	alarm, err := alarms.Get(alarmingClient, alarmID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Creating a gnocchi_resources_threshold alarm
+
+	createOpts := alarms.CreateOpts{
+		Name:         "cpu_high",
+		Type:         alarms.TypeGnocchiResourcesThreshold,
+		Severity:     "critical",
+		AlarmActions: []string{"http://example.org/notify"},
+		GnocchiResourcesThresholdRule: &alarms.GnocchiResourcesThresholdRule{
+			Metric:             "cpu_util",
+			ResourceID:         "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+			ResourceType:       "instance",
+			AggregationMethod:  "mean",
+			Threshold:          80,
+			ComparisonOperator: "gt",
+			Granularity:        300,
+			EvaluationPeriods:  3,
+		},
+	}
+
+	alarm, err := alarms.Create(alarmingClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Creating a composite alarm
+
+	createOpts := alarms.CreateOpts{
+		Name: "cpu_or_memory_high",
+		Type: alarms.TypeComposite,
+		CompositeRule: map[string]interface{}{
+			"or": []map[string]interface{}{
+				{
+					"type":                "gnocchi_resources_threshold",
+					"metric":              "cpu_util",
+					"resource_id":         "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+					"resource_type":       "instance",
+					"aggregation_method":  "mean",
+					"threshold":           80,
+					"comparison_operator": "gt",
+				},
+				{
+					"type":                "gnocchi_resources_threshold",
+					"metric":              "memory.usage",
+					"resource_id":         "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+					"resource_type":       "instance",
+					"aggregation_method":  "mean",
+					"threshold":           2048,
+					"comparison_operator": "gt",
+				},
+			},
+		},
+	}
+
+	alarm, err := alarms.Create(alarmingClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Updating an alarm
+
+	enabled := false
+	updateOpts := alarms.UpdateOpts{
+		Name:    "cpu_high",
+		Type:    alarms.TypeGnocchiResourcesThreshold,
+		Enabled: &enabled,
+		GnocchiResourcesThresholdRule: &alarms.GnocchiResourcesThresholdRule{
+			Metric:            "cpu_util",
+			ResourceID:        "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+			ResourceType:      "instance",
+			AggregationMethod: "mean",
+			Threshold:         90,
+		},
+	}
+
+	alarmID := "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3"
+	alarm, err := alarms.Update(alarmingClient, alarmID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Deleting an alarm
+
+	alarmID := "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3"
+	err := alarms.Delete(alarmingClient, alarmID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Getting and Setting the state of an alarm
+
+	alarmID := "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3"
+	state, err := alarms.GetState(alarmingClient, alarmID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	state, err = alarms.SetState(alarmingClient, alarmID, alarms.StateOK).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Listing the history of an alarm
+
+	listOpts := alarms.ListHistoryOpts{
+		Sort: []string{"timestamp:desc"},
+	}
+
+	alarmID := "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3"
+	allPages, err := alarms.ListHistory(alarmingClient, alarmID, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allChanges, err := alarms.ExtractAlarmChanges(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, change := range allChanges {
+		fmt.Printf("%+v\n", change)
+	}
+*/
+package alarms
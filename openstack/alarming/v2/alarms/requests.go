@@ -0,0 +1,385 @@
+package alarms
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+const (
+	// TypeThreshold is the type of alarms based on meter statistics.
+	TypeThreshold = "threshold"
+
+	// TypeGnocchiResourcesThreshold is the type of alarms based on a single
+	// resource metric stored in the Metric service.
+	TypeGnocchiResourcesThreshold = "gnocchi_resources_threshold"
+
+	// TypeGnocchiAggregationByMetricsThreshold is the type of alarms based on
+	// several metrics stored in the Metric service.
+	TypeGnocchiAggregationByMetricsThreshold = "gnocchi_aggregation_by_metrics_threshold"
+
+	// TypeGnocchiAggregationByResourcesThreshold is the type of alarms based
+	// on a metric of several resources stored in the Metric service.
+	TypeGnocchiAggregationByResourcesThreshold = "gnocchi_aggregation_by_resources_threshold"
+
+	// TypeComposite is the type of alarms combining other alarm rules.
+	TypeComposite = "composite"
+
+	// TypeEvent is the type of alarms triggered by notification events.
+	TypeEvent = "event"
+)
+
+const (
+	// StateOK means the rule of the alarm is not fulfilled.
+	StateOK = "ok"
+
+	// StateAlarm means the rule of the alarm is fulfilled.
+	StateAlarm = "alarm"
+
+	// StateInsufficientData means there is not enough data to evaluate
+	// the rule of the alarm.
+	StateInsufficientData = "insufficient data"
+)
+
+// buildQuery adds the q.field, q.op, q.value and q.type parameters
+// describing the given filters to params.
+func buildQuery(params url.Values, query []Query) {
+	withType := false
+	for _, q := range query {
+		if q.Type != "" {
+			withType = true
+		}
+	}
+
+	for _, q := range query {
+		op := q.Op
+		if op == "" {
+			op = "eq"
+		}
+		params.Add("q.field", q.Field)
+		params.Add("q.op", op)
+		params.Add("q.value", q.Value)
+		if withType {
+			params.Add("q.type", q.Type)
+		}
+	}
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAlarmListQuery() (string, error)
+}
+
+// ListOpts allows the filtering, limiting and sorting of paginated
+// collections through the Alarming v2 API.
+type ListOpts struct {
+	// Query filters alarms, for example by "type", "state" or "enabled".
+	Query []Query
+
+	// Limit allows to limit the number of returned alarms.
+	Limit int `q:"limit"`
+
+	// Marker is the ID of the last alarm on the previous page.
+	Marker string `q:"marker"`
+
+	// Sort allows to sort alarms, for example "name:asc".
+	Sort []string `q:"sort"`
+}
+
+// ToAlarmListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAlarmListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+	buildQuery(params, opts.Query)
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// alarms.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToAlarmListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		p := AlarmPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// Get retrieves a specific alarm based on its ID.
+func Get(c *gophercloud.ServiceClient, alarmID string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, alarmID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAlarmCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new alarm. Exactly one rule matching
+// Type must be set.
+type CreateOpts struct {
+	// Name is the name of the alarm. It must be unique within the project.
+	Name string `json:"name" required:"true"`
+
+	// Type is the type of the alarm.
+	Type string `json:"type" required:"true"`
+
+	// Description is a human-readable description of the alarm.
+	Description string `json:"description,omitempty"`
+
+	// Enabled indicates whether the alarm is evaluated.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// State is the initial state of the alarm.
+	State string `json:"state,omitempty"`
+
+	// Severity is the severity of the alarm: "low", "moderate" or "critical".
+	Severity string `json:"severity,omitempty"`
+
+	// ProjectID is the identifier of the project owning the alarm.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// UserID is the identifier of the user owning the alarm.
+	UserID string `json:"user_id,omitempty"`
+
+	// AlarmActions is a list of URLs to notify when the alarm transitions
+	// to the alarm state.
+	AlarmActions []string `json:"alarm_actions,omitempty"`
+
+	// OKActions is a list of URLs to notify when the alarm transitions
+	// to the ok state.
+	OKActions []string `json:"ok_actions,omitempty"`
+
+	// InsufficientDataActions is a list of URLs to notify when the alarm
+	// transitions to the insufficient data state.
+	InsufficientDataActions []string `json:"insufficient_data_actions,omitempty"`
+
+	// RepeatActions indicates whether actions are repeatedly notified
+	// while the alarm remains in the target state.
+	RepeatActions *bool `json:"repeat_actions,omitempty"`
+
+	// TimeConstraints restricts the evaluation of the alarm to certain
+	// periods of time.
+	TimeConstraints []TimeConstraint `json:"time_constraints,omitempty"`
+
+	// ThresholdRule is the rule of a threshold alarm.
+	ThresholdRule *ThresholdRule `json:"threshold_rule,omitempty"`
+
+	// GnocchiResourcesThresholdRule is the rule of a
+	// gnocchi_resources_threshold alarm.
+	GnocchiResourcesThresholdRule *GnocchiResourcesThresholdRule `json:"gnocchi_resources_threshold_rule,omitempty"`
+
+	// GnocchiAggregationByMetricsThresholdRule is the rule of a
+	// gnocchi_aggregation_by_metrics_threshold alarm.
+	GnocchiAggregationByMetricsThresholdRule *GnocchiAggregationByMetricsThresholdRule `json:"gnocchi_aggregation_by_metrics_threshold_rule,omitempty"`
+
+	// GnocchiAggregationByResourcesThresholdRule is the rule of a
+	// gnocchi_aggregation_by_resources_threshold alarm.
+	GnocchiAggregationByResourcesThresholdRule *GnocchiAggregationByResourcesThresholdRule `json:"gnocchi_aggregation_by_resources_threshold_rule,omitempty"`
+
+	// CompositeRule is the rule of a composite alarm.
+	CompositeRule map[string]interface{} `json:"composite_rule,omitempty"`
+
+	// EventRule is the rule of an event alarm.
+	EventRule *EventRule `json:"event_rule,omitempty"`
+}
+
+// ToAlarmCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToAlarmCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new alarm.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAlarmCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToAlarmUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents options used to update an alarm. The Alarming
+// service replaces the whole alarm definition, so the complete alarm
+// must be described, including its name, type and rule.
+type UpdateOpts struct {
+	// Name is the name of the alarm. It must be unique within the project.
+	Name string `json:"name" required:"true"`
+
+	// Type is the type of the alarm.
+	Type string `json:"type" required:"true"`
+
+	// Description is a human-readable description of the alarm.
+	Description string `json:"description,omitempty"`
+
+	// Enabled indicates whether the alarm is evaluated.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// State is the state of the alarm.
+	State string `json:"state,omitempty"`
+
+	// Severity is the severity of the alarm: "low", "moderate" or "critical".
+	Severity string `json:"severity,omitempty"`
+
+	// AlarmActions is a list of URLs to notify when the alarm transitions
+	// to the alarm state.
+	AlarmActions []string `json:"alarm_actions,omitempty"`
+
+	// OKActions is a list of URLs to notify when the alarm transitions
+	// to the ok state.
+	OKActions []string `json:"ok_actions,omitempty"`
+
+	// InsufficientDataActions is a list of URLs to notify when the alarm
+	// transitions to the insufficient data state.
+	InsufficientDataActions []string `json:"insufficient_data_actions,omitempty"`
+
+	// RepeatActions indicates whether actions are repeatedly notified
+	// while the alarm remains in the target state.
+	RepeatActions *bool `json:"repeat_actions,omitempty"`
+
+	// TimeConstraints restricts the evaluation of the alarm to certain
+	// periods of time.
+	TimeConstraints []TimeConstraint `json:"time_constraints,omitempty"`
+
+	// ThresholdRule is the rule of a threshold alarm.
+	ThresholdRule *ThresholdRule `json:"threshold_rule,omitempty"`
+
+	// GnocchiResourcesThresholdRule is the rule of a
+	// gnocchi_resources_threshold alarm.
+	GnocchiResourcesThresholdRule *GnocchiResourcesThresholdRule `json:"gnocchi_resources_threshold_rule,omitempty"`
+
+	// GnocchiAggregationByMetricsThresholdRule is the rule of a
+	// gnocchi_aggregation_by_metrics_threshold alarm.
+	GnocchiAggregationByMetricsThresholdRule *GnocchiAggregationByMetricsThresholdRule `json:"gnocchi_aggregation_by_metrics_threshold_rule,omitempty"`
+
+	// GnocchiAggregationByResourcesThresholdRule is the rule of a
+	// gnocchi_aggregation_by_resources_threshold alarm.
+	GnocchiAggregationByResourcesThresholdRule *GnocchiAggregationByResourcesThresholdRule `json:"gnocchi_aggregation_by_resources_threshold_rule,omitempty"`
+
+	// CompositeRule is the rule of a composite alarm.
+	CompositeRule map[string]interface{} `json:"composite_rule,omitempty"`
+
+	// EventRule is the rule of an event alarm.
+	EventRule *EventRule `json:"event_rule,omitempty"`
+}
+
+// ToAlarmUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToAlarmUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update accepts an UpdateOpts struct and replaces the definition of an
+// existing alarm using the values provided.
+func Update(c *gophercloud.ServiceClient, alarmID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAlarmUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(updateURL(c, alarmID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete accepts a unique ID and deletes the related alarm.
+func Delete(c *gophercloud.ServiceClient, alarmID string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, alarmID), nil)
+	return
+}
+
+// GetState retrieves the current state of an alarm.
+func GetState(c *gophercloud.ServiceClient, alarmID string) (r StateResult) {
+	_, r.Err = c.Get(stateURL(c, alarmID), &r.Body, nil)
+	return
+}
+
+// SetState forces the state of an alarm to the given value, which must be
+// one of StateOK, StateAlarm or StateInsufficientData.
+func SetState(c *gophercloud.ServiceClient, alarmID, state string) (r StateResult) {
+	if state == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "state"
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(stateURL(c, alarmID), state, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListHistoryOptsBuilder allows extensions to add additional parameters to
+// the ListHistory request.
+type ListHistoryOptsBuilder interface {
+	ToAlarmListHistoryQuery() (string, error)
+}
+
+// ListHistoryOpts allows the filtering, limiting and sorting of the history
+// of an alarm.
+type ListHistoryOpts struct {
+	// Query filters changes, for example by "type" or "timestamp".
+	Query []Query
+
+	// Limit allows to limit the number of returned changes.
+	Limit int `q:"limit"`
+
+	// Sort allows to sort changes, for example "timestamp:desc".
+	Sort []string `q:"sort"`
+}
+
+// ToAlarmListHistoryQuery formats a ListHistoryOpts into a query string.
+func (opts ListHistoryOpts) ToAlarmListHistoryQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+	buildQuery(params, opts.Query)
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// ListHistory returns a Pager which allows you to iterate over the changes
+// of an alarm.
+func ListHistory(c *gophercloud.ServiceClient, alarmID string, opts ListHistoryOptsBuilder) pagination.Pager {
+	url := historyURL(c, alarmID)
+	if opts != nil {
+		query, err := opts.ToAlarmListHistoryQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AlarmChangePage{pagination.SinglePageBase(r)}
+	})
+}
@@ -0,0 +1,416 @@
+package alarms
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Query is a single filter of an alarm rule or a list request.
+type Query struct {
+	// Field is the name of the field to filter on.
+	Field string `json:"field"`
+
+	// Op is the comparison operator, for example "eq", "lt" or "ge".
+	Op string `json:"op,omitempty"`
+
+	// Value is the value to compare against.
+	Value string `json:"value"`
+
+	// Type is the data type of Value, for example "string" or "integer".
+	Type string `json:"type,omitempty"`
+}
+
+// ThresholdRule describes an alarm based on the statistics of a meter.
+type ThresholdRule struct {
+	// MeterName is the name of the meter to evaluate.
+	MeterName string `json:"meter_name"`
+
+	// Threshold is the value to compare the statistic against.
+	Threshold float64 `json:"threshold"`
+
+	// ComparisonOperator is the operator used to compare the statistic
+	// against the threshold.
+	ComparisonOperator string `json:"comparison_operator,omitempty"`
+
+	// Statistic is the statistic to compare to the threshold.
+	Statistic string `json:"statistic,omitempty"`
+
+	// Period is the time range in seconds over which to query.
+	Period int `json:"period,omitempty"`
+
+	// EvaluationPeriods is the number of periods to evaluate over.
+	EvaluationPeriods int `json:"evaluation_periods,omitempty"`
+
+	// Query filters the samples of the meter.
+	Query []Query `json:"query,omitempty"`
+
+	// ExcludeOutliers indicates whether data point outliers should be
+	// excluded.
+	ExcludeOutliers bool `json:"exclude_outliers,omitempty"`
+}
+
+// GnocchiResourcesThresholdRule describes an alarm based on the measures of
+// a single resource metric stored in the Metric service.
+type GnocchiResourcesThresholdRule struct {
+	// Metric is the name of the metric.
+	Metric string `json:"metric"`
+
+	// ResourceID is the identifier of the resource.
+	ResourceID string `json:"resource_id"`
+
+	// ResourceType is the type of the resource.
+	ResourceType string `json:"resource_type"`
+
+	// AggregationMethod is the aggregation method used to compare with the
+	// threshold.
+	AggregationMethod string `json:"aggregation_method"`
+
+	// Threshold is the value to compare the aggregated measures against.
+	Threshold float64 `json:"threshold"`
+
+	// ComparisonOperator is the operator used to compare the aggregated
+	// measures against the threshold.
+	ComparisonOperator string `json:"comparison_operator,omitempty"`
+
+	// Granularity is the time range in seconds over which to query.
+	Granularity int `json:"granularity,omitempty"`
+
+	// EvaluationPeriods is the number of periods to evaluate over.
+	EvaluationPeriods int `json:"evaluation_periods,omitempty"`
+}
+
+// GnocchiAggregationByMetricsThresholdRule describes an alarm based on the
+// aggregated measures of several metrics stored in the Metric service.
+type GnocchiAggregationByMetricsThresholdRule struct {
+	// Metrics is a list of metric identifiers.
+	Metrics []string `json:"metrics"`
+
+	// AggregationMethod is the aggregation method used to compare with the
+	// threshold.
+	AggregationMethod string `json:"aggregation_method"`
+
+	// Threshold is the value to compare the aggregated measures against.
+	Threshold float64 `json:"threshold"`
+
+	// ComparisonOperator is the operator used to compare the aggregated
+	// measures against the threshold.
+	ComparisonOperator string `json:"comparison_operator,omitempty"`
+
+	// Granularity is the time range in seconds over which to query.
+	Granularity int `json:"granularity,omitempty"`
+
+	// EvaluationPeriods is the number of periods to evaluate over.
+	EvaluationPeriods int `json:"evaluation_periods,omitempty"`
+}
+
+// GnocchiAggregationByResourcesThresholdRule describes an alarm based on the
+// aggregated measures of a metric across the resources matched by a query.
+type GnocchiAggregationByResourcesThresholdRule struct {
+	// Metric is the name of the metric.
+	Metric string `json:"metric"`
+
+	// ResourceType is the type of the resources.
+	ResourceType string `json:"resource_type"`
+
+	// Query is a JSON-serialized Metric service search query used to
+	// match the resources.
+	Query string `json:"query"`
+
+	// AggregationMethod is the aggregation method used to compare with the
+	// threshold.
+	AggregationMethod string `json:"aggregation_method"`
+
+	// Threshold is the value to compare the aggregated measures against.
+	Threshold float64 `json:"threshold"`
+
+	// ComparisonOperator is the operator used to compare the aggregated
+	// measures against the threshold.
+	ComparisonOperator string `json:"comparison_operator,omitempty"`
+
+	// Granularity is the time range in seconds over which to query.
+	Granularity int `json:"granularity,omitempty"`
+
+	// EvaluationPeriods is the number of periods to evaluate over.
+	EvaluationPeriods int `json:"evaluation_periods,omitempty"`
+}
+
+// EventRule describes an alarm triggered by notification events.
+type EventRule struct {
+	// EventType is the type of the event to match. It may contain wildcards.
+	EventType string `json:"event_type,omitempty"`
+
+	// Query filters the matched events.
+	Query []Query `json:"query,omitempty"`
+}
+
+// TimeConstraint describes a period of time during which an alarm is
+// evaluated.
+type TimeConstraint struct {
+	// Name is the name of the constraint.
+	Name string `json:"name"`
+
+	// Description is a human-readable description of the constraint.
+	Description string `json:"description,omitempty"`
+
+	// Start is the start of the constraint in cron format.
+	Start string `json:"start"`
+
+	// Duration is the duration of the constraint in seconds.
+	Duration int `json:"duration"`
+
+	// Timezone is the timezone of the constraint.
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Alarm represents an alarm in the Alarming service.
+type Alarm struct {
+	// AlarmID is the unique identifier of the alarm.
+	AlarmID string `json:"alarm_id"`
+
+	// Name is the name of the alarm.
+	Name string `json:"name"`
+
+	// Description is a human-readable description of the alarm.
+	Description string `json:"description"`
+
+	// Type is the type of the alarm.
+	Type string `json:"type"`
+
+	// Enabled indicates whether the alarm is evaluated.
+	Enabled bool `json:"enabled"`
+
+	// State is the current state of the alarm.
+	State string `json:"state"`
+
+	// StateReason is the reason of the current state of the alarm.
+	StateReason string `json:"state_reason"`
+
+	// Severity is the severity of the alarm.
+	Severity string `json:"severity"`
+
+	// ProjectID is the identifier of the project owning the alarm.
+	ProjectID string `json:"project_id"`
+
+	// UserID is the identifier of the user owning the alarm.
+	UserID string `json:"user_id"`
+
+	// AlarmActions is a list of URLs to notify when the alarm transitions
+	// to the alarm state.
+	AlarmActions []string `json:"alarm_actions"`
+
+	// OKActions is a list of URLs to notify when the alarm transitions
+	// to the ok state.
+	OKActions []string `json:"ok_actions"`
+
+	// InsufficientDataActions is a list of URLs to notify when the alarm
+	// transitions to the insufficient data state.
+	InsufficientDataActions []string `json:"insufficient_data_actions"`
+
+	// RepeatActions indicates whether actions are repeatedly notified
+	// while the alarm remains in the target state.
+	RepeatActions bool `json:"repeat_actions"`
+
+	// TimeConstraints restricts the evaluation of the alarm to certain
+	// periods of time.
+	TimeConstraints []TimeConstraint `json:"time_constraints"`
+
+	// ThresholdRule is set for alarms of the threshold type.
+	ThresholdRule *ThresholdRule `json:"threshold_rule"`
+
+	// GnocchiResourcesThresholdRule is set for alarms of the
+	// gnocchi_resources_threshold type.
+	GnocchiResourcesThresholdRule *GnocchiResourcesThresholdRule `json:"gnocchi_resources_threshold_rule"`
+
+	// GnocchiAggregationByMetricsThresholdRule is set for alarms of the
+	// gnocchi_aggregation_by_metrics_threshold type.
+	GnocchiAggregationByMetricsThresholdRule *GnocchiAggregationByMetricsThresholdRule `json:"gnocchi_aggregation_by_metrics_threshold_rule"`
+
+	// GnocchiAggregationByResourcesThresholdRule is set for alarms of the
+	// gnocchi_aggregation_by_resources_threshold type.
+	GnocchiAggregationByResourcesThresholdRule *GnocchiAggregationByResourcesThresholdRule `json:"gnocchi_aggregation_by_resources_threshold_rule"`
+
+	// CompositeRule is set for alarms of the composite type. It is a tree
+	// of sub-rules combined with "and" and "or" operators.
+	CompositeRule map[string]interface{} `json:"composite_rule"`
+
+	// EventRule is set for alarms of the event type.
+	EventRule *EventRule `json:"event_rule"`
+
+	// Timestamp is the time of the last alarm definition update.
+	Timestamp time.Time `json:"-"`
+
+	// StateTimestamp is the time of the last alarm state change.
+	StateTimestamp time.Time `json:"-"`
+}
+
+// UnmarshalJSON helps to unmarshal Alarm fields into needed values.
+func (r *Alarm) UnmarshalJSON(b []byte) error {
+	type tmp Alarm
+	var s struct {
+		tmp
+		Timestamp      gophercloud.JSONRFC3339MilliNoZ `json:"timestamp"`
+		StateTimestamp gophercloud.JSONRFC3339MilliNoZ `json:"state_timestamp"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Alarm(s.tmp)
+
+	r.Timestamp = time.Time(s.Timestamp)
+	r.StateTimestamp = time.Time(s.StateTimestamp)
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an Alarm.
+func (r commonResult) Extract() (*Alarm, error) {
+	var s *Alarm
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as an Alarm.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as an Alarm.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as an Alarm.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// StateResult is the response from a GetState or SetState operation. Call
+// its Extract method to interpret it as an alarm state.
+type StateResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an alarm state.
+func (r StateResult) Extract() (string, error) {
+	var s string
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// AlarmPage abstracts the raw results of making a List() request against
+// the Alarming v2 API.
+type AlarmPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty checks whether an AlarmPage struct is empty.
+func (r AlarmPage) IsEmpty() (bool, error) {
+	alarms, err := ExtractAlarms(r)
+	return len(alarms) == 0, err
+}
+
+// LastMarker returns the ID of the last alarm in an AlarmPage.
+func (r AlarmPage) LastMarker() (string, error) {
+	alarms, err := ExtractAlarms(r)
+	if err != nil {
+		return "", err
+	}
+	if len(alarms) == 0 {
+		return "", nil
+	}
+	return alarms[len(alarms)-1].AlarmID, nil
+}
+
+// ExtractAlarms interprets the results of a single page from a List() call,
+// producing a slice of Alarm structs.
+func ExtractAlarms(r pagination.Page) ([]Alarm, error) {
+	var s []Alarm
+	err := (r.(AlarmPage)).ExtractInto(&s)
+	return s, err
+}
+
+// AlarmChange represents a single entry of the history of an alarm.
+type AlarmChange struct {
+	// EventID is the unique identifier of the change.
+	EventID string `json:"event_id"`
+
+	// AlarmID is the identifier of the alarm that changed.
+	AlarmID string `json:"alarm_id"`
+
+	// Type is the type of the change, for example "creation",
+	// "rule change", "state transition" or "deletion".
+	Type string `json:"type"`
+
+	// Detail is a JSON-serialized description of the change.
+	Detail string `json:"detail"`
+
+	// ProjectID is the identifier of the project that made the change.
+	ProjectID string `json:"project_id"`
+
+	// UserID is the identifier of the user that made the change.
+	UserID string `json:"user_id"`
+
+	// OnBehalfOf is the identifier of the project owning the alarm.
+	OnBehalfOf string `json:"on_behalf_of"`
+
+	// Severity is the severity of the alarm at the time of the change.
+	Severity string `json:"severity"`
+
+	// Timestamp is the time of the change.
+	Timestamp time.Time `json:"-"`
+}
+
+// UnmarshalJSON helps to unmarshal AlarmChange fields into needed values.
+func (r *AlarmChange) UnmarshalJSON(b []byte) error {
+	type tmp AlarmChange
+	var s struct {
+		tmp
+		Timestamp gophercloud.JSONRFC3339MilliNoZ `json:"timestamp"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = AlarmChange(s.tmp)
+
+	r.Timestamp = time.Time(s.Timestamp)
+
+	return nil
+}
+
+// AlarmChangePage abstracts the raw results of making a ListHistory()
+// request against the Alarming v2 API.
+type AlarmChangePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether an AlarmChangePage struct is empty.
+func (r AlarmChangePage) IsEmpty() (bool, error) {
+	changes, err := ExtractAlarmChanges(r)
+	return len(changes) == 0, err
+}
+
+// ExtractAlarmChanges interprets the results of a single page from a
+// ListHistory() call, producing a slice of AlarmChange structs.
+func ExtractAlarmChanges(r pagination.Page) ([]AlarmChange, error) {
+	var s []AlarmChange
+	err := (r.(AlarmChangePage)).ExtractInto(&s)
+	return s, err
+}
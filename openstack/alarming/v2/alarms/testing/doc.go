@@ -0,0 +1,2 @@
+// alarming_alarms_v2
+package testing
@@ -0,0 +1,554 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/alarming/v2/alarms"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListResult represents a raw server response from a List request.
+const ListResult = `
+[
+    {
+        "alarm_actions": [
+            "http://example.org/notify"
+        ],
+        "alarm_id": "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3",
+        "description": "CPU usage is too high",
+        "enabled": true,
+        "gnocchi_resources_threshold_rule": {
+            "aggregation_method": "mean",
+            "comparison_operator": "gt",
+            "evaluation_periods": 3,
+            "granularity": 300,
+            "metric": "cpu_util",
+            "resource_id": "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+            "resource_type": "instance",
+            "threshold": 80.0
+        },
+        "insufficient_data_actions": [],
+        "name": "cpu_high",
+        "ok_actions": [],
+        "project_id": "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+        "repeat_actions": false,
+        "severity": "critical",
+        "state": "alarm",
+        "state_reason": "Transition to alarm due to 3 samples outside threshold",
+        "state_timestamp": "2018-10-01T10:55:03.735316",
+        "time_constraints": [],
+        "timestamp": "2018-10-01T10:51:03.735316",
+        "type": "gnocchi_resources_threshold",
+        "user_id": "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e"
+    },
+    {
+        "alarm_actions": [
+            "log://"
+        ],
+        "alarm_id": "e0f2b5a8-6e0c-4d11-a1b6-bd3a7e0e4f7b",
+        "description": "",
+        "enabled": true,
+        "event_rule": {
+            "event_type": "compute.instance.update",
+            "query": [
+                {
+                    "field": "traits.state",
+                    "op": "eq",
+                    "type": "string",
+                    "value": "error"
+                }
+            ]
+        },
+        "insufficient_data_actions": [],
+        "name": "instance_error",
+        "ok_actions": [],
+        "project_id": "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+        "repeat_actions": false,
+        "severity": "low",
+        "state": "insufficient data",
+        "state_reason": "Not evaluated yet",
+        "state_timestamp": "2018-10-02T08:00:00",
+        "time_constraints": [],
+        "timestamp": "2018-10-02T08:00:00",
+        "type": "event",
+        "user_id": "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e"
+    }
+]
+`
+
+// CPUAlarm is an expected representation of a first alarm from the
+// ListResult.
+var CPUAlarm = alarms.Alarm{
+	AlarmID:                 "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3",
+	Name:                    "cpu_high",
+	Description:             "CPU usage is too high",
+	Type:                    alarms.TypeGnocchiResourcesThreshold,
+	Enabled:                 true,
+	State:                   alarms.StateAlarm,
+	StateReason:             "Transition to alarm due to 3 samples outside threshold",
+	Severity:                "critical",
+	ProjectID:               "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+	UserID:                  "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e",
+	AlarmActions:            []string{"http://example.org/notify"},
+	OKActions:               []string{},
+	InsufficientDataActions: []string{},
+	TimeConstraints:         []alarms.TimeConstraint{},
+	GnocchiResourcesThresholdRule: &alarms.GnocchiResourcesThresholdRule{
+		Metric:             "cpu_util",
+		ResourceID:         "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+		ResourceType:       "instance",
+		AggregationMethod:  "mean",
+		Threshold:          80,
+		ComparisonOperator: "gt",
+		Granularity:        300,
+		EvaluationPeriods:  3,
+	},
+	Timestamp:      time.Date(2018, 10, 1, 10, 51, 3, 735316000, time.UTC),
+	StateTimestamp: time.Date(2018, 10, 1, 10, 55, 3, 735316000, time.UTC),
+}
+
+// EventAlarm is an expected representation of a second alarm from the
+// ListResult.
+var EventAlarm = alarms.Alarm{
+	AlarmID:                 "e0f2b5a8-6e0c-4d11-a1b6-bd3a7e0e4f7b",
+	Name:                    "instance_error",
+	Type:                    alarms.TypeEvent,
+	Enabled:                 true,
+	State:                   alarms.StateInsufficientData,
+	StateReason:             "Not evaluated yet",
+	Severity:                "low",
+	ProjectID:               "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+	UserID:                  "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e",
+	AlarmActions:            []string{"log://"},
+	OKActions:               []string{},
+	InsufficientDataActions: []string{},
+	TimeConstraints:         []alarms.TimeConstraint{},
+	EventRule: &alarms.EventRule{
+		EventType: "compute.instance.update",
+		Query: []alarms.Query{
+			{
+				Field: "traits.state",
+				Op:    "eq",
+				Type:  "string",
+				Value: "error",
+			},
+		},
+	},
+	Timestamp:      time.Date(2018, 10, 2, 8, 0, 0, 0, time.UTC),
+	StateTimestamp: time.Date(2018, 10, 2, 8, 0, 0, 0, time.UTC),
+}
+
+// GetResult represents a raw server response from a Get request.
+const GetResult = `
+{
+    "alarm_actions": [],
+    "alarm_id": "5d8c8a1e-4b4f-4c0e-8a1a-4c5e2e7b9d3a",
+    "composite_rule": {
+        "or": [
+            {
+                "threshold": 0.8,
+                "metrics": [
+                    "b3d9d8ab-05e8-439f-89ad-5e978dd2a5eb",
+                    "009d4faf-c275-46f0-8f2d-670b15bac2b0"
+                ],
+                "type": "gnocchi_aggregation_by_metrics_threshold",
+                "aggregation_method": "mean"
+            },
+            {
+                "threshold": 30,
+                "metric": "cpu_util",
+                "type": "gnocchi_resources_threshold",
+                "resource_type": "instance",
+                "resource_id": "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+                "aggregation_method": "last"
+            }
+        ]
+    },
+    "description": "",
+    "enabled": true,
+    "insufficient_data_actions": [],
+    "name": "composite_alarm",
+    "ok_actions": [],
+    "project_id": "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+    "repeat_actions": true,
+    "severity": "moderate",
+    "state": "ok",
+    "state_reason": "",
+    "state_timestamp": "2018-10-03T09:00:00.5",
+    "time_constraints": [
+        {
+            "name": "office_hours",
+            "description": "",
+            "start": "0 9 * * 1-5",
+            "duration": 32400,
+            "timezone": "Europe/Paris"
+        }
+    ],
+    "timestamp": "2018-10-03T09:00:00.5",
+    "type": "composite",
+    "user_id": "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e"
+}
+`
+
+// CompositeAlarm is an expected representation of the GetResult.
+var CompositeAlarm = alarms.Alarm{
+	AlarmID: "5d8c8a1e-4b4f-4c0e-8a1a-4c5e2e7b9d3a",
+	Name:    "composite_alarm",
+	Type:    alarms.TypeComposite,
+	Enabled: true,
+	State:   alarms.StateOK,
+	CompositeRule: map[string]interface{}{
+		"or": []interface{}{
+			map[string]interface{}{
+				"threshold": 0.8,
+				"metrics": []interface{}{
+					"b3d9d8ab-05e8-439f-89ad-5e978dd2a5eb",
+					"009d4faf-c275-46f0-8f2d-670b15bac2b0",
+				},
+				"type":               "gnocchi_aggregation_by_metrics_threshold",
+				"aggregation_method": "mean",
+			},
+			map[string]interface{}{
+				"threshold":          float64(30),
+				"metric":             "cpu_util",
+				"type":               "gnocchi_resources_threshold",
+				"resource_type":      "instance",
+				"resource_id":        "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+				"aggregation_method": "last",
+			},
+		},
+	},
+	Severity:                "moderate",
+	ProjectID:               "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+	UserID:                  "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e",
+	RepeatActions:           true,
+	AlarmActions:            []string{},
+	OKActions:               []string{},
+	InsufficientDataActions: []string{},
+	TimeConstraints: []alarms.TimeConstraint{
+		{
+			Name:     "office_hours",
+			Start:    "0 9 * * 1-5",
+			Duration: 32400,
+			Timezone: "Europe/Paris",
+		},
+	},
+	Timestamp:      time.Date(2018, 10, 3, 9, 0, 0, 500000000, time.UTC),
+	StateTimestamp: time.Date(2018, 10, 3, 9, 0, 0, 500000000, time.UTC),
+}
+
+// CreateRequest represents a request to create an alarm.
+const CreateRequest = `
+{
+    "name": "cpu_high",
+    "type": "gnocchi_resources_threshold",
+    "description": "CPU usage is too high",
+    "severity": "critical",
+    "alarm_actions": [
+        "http://example.org/notify"
+    ],
+    "gnocchi_resources_threshold_rule": {
+        "aggregation_method": "mean",
+        "comparison_operator": "gt",
+        "evaluation_periods": 3,
+        "granularity": 300,
+        "metric": "cpu_util",
+        "resource_id": "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+        "resource_type": "instance",
+        "threshold": 80
+    }
+}
+`
+
+// CreateResult represents a raw server response to the CreateRequest.
+const CreateResult = `
+{
+    "alarm_actions": [
+        "http://example.org/notify"
+    ],
+    "alarm_id": "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3",
+    "description": "CPU usage is too high",
+    "enabled": true,
+    "gnocchi_resources_threshold_rule": {
+        "aggregation_method": "mean",
+        "comparison_operator": "gt",
+        "evaluation_periods": 3,
+        "granularity": 300,
+        "metric": "cpu_util",
+        "resource_id": "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+        "resource_type": "instance",
+        "threshold": 80.0
+    },
+    "insufficient_data_actions": [],
+    "name": "cpu_high",
+    "ok_actions": [],
+    "project_id": "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+    "repeat_actions": false,
+    "severity": "critical",
+    "state": "alarm",
+    "state_reason": "Transition to alarm due to 3 samples outside threshold",
+    "state_timestamp": "2018-10-01T10:55:03.735316",
+    "time_constraints": [],
+    "timestamp": "2018-10-01T10:51:03.735316",
+    "type": "gnocchi_resources_threshold",
+    "user_id": "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e"
+}
+`
+
+// UpdateRequest represents a request to update an alarm.
+const UpdateRequest = `
+{
+    "name": "cpu_high",
+    "type": "gnocchi_resources_threshold",
+    "enabled": false,
+    "gnocchi_resources_threshold_rule": {
+        "aggregation_method": "mean",
+        "metric": "cpu_util",
+        "resource_id": "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+        "resource_type": "instance",
+        "threshold": 90
+    }
+}
+`
+
+// UpdateResult represents a raw server response to the UpdateRequest.
+const UpdateResult = `
+{
+    "alarm_actions": [],
+    "alarm_id": "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3",
+    "description": "",
+    "enabled": false,
+    "gnocchi_resources_threshold_rule": {
+        "aggregation_method": "mean",
+        "comparison_operator": "eq",
+        "evaluation_periods": 1,
+        "granularity": 60,
+        "metric": "cpu_util",
+        "resource_id": "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+        "resource_type": "instance",
+        "threshold": 90.0
+    },
+    "insufficient_data_actions": [],
+    "name": "cpu_high",
+    "ok_actions": [],
+    "project_id": "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+    "repeat_actions": false,
+    "severity": "low",
+    "state": "alarm",
+    "state_reason": "Transition to alarm due to 3 samples outside threshold",
+    "state_timestamp": "2018-10-01T10:55:03.735316",
+    "time_constraints": [],
+    "timestamp": "2018-10-04T12:00:00",
+    "type": "gnocchi_resources_threshold",
+    "user_id": "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e"
+}
+`
+
+// UpdatedAlarm is an expected representation of the UpdateResult.
+var UpdatedAlarm = alarms.Alarm{
+	AlarmID:                 "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3",
+	Name:                    "cpu_high",
+	Type:                    alarms.TypeGnocchiResourcesThreshold,
+	Enabled:                 false,
+	State:                   alarms.StateAlarm,
+	StateReason:             "Transition to alarm due to 3 samples outside threshold",
+	Severity:                "low",
+	ProjectID:               "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+	UserID:                  "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e",
+	AlarmActions:            []string{},
+	OKActions:               []string{},
+	InsufficientDataActions: []string{},
+	TimeConstraints:         []alarms.TimeConstraint{},
+	GnocchiResourcesThresholdRule: &alarms.GnocchiResourcesThresholdRule{
+		Metric:             "cpu_util",
+		ResourceID:         "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+		ResourceType:       "instance",
+		AggregationMethod:  "mean",
+		Threshold:          90,
+		ComparisonOperator: "eq",
+		Granularity:        60,
+		EvaluationPeriods:  1,
+	},
+	Timestamp:      time.Date(2018, 10, 4, 12, 0, 0, 0, time.UTC),
+	StateTimestamp: time.Date(2018, 10, 1, 10, 55, 3, 735316000, time.UTC),
+}
+
+// ListHistoryResult represents a raw server response from a ListHistory
+// request.
+const ListHistoryResult = `
+[
+    {
+        "alarm_id": "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3",
+        "detail": "{\"state\": \"alarm\", \"transition_reason\": \"Transition to alarm due to 3 samples outside threshold\"}",
+        "event_id": "c1f5e1c4-bcd5-4a0f-9b73-8d6a5e3e1f2a",
+        "on_behalf_of": "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+        "project_id": "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+        "severity": "critical",
+        "timestamp": "2018-10-01T10:55:03.735316",
+        "type": "state transition",
+        "user_id": "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e"
+    },
+    {
+        "alarm_id": "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3",
+        "detail": "{\"name\": \"cpu_high\", \"type\": \"gnocchi_resources_threshold\"}",
+        "event_id": "8a7d8e76-0b0d-4f8f-8fb3-6b0b2f3f6a1c",
+        "on_behalf_of": "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+        "project_id": "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+        "severity": "critical",
+        "timestamp": "2018-10-01T10:51:03.735316",
+        "type": "creation",
+        "user_id": "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e"
+    }
+]
+`
+
+// ExpectedAlarmChanges is an expected representation of the
+// ListHistoryResult.
+var ExpectedAlarmChanges = []alarms.AlarmChange{
+	{
+		EventID:    "c1f5e1c4-bcd5-4a0f-9b73-8d6a5e3e1f2a",
+		AlarmID:    "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3",
+		Type:       "state transition",
+		Detail:     `{"state": "alarm", "transition_reason": "Transition to alarm due to 3 samples outside threshold"}`,
+		ProjectID:  "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+		UserID:     "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e",
+		OnBehalfOf: "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+		Severity:   "critical",
+		Timestamp:  time.Date(2018, 10, 1, 10, 55, 3, 735316000, time.UTC),
+	},
+	{
+		EventID:    "8a7d8e76-0b0d-4f8f-8fb3-6b0b2f3f6a1c",
+		AlarmID:    "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3",
+		Type:       "creation",
+		Detail:     `{"name": "cpu_high", "type": "gnocchi_resources_threshold"}`,
+		ProjectID:  "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+		UserID:     "9f8cdac1c0a442cb9c8a9c1c3d3e1c1e",
+		OnBehalfOf: "c888b4a1c1d9472a8e3c1c3b4b0f9e4c",
+		Severity:   "critical",
+		Timestamp:  time.Date(2018, 10, 1, 10, 51, 3, 735316000, time.UTC),
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			th.CheckDeepEquals(t, []string{"enabled"}, r.Form["q.field"])
+			th.CheckDeepEquals(t, []string{"eq"}, r.Form["q.op"])
+			th.CheckDeepEquals(t, []string{"true"}, r.Form["q.value"])
+			fmt.Fprintf(w, ListResult)
+		case "e0f2b5a8-6e0c-4d11-a1b6-bd3a7e0e4f7b":
+			fmt.Fprintf(w, `[]`)
+		default:
+			t.Fatalf("/alarms invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms/5d8c8a1e-4b4f-4c0e-8a1a-4c5e2e7b9d3a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetResult)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, CreateResult)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms/bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateResult)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms/bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleGetStateSuccessfully configures the test server to respond to a
+// GetState request.
+func HandleGetStateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms/bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3/state", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `"alarm"`)
+	})
+}
+
+// HandleSetStateSuccessfully configures the test server to respond to a
+// SetState request.
+func HandleSetStateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms/bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3/state", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `"insufficient data"`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `"insufficient data"`)
+	})
+}
+
+// HandleListHistorySuccessfully configures the test server to respond to a
+// ListHistory request.
+func HandleListHistorySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms/bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3/history", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"sort":  "timestamp:desc",
+			"limit": "2",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListHistoryResult)
+	})
+}
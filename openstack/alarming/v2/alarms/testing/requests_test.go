@@ -0,0 +1,178 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/alarming/v2/alarms"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListAlarms(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := alarms.ListOpts{
+		Query: []alarms.Query{
+			{
+				Field: "enabled",
+				Value: "true",
+			},
+		},
+	}
+
+	count := 0
+
+	err := alarms.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := alarms.ExtractAlarms(page)
+		th.AssertNoErr(t, err)
+
+		expected := []alarms.Alarm{CPUAlarm, EventAlarm}
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListAlarmsQuery(t *testing.T) {
+	listOpts := alarms.ListOpts{
+		Query: []alarms.Query{
+			{
+				Field: "state",
+				Value: "alarm",
+			},
+			{
+				Field: "severity",
+				Op:    "ne",
+				Value: "low",
+				Type:  "string",
+			},
+		},
+		Limit: 10,
+	}
+
+	query, err := listOpts.ToAlarmListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?limit=10&q.field=state&q.field=severity&q.op=eq&q.op=ne&q.type=&q.type=string&q.value=alarm&q.value=low", query)
+}
+
+func TestGetAlarm(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := alarms.Get(fake.ServiceClient(), "5d8c8a1e-4b4f-4c0e-8a1a-4c5e2e7b9d3a").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &CompositeAlarm, actual)
+}
+
+func TestCreateAlarm(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := alarms.CreateOpts{
+		Name:         "cpu_high",
+		Type:         alarms.TypeGnocchiResourcesThreshold,
+		Description:  "CPU usage is too high",
+		Severity:     "critical",
+		AlarmActions: []string{"http://example.org/notify"},
+		GnocchiResourcesThresholdRule: &alarms.GnocchiResourcesThresholdRule{
+			Metric:             "cpu_util",
+			ResourceID:         "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+			ResourceType:       "instance",
+			AggregationMethod:  "mean",
+			Threshold:          80,
+			ComparisonOperator: "gt",
+			Granularity:        300,
+			EvaluationPeriods:  3,
+		},
+	}
+
+	actual, err := alarms.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &CPUAlarm, actual)
+}
+
+func TestCreateAlarmRequiresType(t *testing.T) {
+	res := alarms.Create(fake.ServiceClient(), alarms.CreateOpts{Name: "cpu_high"})
+	if res.Err == nil {
+		t.Fatal("expected error when Type is not set")
+	}
+}
+
+func TestUpdateAlarm(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	enabled := false
+	updateOpts := alarms.UpdateOpts{
+		Name:    "cpu_high",
+		Type:    alarms.TypeGnocchiResourcesThreshold,
+		Enabled: &enabled,
+		GnocchiResourcesThresholdRule: &alarms.GnocchiResourcesThresholdRule{
+			Metric:            "cpu_util",
+			ResourceID:        "3a1b5d1e-7f93-4e06-a3a6-a3a68e3c5b7e",
+			ResourceType:      "instance",
+			AggregationMethod: "mean",
+			Threshold:         90,
+		},
+	}
+
+	actual, err := alarms.Update(fake.ServiceClient(), "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &UpdatedAlarm, actual)
+}
+
+func TestDeleteAlarm(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := alarms.Delete(fake.ServiceClient(), "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestGetAlarmState(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetStateSuccessfully(t)
+
+	state, err := alarms.GetState(fake.ServiceClient(), "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, alarms.StateAlarm, state)
+}
+
+func TestSetAlarmState(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSetStateSuccessfully(t)
+
+	state, err := alarms.SetState(fake.ServiceClient(), "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3", alarms.StateInsufficientData).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, alarms.StateInsufficientData, state)
+}
+
+func TestListAlarmHistory(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListHistorySuccessfully(t)
+
+	listOpts := alarms.ListHistoryOpts{
+		Limit: 2,
+		Sort:  []string{"timestamp:desc"},
+	}
+
+	allPages, err := alarms.ListHistory(fake.ServiceClient(), "bc4f0b36-46a0-4fe3-9a93-f2d5a8e3e8c3", listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := alarms.ExtractAlarmChanges(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedAlarmChanges, actual)
+}
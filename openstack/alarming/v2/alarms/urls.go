@@ -0,0 +1,41 @@
+package alarms
+
+import "github.com/gophercloud/gophercloud"
+
+const alarmsPath = "alarms"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(alarmsPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, alarmID string) string {
+	return c.ServiceURL(alarmsPath, alarmID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, alarmID string) string {
+	return resourceURL(c, alarmID)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func updateURL(c *gophercloud.ServiceClient, alarmID string) string {
+	return resourceURL(c, alarmID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, alarmID string) string {
+	return resourceURL(c, alarmID)
+}
+
+func stateURL(c *gophercloud.ServiceClient, alarmID string) string {
+	return c.ServiceURL(alarmsPath, alarmID, "state")
+}
+
+func historyURL(c *gophercloud.ServiceClient, alarmID string) string {
+	return c.ServiceURL(alarmsPath, alarmID, "history")
+}
@@ -0,0 +1,61 @@
+/*
+Package actions manages the actions of the OpenStack Backup service
+(Freezer). An action describes a unit of work of the backup agent, such as
+a backup or a restore, and is run as part of a job.
+
+Example to List Actions
+
+	allPages, err := actions.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allActions, err := actions.ExtractActions(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, action := range allActions {
+		fmt.Printf("%+v\n", action)
+	}
+
+Example to Create an Action
+
+	createOpts := actions.CreateOpts{
+		FreezerAction: actions.FreezerActionOpts{
+			Action:       actions.ActionBackup,
+			Mode:         "fs",
+			BackupName:   "etc",
+			PathToBackup: "/etc",
+			Container:    "backups",
+			Storage:      "swift",
+		},
+		MaxRetries:         3,
+		MaxRetriesInterval: 60,
+	}
+
+	actionID, err := actions.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Action
+
+	maxRetries := 5
+	updateOpts := actions.UpdateOpts{
+		MaxRetries: &maxRetries,
+	}
+
+	_, err := actions.Update(client, actionID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Action
+
+	err := actions.Delete(client, actionID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package actions
@@ -0,0 +1,188 @@
+package actions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Action types supported by the backup agent.
+const (
+	ActionBackup  = "backup"
+	ActionRestore = "restore"
+	ActionInfo    = "info"
+	ActionAdmin   = "admin"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToActionListQuery() (string, error)
+}
+
+// ListOpts allows the paging of the collection of actions.
+type ListOpts struct {
+	// Limit limits the number of actions to return.
+	Limit int `q:"limit"`
+
+	// Offset is the number of actions to skip.
+	Offset int `q:"offset"`
+}
+
+// ToActionListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToActionListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the actions of the
+// current user.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToActionListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ActionPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific action based on its unique ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// FreezerActionOpts describes the work done by the backup agent.
+type FreezerActionOpts struct {
+	// Action is the type of the action, e.g. ActionBackup or ActionRestore.
+	Action string `json:"action" required:"true"`
+
+	// Mode is the backup mode, e.g. "fs", "mysql" or "cinder".
+	Mode string `json:"mode,omitempty"`
+
+	// BackupName is the name of the backup.
+	BackupName string `json:"backup_name,omitempty"`
+
+	// PathToBackup is the path of the data to back up.
+	PathToBackup string `json:"path_to_backup,omitempty"`
+
+	// Container is the container or directory backups are stored in.
+	Container string `json:"container,omitempty"`
+
+	// Storage is the storage backend, e.g. "swift", "local" or "ssh".
+	Storage string `json:"storage,omitempty"`
+
+	// RestoreAbsPath is the path data is restored to.
+	RestoreAbsPath string `json:"restore_abs_path,omitempty"`
+
+	// RestoreFromHost is the host whose backups are restored.
+	RestoreFromHost string `json:"restore_from_host,omitempty"`
+
+	// RestoreFromDate restores the latest backup older than the date.
+	RestoreFromDate string `json:"restore_from_date,omitempty"`
+
+	// MaxLevel is the number of incremental backups between two full
+	// backups.
+	MaxLevel int `json:"max_level,omitempty"`
+
+	// AlwaysLevel always makes an incremental backup of the given level.
+	AlwaysLevel int `json:"always_level,omitempty"`
+
+	// RemoveOlderThan removes the backups older than the given number of
+	// days.
+	RemoveOlderThan int `json:"remove_older_than,omitempty"`
+
+	// Snapshot makes a filesystem snapshot before the backup.
+	Snapshot bool `json:"snapshot,omitempty"`
+
+	// DryRun does not perform the action.
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToActionCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new action.
+type CreateOpts struct {
+	// FreezerAction describes the work done by the backup agent.
+	FreezerAction FreezerActionOpts `json:"freezer_action" required:"true"`
+
+	// MaxRetries is the number of times the action is retried on failure.
+	MaxRetries int `json:"max_retries,omitempty"`
+
+	// MaxRetriesInterval is the number of seconds between two retries.
+	MaxRetriesInterval int `json:"max_retries_interval,omitempty"`
+
+	// Mandatory fails the job running the action if the action fails.
+	Mandatory bool `json:"mandatory,omitempty"`
+}
+
+// ToActionCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToActionCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new action.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToActionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToActionUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters to update an action.
+type UpdateOpts struct {
+	// FreezerAction describes the work done by the backup agent.
+	FreezerAction *FreezerActionOpts `json:"freezer_action,omitempty"`
+
+	// MaxRetries is the number of times the action is retried on failure.
+	MaxRetries *int `json:"max_retries,omitempty"`
+
+	// MaxRetriesInterval is the number of seconds between two retries.
+	MaxRetriesInterval *int `json:"max_retries_interval,omitempty"`
+
+	// Mandatory fails the job running the action if the action fails.
+	Mandatory *bool `json:"mandatory,omitempty"`
+}
+
+// ToActionUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToActionUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of an action.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToActionUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete requests the deletion of an action.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
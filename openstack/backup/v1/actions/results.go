@@ -0,0 +1,142 @@
+package actions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Action represents a unit of work of the backup agent, such as a backup or
+// a restore.
+type Action struct {
+	// ActionID is the unique ID of the action.
+	ActionID string `json:"action_id"`
+
+	// FreezerAction describes the work done by the backup agent.
+	FreezerAction FreezerAction `json:"freezer_action"`
+
+	// MaxRetries is the number of times the action is retried on failure.
+	MaxRetries int `json:"max_retries"`
+
+	// MaxRetriesInterval is the number of seconds between two retries.
+	MaxRetriesInterval int `json:"max_retries_interval"`
+
+	// Mandatory indicates whether the job running the action fails if the
+	// action fails.
+	Mandatory bool `json:"mandatory"`
+
+	// UserID is the ID of the user owning the action.
+	UserID string `json:"user_id"`
+}
+
+// FreezerAction describes the work done by the backup agent.
+type FreezerAction struct {
+	// Action is the type of the action.
+	Action string `json:"action"`
+
+	// Mode is the backup mode.
+	Mode string `json:"mode"`
+
+	// BackupName is the name of the backup.
+	BackupName string `json:"backup_name"`
+
+	// PathToBackup is the path of the data to back up.
+	PathToBackup string `json:"path_to_backup"`
+
+	// Container is the container or directory backups are stored in.
+	Container string `json:"container"`
+
+	// Storage is the storage backend.
+	Storage string `json:"storage"`
+
+	// RestoreAbsPath is the path data is restored to.
+	RestoreAbsPath string `json:"restore_abs_path"`
+
+	// RestoreFromHost is the host whose backups are restored.
+	RestoreFromHost string `json:"restore_from_host"`
+
+	// RestoreFromDate restores the latest backup older than the date.
+	RestoreFromDate string `json:"restore_from_date"`
+
+	// MaxLevel is the number of incremental backups between two full
+	// backups.
+	MaxLevel int `json:"max_level"`
+
+	// AlwaysLevel always makes an incremental backup of the given level.
+	AlwaysLevel int `json:"always_level"`
+
+	// RemoveOlderThan removes the backups older than the given number of
+	// days.
+	RemoveOlderThan int `json:"remove_older_than"`
+
+	// Snapshot indicates whether a filesystem snapshot is made before the
+	// backup.
+	Snapshot bool `json:"snapshot"`
+
+	// DryRun indicates whether the action is not performed.
+	DryRun bool `json:"dry_run"`
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as an Action.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as an Action.
+func (r GetResult) Extract() (*Action, error) {
+	var s *Action
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+type idResult struct {
+	gophercloud.Result
+}
+
+// Extract returns the ID of the created or updated action.
+func (r idResult) Extract() (string, error) {
+	var s struct {
+		ActionID string `json:"action_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ActionID, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to retrieve the ID of the new action.
+type CreateResult struct {
+	idResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to retrieve the ID of the updated action.
+type UpdateResult struct {
+	idResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ActionPage is a single page of Action results.
+type ActionPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not an ActionPage contains any results.
+func (r ActionPage) IsEmpty() (bool, error) {
+	actions, err := ExtractActions(r)
+	return len(actions) == 0, err
+}
+
+// ExtractActions returns a slice of Actions contained in a single page of
+// results.
+func ExtractActions(r pagination.Page) ([]Action, error) {
+	var s struct {
+		Actions []Action `json:"actions"`
+	}
+	err := (r.(ActionPage)).ExtractInto(&s)
+	return s.Actions, err
+}
@@ -0,0 +1,2 @@
+// backup_actions_v1
+package testing
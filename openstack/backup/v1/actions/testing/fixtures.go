@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/actions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const ActionID = "3d7f1a4e1fea4c42a0b3f2a1e9d5c6b7"
+
+// ActionBody is the JSON representation of a single action.
+const ActionBody = `
+{
+  "action_id": "3d7f1a4e1fea4c42a0b3f2a1e9d5c6b7",
+  "freezer_action": {
+    "action": "backup",
+    "mode": "fs",
+    "backup_name": "etc",
+    "path_to_backup": "/etc",
+    "container": "backups",
+    "storage": "swift",
+    "max_level": 5
+  },
+  "max_retries": 3,
+  "max_retries_interval": 60,
+  "mandatory": false,
+  "user_id": "ac8a2f2b5a0c4b1c8a6ffa43a41c6f7d"
+}
+`
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`{"actions": [%s]}`, ActionBody)
+
+// CreateRequest is a sample request to create an action.
+const CreateRequest = `
+{
+  "freezer_action": {
+    "action": "backup",
+    "mode": "fs",
+    "backup_name": "etc",
+    "path_to_backup": "/etc",
+    "container": "backups",
+    "storage": "swift",
+    "max_level": 5
+  },
+  "max_retries": 3,
+  "max_retries_interval": 60
+}
+`
+
+// CreateResponse is a sample response to a Create call.
+const CreateResponse = `{"action_id": "3d7f1a4e1fea4c42a0b3f2a1e9d5c6b7"}`
+
+// UpdateRequest is a sample request to update an action.
+const UpdateRequest = `
+{
+  "max_retries": 5,
+  "mandatory": true
+}
+`
+
+// UpdateResponse is a sample response to an Update call.
+const UpdateResponse = `{"action_id": "3d7f1a4e1fea4c42a0b3f2a1e9d5c6b7", "version": 2}`
+
+// FirstAction is the action described by ActionBody.
+var FirstAction = actions.Action{
+	ActionID: ActionID,
+	FreezerAction: actions.FreezerAction{
+		Action:       "backup",
+		Mode:         "fs",
+		BackupName:   "etc",
+		PathToBackup: "/etc",
+		Container:    "backups",
+		Storage:      "swift",
+		MaxLevel:     5,
+	},
+	MaxRetries:         3,
+	MaxRetriesInterval: 60,
+	UserID:             "ac8a2f2b5a0c4b1c8a6ffa43a41c6f7d",
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/actions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"limit": "10"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/actions/"+ActionID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ActionBody)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/actions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/actions/"+ActionID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/actions/"+ActionID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
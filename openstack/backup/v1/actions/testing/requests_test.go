@@ -0,0 +1,95 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/actions"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListActions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := actions.List(fake.ServiceClient(), actions.ListOpts{Limit: 10}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := actions.ExtractActions(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []actions.Action{FirstAction}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetAction(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := actions.Get(fake.ServiceClient(), ActionID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAction, actual)
+}
+
+func TestCreateAction(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := actions.CreateOpts{
+		FreezerAction: actions.FreezerActionOpts{
+			Action:       actions.ActionBackup,
+			Mode:         "fs",
+			BackupName:   "etc",
+			PathToBackup: "/etc",
+			Container:    "backups",
+			Storage:      "swift",
+			MaxLevel:     5,
+		},
+		MaxRetries:         3,
+		MaxRetriesInterval: 60,
+	}
+
+	actual, err := actions.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, ActionID, actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	_, err := actions.CreateOpts{}.ToActionCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdateAction(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	maxRetries := 5
+	mandatory := true
+	updateOpts := actions.UpdateOpts{
+		MaxRetries: &maxRetries,
+		Mandatory:  &mandatory,
+	}
+
+	actual, err := actions.Update(fake.ServiceClient(), ActionID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, ActionID, actual)
+}
+
+func TestDeleteAction(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := actions.Delete(fake.ServiceClient(), ActionID)
+	th.AssertNoErr(t, res.Err)
+}
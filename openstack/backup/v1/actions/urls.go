@@ -0,0 +1,33 @@
+package actions
+
+import "github.com/gophercloud/gophercloud"
+
+const actionsPath = "actions"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(actionsPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, actionID string) string {
+	return c.ServiceURL(actionsPath, actionID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, actionID string) string {
+	return resourceURL(c, actionID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, actionID string) string {
+	return resourceURL(c, actionID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, actionID string) string {
+	return resourceURL(c, actionID)
+}
@@ -0,0 +1,62 @@
+/*
+Package jobs manages the jobs of the OpenStack Backup service (Freezer). A
+job is a scheduled sequence of actions run by a backup agent.
+
+Example to List Jobs
+
+	allPages, err := jobs.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allJobs, err := jobs.ExtractJobs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, job := range allJobs {
+		fmt.Printf("%+v\n", job)
+	}
+
+Example to Create a Daily Backup Job
+
+	createOpts := jobs.CreateOpts{
+		Description: "Daily backup of /etc",
+		ClientID:    clientID,
+		JobSchedule: jobs.ScheduleOpts{
+			ScheduleInterval: "1 days",
+		},
+		JobActions: []actions.CreateOpts{
+			{
+				FreezerAction: actions.FreezerActionOpts{
+					Action:       actions.ActionBackup,
+					Mode:         "fs",
+					BackupName:   "etc",
+					PathToBackup: "/etc",
+					Container:    "backups",
+				},
+				MaxRetries: 3,
+			},
+		},
+	}
+
+	jobID, err := jobs.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Start a Job
+
+	err := jobs.Start(client, jobID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Job
+
+	err := jobs.Delete(client, jobID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package jobs
@@ -0,0 +1,178 @@
+package jobs
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/actions"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToJobListQuery() (string, error)
+}
+
+// ListOpts allows the paging of the collection of jobs.
+type ListOpts struct {
+	// Limit limits the number of jobs to return.
+	Limit int `q:"limit"`
+
+	// Offset is the number of jobs to skip.
+	Offset int `q:"offset"`
+}
+
+// ToJobListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToJobListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the jobs of the
+// current user.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToJobListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return JobPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific job based on its unique ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// ScheduleOpts specifies when a job is run.
+type ScheduleOpts struct {
+	// ScheduleDate runs the job once at the given date.
+	ScheduleDate string `json:"schedule_date,omitempty"`
+
+	// ScheduleInterval runs the job periodically, e.g. "continuous" or
+	// "1 days".
+	ScheduleInterval string `json:"schedule_interval,omitempty"`
+
+	// ScheduleStartDate is the date of the first run of a periodic job.
+	ScheduleStartDate string `json:"schedule_start_date,omitempty"`
+
+	// ScheduleEndDate is the date after which a periodic job is not run.
+	ScheduleEndDate string `json:"schedule_end_date,omitempty"`
+
+	// ScheduleCron runs the job following a cron expression.
+	ScheduleCron string `json:"schedule_cron,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToJobCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new job.
+type CreateOpts struct {
+	// Description is a description of the job.
+	Description string `json:"description,omitempty"`
+
+	// ClientID is the ID of the backup agent running the job.
+	ClientID string `json:"client_id,omitempty"`
+
+	// JobSchedule specifies when the job is run.
+	JobSchedule ScheduleOpts `json:"job_schedule"`
+
+	// JobActions are the actions run by the job, in order.
+	JobActions []actions.CreateOpts `json:"job_actions" required:"true"`
+}
+
+// ToJobCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToJobCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new job.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToJobCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToJobUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters to update a job.
+type UpdateOpts struct {
+	// Description is a description of the job.
+	Description *string `json:"description,omitempty"`
+
+	// ClientID is the ID of the backup agent running the job.
+	ClientID string `json:"client_id,omitempty"`
+
+	// JobSchedule specifies when the job is run.
+	JobSchedule *ScheduleOpts `json:"job_schedule,omitempty"`
+
+	// JobActions are the actions run by the job, in order.
+	JobActions []actions.CreateOpts `json:"job_actions,omitempty"`
+}
+
+// ToJobUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToJobUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of a job.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToJobUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete requests the deletion of a job.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
+
+func sendEvent(client *gophercloud.ServiceClient, id, event string) (r EventResult) {
+	b := map[string]interface{}{
+		event: nil,
+	}
+	_, r.Err = client.Post(eventURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Start requests the backup agent to run a job now.
+func Start(client *gophercloud.ServiceClient, id string) (r EventResult) {
+	return sendEvent(client, id, "start")
+}
+
+// Stop requests the backup agent to stop scheduling a job.
+func Stop(client *gophercloud.ServiceClient, id string) (r EventResult) {
+	return sendEvent(client, id, "stop")
+}
+
+// Abort requests the backup agent to abort a running job.
+func Abort(client *gophercloud.ServiceClient, id string) (r EventResult) {
+	return sendEvent(client, id, "abort")
+}
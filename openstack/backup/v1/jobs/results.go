@@ -0,0 +1,145 @@
+package jobs
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/actions"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Job represents a scheduled sequence of actions run by a backup agent.
+type Job struct {
+	// JobID is the unique ID of the job.
+	JobID string `json:"job_id"`
+
+	// Description is a description of the job.
+	Description string `json:"description"`
+
+	// ClientID is the ID of the backup agent running the job.
+	ClientID string `json:"client_id"`
+
+	// JobSchedule describes when the job is run and the state of its last
+	// run.
+	JobSchedule Schedule `json:"job_schedule"`
+
+	// JobActions are the actions run by the job, in order.
+	JobActions []actions.Action `json:"job_actions"`
+
+	// SessionID is the ID of the session the job belongs to.
+	SessionID string `json:"session_id"`
+
+	// SessionTag is the tag of the last session run of the job.
+	SessionTag int `json:"session_tag"`
+
+	// UserID is the ID of the user owning the job.
+	UserID string `json:"user_id"`
+}
+
+// Schedule describes when a job is run and the state of its last run.
+type Schedule struct {
+	// ScheduleDate is the date of a job run once.
+	ScheduleDate string `json:"schedule_date"`
+
+	// ScheduleInterval is the interval between the runs of a periodic job.
+	ScheduleInterval string `json:"schedule_interval"`
+
+	// ScheduleStartDate is the date of the first run of a periodic job.
+	ScheduleStartDate string `json:"schedule_start_date"`
+
+	// ScheduleEndDate is the date after which a periodic job is not run.
+	ScheduleEndDate string `json:"schedule_end_date"`
+
+	// ScheduleCron is the cron expression of the job.
+	ScheduleCron string `json:"schedule_cron"`
+
+	// Status is the status of the job, e.g. "scheduled" or "running".
+	Status string `json:"status"`
+
+	// Event is the last event sent to the job, e.g. "start" or "stop".
+	Event string `json:"event"`
+
+	// Result is the result of the last run, e.g. "success" or "fail".
+	Result string `json:"result"`
+
+	// CurrentPID is the process ID of the running job.
+	CurrentPID int `json:"current_pid"`
+
+	// TimeCreated is the Unix time the job was created at.
+	TimeCreated int `json:"time_created"`
+
+	// TimeStarted is the Unix time the last run started at.
+	TimeStarted int `json:"time_started"`
+
+	// TimeEnded is the Unix time the last run ended at.
+	TimeEnded int `json:"time_ended"`
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Job.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a Job.
+func (r GetResult) Extract() (*Job, error) {
+	var s *Job
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+type idResult struct {
+	gophercloud.Result
+}
+
+// Extract returns the ID of the created or updated job.
+func (r idResult) Extract() (string, error) {
+	var s struct {
+		JobID string `json:"job_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.JobID, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to retrieve the ID of the new job.
+type CreateResult struct {
+	idResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to retrieve the ID of the updated job.
+type UpdateResult struct {
+	idResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// EventResult is the response from a Start, Stop or Abort operation. Call
+// its ExtractErr method to determine if the call succeeded or failed.
+type EventResult struct {
+	gophercloud.ErrResult
+}
+
+// JobPage is a single page of Job results.
+type JobPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a JobPage contains any results.
+func (r JobPage) IsEmpty() (bool, error) {
+	jobs, err := ExtractJobs(r)
+	return len(jobs) == 0, err
+}
+
+// ExtractJobs returns a slice of Jobs contained in a single page of
+// results.
+func ExtractJobs(r pagination.Page) ([]Job, error) {
+	var s struct {
+		Jobs []Job `json:"jobs"`
+	}
+	err := (r.(JobPage)).ExtractInto(&s)
+	return s.Jobs, err
+}
@@ -0,0 +1,2 @@
+// backup_jobs_v1
+package testing
@@ -0,0 +1,199 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/actions"
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/jobs"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const JobID = "a4fbe4b5c64e4ae3bd2a5df8e7e47e6f"
+
+// JobBody is the JSON representation of a single job.
+const JobBody = `
+{
+  "job_id": "a4fbe4b5c64e4ae3bd2a5df8e7e47e6f",
+  "description": "Daily backup of /etc",
+  "client_id": "myproject_node1",
+  "job_schedule": {
+    "schedule_interval": "1 days",
+    "status": "scheduled",
+    "event": "start",
+    "result": "",
+    "current_pid": 0,
+    "time_created": 1485354382,
+    "time_started": -1,
+    "time_ended": -1
+  },
+  "job_actions": [
+    {
+      "action_id": "3d7f1a4e1fea4c42a0b3f2a1e9d5c6b7",
+      "freezer_action": {
+        "action": "backup",
+        "mode": "fs",
+        "backup_name": "etc",
+        "path_to_backup": "/etc",
+        "container": "backups"
+      },
+      "max_retries": 3
+    }
+  ],
+  "session_id": "",
+  "session_tag": 0,
+  "user_id": "ac8a2f2b5a0c4b1c8a6ffa43a41c6f7d"
+}
+`
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`{"jobs": [%s]}`, JobBody)
+
+// CreateRequest is a sample request to create a job.
+const CreateRequest = `
+{
+  "description": "Daily backup of /etc",
+  "client_id": "myproject_node1",
+  "job_schedule": {
+    "schedule_interval": "1 days"
+  },
+  "job_actions": [
+    {
+      "freezer_action": {
+        "action": "backup",
+        "mode": "fs",
+        "backup_name": "etc",
+        "path_to_backup": "/etc",
+        "container": "backups"
+      },
+      "max_retries": 3
+    }
+  ]
+}
+`
+
+// CreateResponse is a sample response to a Create call.
+const CreateResponse = `{"job_id": "a4fbe4b5c64e4ae3bd2a5df8e7e47e6f"}`
+
+// UpdateRequest is a sample request to update a job.
+const UpdateRequest = `
+{
+  "description": "Weekly backup of /etc",
+  "job_schedule": {
+    "schedule_interval": "7 days"
+  }
+}
+`
+
+// UpdateResponse is a sample response to an Update call.
+const UpdateResponse = `{"job_id": "a4fbe4b5c64e4ae3bd2a5df8e7e47e6f", "version": 2}`
+
+// FirstJob is the job described by JobBody.
+var FirstJob = jobs.Job{
+	JobID:       JobID,
+	Description: "Daily backup of /etc",
+	ClientID:    "myproject_node1",
+	JobSchedule: jobs.Schedule{
+		ScheduleInterval: "1 days",
+		Status:           "scheduled",
+		Event:            "start",
+		TimeCreated:      1485354382,
+		TimeStarted:      -1,
+		TimeEnded:        -1,
+	},
+	JobActions: []actions.Action{
+		{
+			ActionID: "3d7f1a4e1fea4c42a0b3f2a1e9d5c6b7",
+			FreezerAction: actions.FreezerAction{
+				Action:       "backup",
+				Mode:         "fs",
+				BackupName:   "etc",
+				PathToBackup: "/etc",
+				Container:    "backups",
+			},
+			MaxRetries: 3,
+		},
+	},
+	UserID: "ac8a2f2b5a0c4b1c8a6ffa43a41c6f7d",
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs/"+JobID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, JobBody)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs/"+JobID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs/"+JobID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleEventSuccessfully configures the test server to respond to a Start,
+// Stop or Abort request sending the given event.
+func HandleEventSuccessfully(t *testing.T, event string) {
+	th.Mux.HandleFunc("/jobs/"+JobID+"/event", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, fmt.Sprintf(`{"%s": null}`, event))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"result": "success"}`)
+	})
+}
@@ -0,0 +1,123 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/actions"
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/jobs"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListJobs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := jobs.List(fake.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := jobs.ExtractJobs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []jobs.Job{FirstJob}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := jobs.Get(fake.ServiceClient(), JobID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstJob, actual)
+}
+
+func TestCreateJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := jobs.CreateOpts{
+		Description: "Daily backup of /etc",
+		ClientID:    "myproject_node1",
+		JobSchedule: jobs.ScheduleOpts{
+			ScheduleInterval: "1 days",
+		},
+		JobActions: []actions.CreateOpts{
+			{
+				FreezerAction: actions.FreezerActionOpts{
+					Action:       actions.ActionBackup,
+					Mode:         "fs",
+					BackupName:   "etc",
+					PathToBackup: "/etc",
+					Container:    "backups",
+				},
+				MaxRetries: 3,
+			},
+		},
+	}
+
+	actual, err := jobs.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, JobID, actual)
+}
+
+func TestUpdateJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	description := "Weekly backup of /etc"
+	updateOpts := jobs.UpdateOpts{
+		Description: &description,
+		JobSchedule: &jobs.ScheduleOpts{
+			ScheduleInterval: "7 days",
+		},
+	}
+
+	actual, err := jobs.Update(fake.ServiceClient(), JobID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, JobID, actual)
+}
+
+func TestDeleteJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := jobs.Delete(fake.ServiceClient(), JobID)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestStartJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEventSuccessfully(t, "start")
+
+	err := jobs.Start(fake.ServiceClient(), JobID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestStopJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEventSuccessfully(t, "stop")
+
+	err := jobs.Stop(fake.ServiceClient(), JobID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAbortJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEventSuccessfully(t, "abort")
+
+	err := jobs.Abort(fake.ServiceClient(), JobID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,37 @@
+package jobs
+
+import "github.com/gophercloud/gophercloud"
+
+const jobsPath = "jobs"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(jobsPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, jobID string) string {
+	return c.ServiceURL(jobsPath, jobID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, jobID string) string {
+	return resourceURL(c, jobID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, jobID string) string {
+	return resourceURL(c, jobID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, jobID string) string {
+	return resourceURL(c, jobID)
+}
+
+func eventURL(c *gophercloud.ServiceClient, jobID string) string {
+	return c.ServiceURL(jobsPath, jobID, "event")
+}
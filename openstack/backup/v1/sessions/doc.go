@@ -0,0 +1,61 @@
+/*
+Package sessions manages the sessions of the OpenStack Backup service
+(Freezer). A session groups jobs which are run together, for example to back
+up several hosts at the same time.
+
+Example to List Sessions
+
+	allPages, err := sessions.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allSessions, err := sessions.ExtractSessions(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, session := range allSessions {
+		fmt.Printf("%+v\n", session)
+	}
+
+Example to Create a Session and Add a Job to it
+
+	createOpts := sessions.CreateOpts{
+		Description: "Nightly backup of the database cluster",
+		HoldOff:     30,
+		Schedule: &jobs.ScheduleOpts{
+			ScheduleInterval: "1 days",
+		},
+	}
+
+	sessionID, err := sessions.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = sessions.AddJob(client, sessionID, jobID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Start a Session
+
+	startOpts := sessions.StartOpts{
+		JobID:      jobID,
+		CurrentTag: 0,
+	}
+
+	res, err := sessions.Start(client, sessionID, startOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Session
+
+	err := sessions.Delete(client, sessionID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package sessions
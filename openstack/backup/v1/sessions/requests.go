@@ -0,0 +1,178 @@
+package sessions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/jobs"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSessionListQuery() (string, error)
+}
+
+// ListOpts allows the paging of the collection of sessions.
+type ListOpts struct {
+	// Limit limits the number of sessions to return.
+	Limit int `q:"limit"`
+
+	// Offset is the number of sessions to skip.
+	Offset int `q:"offset"`
+}
+
+// ToSessionListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSessionListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the sessions of the
+// current user.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToSessionListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return SessionPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific session based on its unique ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSessionCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new session.
+type CreateOpts struct {
+	// Description is a description of the session.
+	Description string `json:"description" required:"true"`
+
+	// HoldOff is the number of seconds the jobs of the session wait for
+	// each other before they are started.
+	HoldOff int `json:"hold_off,omitempty"`
+
+	// Schedule specifies when the jobs of the session are run.
+	Schedule *jobs.ScheduleOpts `json:"schedule,omitempty"`
+}
+
+// ToSessionCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToSessionCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new session.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSessionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToSessionUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters to update a session.
+type UpdateOpts struct {
+	// Description is a description of the session.
+	Description string `json:"description,omitempty"`
+
+	// HoldOff is the number of seconds the jobs of the session wait for
+	// each other before they are started.
+	HoldOff *int `json:"hold_off,omitempty"`
+
+	// Schedule specifies when the jobs of the session are run.
+	Schedule *jobs.ScheduleOpts `json:"schedule,omitempty"`
+}
+
+// ToSessionUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToSessionUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of a session.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToSessionUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete requests the deletion of a session.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
+
+// AddJob adds a job to a session.
+func AddJob(client *gophercloud.ServiceClient, sessionID, jobID string) (r AddJobResult) {
+	_, r.Err = client.Put(jobURL(client, sessionID, jobID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// RemoveJob removes a job from a session.
+func RemoveJob(client *gophercloud.ServiceClient, sessionID, jobID string) (r RemoveJobResult) {
+	_, r.Err = client.Delete(jobURL(client, sessionID, jobID), nil)
+	return
+}
+
+// StartOptsBuilder allows extensions to add additional parameters to the
+// Start request.
+type StartOptsBuilder interface {
+	ToSessionStartMap() (map[string]interface{}, error)
+}
+
+// StartOpts specifies the job starting a session run.
+type StartOpts struct {
+	// JobID is the ID of the job of the session requesting the run.
+	JobID string `json:"job_id" required:"true"`
+
+	// CurrentTag is the session tag known by the job. The run is started
+	// only if it matches the tag of the session.
+	CurrentTag int `json:"current_tag"`
+}
+
+// ToSessionStartMap constructs a request body from StartOpts.
+func (opts StartOpts) ToSessionStartMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "start")
+}
+
+// Start requests a new run of the jobs of a session.
+func Start(client *gophercloud.ServiceClient, id string, opts StartOptsBuilder) (r StartResult) {
+	b, err := opts.ToSessionStartMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
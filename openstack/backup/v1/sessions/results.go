@@ -0,0 +1,178 @@
+package sessions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Session represents a group of jobs run together, e.g. on several hosts.
+type Session struct {
+	// SessionID is the unique ID of the session.
+	SessionID string `json:"session_id"`
+
+	// SessionTag is incremented at every run of the session.
+	SessionTag int `json:"session_tag"`
+
+	// Description is a description of the session.
+	Description string `json:"description"`
+
+	// HoldOff is the number of seconds the jobs of the session wait for
+	// each other before they are started.
+	HoldOff int `json:"hold_off"`
+
+	// Schedule describes when the jobs of the session are run.
+	Schedule Schedule `json:"schedule"`
+
+	// Jobs are the jobs of the session, keyed by job ID.
+	Jobs map[string]Job `json:"jobs"`
+
+	// Status is the status of the session, e.g. "active" or "running".
+	Status string `json:"status"`
+
+	// Result is the result of the last run, e.g. "success" or "fail".
+	Result string `json:"result"`
+
+	// TimeStarted is the Unix time the last run started at.
+	TimeStarted int `json:"time_started"`
+
+	// TimeEnded is the Unix time the last run ended at.
+	TimeEnded int `json:"time_ended"`
+
+	// UserID is the ID of the user owning the session.
+	UserID string `json:"user_id"`
+}
+
+// Schedule describes when the jobs of a session are run.
+type Schedule struct {
+	// ScheduleDate is the date of a session run once.
+	ScheduleDate string `json:"schedule_date"`
+
+	// ScheduleInterval is the interval between the runs of a periodic
+	// session.
+	ScheduleInterval string `json:"schedule_interval"`
+
+	// ScheduleStartDate is the date of the first run of a periodic session.
+	ScheduleStartDate string `json:"schedule_start_date"`
+
+	// ScheduleEndDate is the date after which a periodic session is not
+	// run.
+	ScheduleEndDate string `json:"schedule_end_date"`
+}
+
+// Job is the state of a job in a session.
+type Job struct {
+	// ClientID is the ID of the backup agent running the job.
+	ClientID string `json:"client_id"`
+
+	// Status is the status of the job.
+	Status string `json:"status"`
+
+	// Result is the result of the last run of the job.
+	Result string `json:"result"`
+
+	// TimeStarted is the Unix time the last run of the job started at.
+	TimeStarted int `json:"time_started"`
+
+	// TimeEnded is the Unix time the last run of the job ended at.
+	TimeEnded int `json:"time_ended"`
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Session.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a Session.
+func (r GetResult) Extract() (*Session, error) {
+	var s *Session
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+type idResult struct {
+	gophercloud.Result
+}
+
+// Extract returns the ID of the created or updated session.
+func (r idResult) Extract() (string, error) {
+	var s struct {
+		SessionID string `json:"session_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SessionID, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to retrieve the ID of the new session.
+type CreateResult struct {
+	idResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to retrieve the ID of the updated session.
+type UpdateResult struct {
+	idResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AddJobResult is the response from an AddJob operation. Call its
+// ExtractErr method to determine if the call succeeded or failed.
+type AddJobResult struct {
+	gophercloud.ErrResult
+}
+
+// RemoveJobResult is the response from a RemoveJob operation. Call its
+// ExtractErr method to determine if the call succeeded or failed.
+type RemoveJobResult struct {
+	gophercloud.ErrResult
+}
+
+// StartResponse is the outcome of a Start operation.
+type StartResponse struct {
+	// Result is "success" if a new run was started, or "running" if the
+	// session was already running.
+	Result string `json:"result"`
+
+	// SessionTag is the tag of the current run of the session.
+	SessionTag int `json:"session_tag"`
+}
+
+// StartResult is the response from a Start operation. Call its Extract
+// method to interpret it as a StartResponse.
+type StartResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a StartResult as a StartResponse.
+func (r StartResult) Extract() (*StartResponse, error) {
+	var s *StartResponse
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// SessionPage is a single page of Session results.
+type SessionPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a SessionPage contains any results.
+func (r SessionPage) IsEmpty() (bool, error) {
+	sessions, err := ExtractSessions(r)
+	return len(sessions) == 0, err
+}
+
+// ExtractSessions returns a slice of Sessions contained in a single page of
+// results.
+func ExtractSessions(r pagination.Page) ([]Session, error) {
+	var s struct {
+		Sessions []Session `json:"sessions"`
+	}
+	err := (r.(SessionPage)).ExtractInto(&s)
+	return s.Sessions, err
+}
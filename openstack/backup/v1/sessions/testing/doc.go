@@ -0,0 +1,2 @@
+// backup_sessions_v1
+package testing
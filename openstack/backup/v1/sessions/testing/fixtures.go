@@ -0,0 +1,209 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/sessions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const (
+	SessionID = "c1a9d0b5e2f44b7e9d8f7a6b5c4d3e2f"
+	JobID     = "a4fbe4b5c64e4ae3bd2a5df8e7e47e6f"
+)
+
+// SessionBody is the JSON representation of a single session.
+const SessionBody = `
+{
+  "session_id": "c1a9d0b5e2f44b7e9d8f7a6b5c4d3e2f",
+  "session_tag": 3,
+  "description": "Nightly backup of the database cluster",
+  "hold_off": 30,
+  "schedule": {
+    "schedule_interval": "1 days"
+  },
+  "jobs": {
+    "a4fbe4b5c64e4ae3bd2a5df8e7e47e6f": {
+      "client_id": "myproject_node1",
+      "status": "completed",
+      "result": "success",
+      "time_started": 1485354382,
+      "time_ended": 1485354442
+    }
+  },
+  "status": "active",
+  "result": "success",
+  "time_started": 1485354382,
+  "time_ended": 1485354442,
+  "user_id": "ac8a2f2b5a0c4b1c8a6ffa43a41c6f7d"
+}
+`
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`{"sessions": [%s]}`, SessionBody)
+
+// CreateRequest is a sample request to create a session.
+const CreateRequest = `
+{
+  "description": "Nightly backup of the database cluster",
+  "hold_off": 30,
+  "schedule": {
+    "schedule_interval": "1 days"
+  }
+}
+`
+
+// CreateResponse is a sample response to a Create call.
+const CreateResponse = `{"session_id": "c1a9d0b5e2f44b7e9d8f7a6b5c4d3e2f"}`
+
+// UpdateRequest is a sample request to update a session.
+const UpdateRequest = `
+{
+  "hold_off": 0
+}
+`
+
+// UpdateResponse is a sample response to an Update call.
+const UpdateResponse = `{"session_id": "c1a9d0b5e2f44b7e9d8f7a6b5c4d3e2f", "version": 2}`
+
+// StartRequest is a sample request to start a session.
+const StartRequest = `
+{
+  "start": {
+    "job_id": "a4fbe4b5c64e4ae3bd2a5df8e7e47e6f",
+    "current_tag": 3
+  }
+}
+`
+
+// StartResponse is a sample response to a Start call.
+const StartResponse = `{"result": "success", "session_tag": 4}`
+
+// FirstSession is the session described by SessionBody.
+var FirstSession = sessions.Session{
+	SessionID:   SessionID,
+	SessionTag:  3,
+	Description: "Nightly backup of the database cluster",
+	HoldOff:     30,
+	Schedule: sessions.Schedule{
+		ScheduleInterval: "1 days",
+	},
+	Jobs: map[string]sessions.Job{
+		JobID: {
+			ClientID:    "myproject_node1",
+			Status:      "completed",
+			Result:      "success",
+			TimeStarted: 1485354382,
+			TimeEnded:   1485354442,
+		},
+	},
+	Status:      "active",
+	Result:      "success",
+	TimeStarted: 1485354382,
+	TimeEnded:   1485354442,
+	UserID:      "ac8a2f2b5a0c4b1c8a6ffa43a41c6f7d",
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sessions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sessions/"+SessionID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, SessionBody)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sessions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sessions/"+SessionID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sessions/"+SessionID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleAddJobSuccessfully configures the test server to respond to an
+// AddJob request.
+func HandleAddJobSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sessions/"+SessionID+"/jobs/"+JobID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleRemoveJobSuccessfully configures the test server to respond to a
+// RemoveJob request.
+func HandleRemoveJobSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sessions/"+SessionID+"/jobs/"+JobID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleStartSuccessfully configures the test server to respond to a Start
+// request.
+func HandleStartSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sessions/"+SessionID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, StartRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, StartResponse)
+	})
+}
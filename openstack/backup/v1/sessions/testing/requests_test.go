@@ -0,0 +1,114 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/jobs"
+	"github.com/gophercloud/gophercloud/openstack/backup/v1/sessions"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListSessions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := sessions.List(fake.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := sessions.ExtractSessions(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []sessions.Session{FirstSession}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetSession(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := sessions.Get(fake.ServiceClient(), SessionID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstSession, actual)
+}
+
+func TestCreateSession(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := sessions.CreateOpts{
+		Description: "Nightly backup of the database cluster",
+		HoldOff:     30,
+		Schedule: &jobs.ScheduleOpts{
+			ScheduleInterval: "1 days",
+		},
+	}
+
+	actual, err := sessions.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, SessionID, actual)
+}
+
+func TestUpdateSession(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	holdOff := 0
+	updateOpts := sessions.UpdateOpts{
+		HoldOff: &holdOff,
+	}
+
+	actual, err := sessions.Update(fake.ServiceClient(), SessionID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, SessionID, actual)
+}
+
+func TestDeleteSession(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := sessions.Delete(fake.ServiceClient(), SessionID)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestAddJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAddJobSuccessfully(t)
+
+	err := sessions.AddJob(fake.ServiceClient(), SessionID, JobID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRemoveJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRemoveJobSuccessfully(t)
+
+	err := sessions.RemoveJob(fake.ServiceClient(), SessionID, JobID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestStartSession(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStartSuccessfully(t)
+
+	startOpts := sessions.StartOpts{
+		JobID:      JobID,
+		CurrentTag: 3,
+	}
+
+	actual, err := sessions.Start(fake.ServiceClient(), SessionID, startOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &sessions.StartResponse{Result: "success", SessionTag: 4}, actual)
+}
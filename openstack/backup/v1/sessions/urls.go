@@ -0,0 +1,41 @@
+package sessions
+
+import "github.com/gophercloud/gophercloud"
+
+const sessionsPath = "sessions"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(sessionsPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, sessionID string) string {
+	return c.ServiceURL(sessionsPath, sessionID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, sessionID string) string {
+	return resourceURL(c, sessionID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, sessionID string) string {
+	return resourceURL(c, sessionID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, sessionID string) string {
+	return resourceURL(c, sessionID)
+}
+
+func jobURL(c *gophercloud.ServiceClient, sessionID, jobID string) string {
+	return c.ServiceURL(sessionsPath, sessionID, "jobs", jobID)
+}
+
+func actionURL(c *gophercloud.ServiceClient, sessionID string) string {
+	return c.ServiceURL(sessionsPath, sessionID, "action")
+}
@@ -88,6 +88,19 @@ func (r AllocationPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r AllocationPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"allocations_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // ExtractAllocations interprets the results of a single page from a List() call,
 // producing a slice of Allocation entities.
 func ExtractAllocations(r pagination.Page) ([]Allocation, error) {
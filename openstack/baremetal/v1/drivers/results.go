@@ -151,6 +151,19 @@ func (r DriverPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r DriverPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"drivers_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // ExtractDrivers interprets the results of a single page from ListDrivers()
 // call, producing a slice of Driver entities.
 func ExtractDrivers(r pagination.Page) ([]Driver, error) {
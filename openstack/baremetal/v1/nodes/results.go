@@ -217,6 +217,19 @@ func (r NodePage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r NodePage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"nodes_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // ExtractNodes interprets the results of a single page from a List() call,
 // producing a slice of Node entities.
 func ExtractNodes(r pagination.Page) ([]Node, error) {
@@ -99,6 +99,19 @@ func (r PortPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r PortPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"ports_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // ExtractPorts interprets the results of a single page from a List() call,
 // producing a slice of Port entities.
 func ExtractPorts(r pagination.Page) ([]Port, error) {
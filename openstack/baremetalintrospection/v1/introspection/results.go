@@ -90,6 +90,19 @@ func (r IntrospectionPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r IntrospectionPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"introspection_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // UnmarshalJSON trie to convert values for started_at and finished_at from the
 // json response into RFC3339 standard. Since Introspection API can remove the
 // Z from the format, if the conversion fails, it falls back to an RFC3339
@@ -123,6 +123,19 @@ func (page BackupPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (page BackupPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"backups_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // ExtractBackups extracts and returns Backups. It is used while iterating over a backups.List call.
 func ExtractBackups(r pagination.Page) ([]Backup, error) {
 	var s []Backup
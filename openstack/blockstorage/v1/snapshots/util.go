@@ -1,11 +1,15 @@
 package snapshots
 
 import (
+	"strings"
+
 	"github.com/gophercloud/gophercloud"
 )
 
 // WaitForStatus will continually poll the resource, checking for a particular
-// status. It will do this for the amount of seconds defined.
+// status. It will do this for the amount of seconds defined. It fails early
+// with an ErrSnapshotFailed if the snapshot enters an error status, e.g.
+// "error" or "error_deleting".
 func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
 	return gophercloud.WaitFor(secs, func() (bool, error) {
 		current, err := Get(c, id).Extract()
@@ -17,6 +21,10 @@ func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) er
 			return true, nil
 		}
 
+		if strings.HasPrefix(current.Status, "error") {
+			return false, ErrSnapshotFailed{ID: id, Status: current.Status}
+		}
+
 		return false, nil
 	})
 }
@@ -0,0 +1,19 @@
+package volumes
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrVolumeFailed is returned by WaitForStatus when a volume enters an error
+// status.
+type ErrVolumeFailed struct {
+	gophercloud.BaseError
+	ID     string
+	Status string
+}
+
+func (e ErrVolumeFailed) Error() string {
+	return fmt.Sprintf("volume %s is in status %s", e.ID, e.Status)
+}
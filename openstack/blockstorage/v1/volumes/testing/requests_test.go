@@ -151,3 +151,17 @@ func TestUpdate(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, "vol-002", v.Name)
 }
+
+func TestWaitForStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetErrorResponse(t)
+
+	err := volumes.WaitForStatus(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", "available", 5)
+	th.AssertEquals(t, "volume d32019d3-bc6e-4319-9c1d-6722fc136a22 is in status error", err.Error())
+
+	failed, ok := err.(volumes.ErrVolumeFailed)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "error", failed.Status)
+}
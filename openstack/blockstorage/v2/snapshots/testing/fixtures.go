@@ -132,3 +132,22 @@ func MockDeleteResponse(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+func MockGetErrorResponse(t *testing.T) {
+	th.Mux.HandleFunc("/snapshots/d32019d3-bc6e-4319-9c1d-6722fc136a22", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "snapshot": {
+    "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "name": "snap-001",
+    "status": "error"
+  }
+}
+      `)
+	})
+}
@@ -114,3 +114,17 @@ func TestDelete(t *testing.T) {
 	res := snapshots.Delete(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestWaitForStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetErrorResponse(t)
+
+	err := snapshots.WaitForStatus(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", "available", 5)
+	th.AssertEquals(t, "snapshot d32019d3-bc6e-4319-9c1d-6722fc136a22 is in status error", err.Error())
+
+	failed, ok := err.(snapshots.ErrSnapshotFailed)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "error", failed.Status)
+}
@@ -201,3 +201,22 @@ func MockUpdateResponse(t *testing.T) {
         `)
 	})
 }
+
+func MockGetErrorResponse(t *testing.T) {
+	th.Mux.HandleFunc("/volumes/d32019d3-bc6e-4319-9c1d-6722fc136a22", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "volume": {
+    "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "name": "vol-001",
+    "status": "error"
+  }
+}
+      `)
+	})
+}
@@ -256,3 +256,17 @@ func TestGetWithExtensions(t *testing.T) {
 		t.Errorf("Expected error when providing non-pointer struct")
 	}
 }
+
+func TestWaitForStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetErrorResponse(t)
+
+	err := volumes.WaitForStatus(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", "available", 5)
+	th.AssertEquals(t, "volume d32019d3-bc6e-4319-9c1d-6722fc136a22 is in status error", err.Error())
+
+	failed, ok := err.(volumes.ErrVolumeFailed)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "error", failed.Status)
+}
@@ -0,0 +1,19 @@
+package snapshots
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrSnapshotFailed is returned by WaitForStatus when a snapshot enters an error
+// status.
+type ErrSnapshotFailed struct {
+	gophercloud.BaseError
+	ID     string
+	Status string
+}
+
+func (e ErrSnapshotFailed) Error() string {
+	return fmt.Sprintf("snapshot %s is in status %s", e.ID, e.Status)
+}
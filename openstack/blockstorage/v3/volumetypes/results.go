@@ -45,6 +45,19 @@ func (page VolumeTypePage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (page VolumeTypePage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"volume_type_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // ExtractVolumeTypes extracts and returns Volumes. It is used while iterating over a volumetypes.List call.
 func ExtractVolumeTypes(r pagination.Page) ([]VolumeType, error) {
 	var s []VolumeType
@@ -483,3 +483,53 @@ func NewWorkflowV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 func NewPlacementV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	return initClientOpts(client, eo, "placement")
 }
+
+// NewMetricV1 creates a ServiceClient that may be used with the v1 metric
+// package.
+func NewMetricV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "metric")
+	sc.ResourceBase = sc.Endpoint + "v1/"
+	return sc, err
+}
+
+// NewAlarmingV2 creates a ServiceClient that may be used with the v2 alarming
+// package.
+func NewAlarmingV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "alarming")
+	sc.ResourceBase = sc.Endpoint + "v2/"
+	return sc, err
+}
+
+// NewReservationV1 creates a ServiceClient that may be used with the v1
+// reservation package.
+func NewReservationV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
+	return initClientOpts(client, eo, "reservation")
+}
+
+// NewInstanceHAV1 creates a ServiceClient that may be used with the v1
+// instance HA package.
+func NewInstanceHAV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
+	return initClientOpts(client, eo, "instance-ha")
+}
+
+// NewDataProcessingV1 creates a ServiceClient that may be used with the v1
+// data processing package.
+func NewDataProcessingV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
+	return initClientOpts(client, eo, "data-processing")
+}
+
+// NewBackupV1 creates a ServiceClient that may be used with the v1 backup
+// package.
+func NewBackupV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "backup")
+	sc.ResourceBase = sc.Endpoint + "v1/"
+	return sc, err
+}
+
+// NewInfraOptimV1 creates a ServiceClient that may be used with the v1
+// infrastructure optimization package.
+func NewInfraOptimV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "infra-optim")
+	sc.ResourceBase = sc.Endpoint + "v1/"
+	return sc, err
+}
@@ -237,6 +237,8 @@ type ScaleInOptsBuilder interface {
 
 // ScaleInOpts represents options used to scale-in a cluster.
 type ScaleInOpts struct {
+	// Count is the number of nodes to remove. If omitted, the cluster's
+	// scaling policies decide how many nodes are removed.
 	Count *int `json:"count,omitempty"`
 }
 
@@ -271,6 +273,8 @@ type ScaleOutOptsBuilder interface {
 
 // ScaleOutOpts represents options used to scale-out a cluster.
 type ScaleOutOpts struct {
+	// Count is the number of nodes to add. If omitted, the cluster's
+	// scaling policies decide how many nodes are added.
 	Count int `json:"count,omitempty"`
 }
 
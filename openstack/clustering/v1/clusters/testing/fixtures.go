@@ -342,6 +342,25 @@ const ActionResponse = `
 
 const ExpectedActionID = "2a0ff107-e789-4660-a122-3816c43af703"
 
+const ScaleInRequest = `
+{
+  "scale_in": {
+    "count": 5
+  }
+}`
+
+const ScaleInNoCountRequest = `
+{
+  "scale_in": {}
+}`
+
+const ScaleOutRequest = `
+{
+  "scale_out": {
+    "count": 5
+  }
+}`
+
 const OperationActionResponse = `
 {
   "action": "2a0ff107-e789-4660-a122-3816c43af703"
@@ -528,6 +547,7 @@ func HandleScaleInSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/v1/clusters/edce3528-864f-41fb-8759-f4707925cc09/actions", func(w http.ResponseWriter, r *http.Request) {
 		th.TestMethod(t, r, "POST")
 		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, ScaleInRequest)
 
 		w.Header().Add("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -540,6 +560,20 @@ func HandleScaleOutSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/v1/clusters/edce3528-864f-41fb-8759-f4707925cc09/actions", func(w http.ResponseWriter, r *http.Request) {
 		th.TestMethod(t, r, "POST")
 		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, ScaleOutRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ActionResponse)
+	})
+}
+
+func HandleScaleInNoCountSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v1/clusters/edce3528-864f-41fb-8759-f4707925cc09/actions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, ScaleInNoCountRequest)
 
 		w.Header().Add("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -290,6 +290,17 @@ func TestClusterScaleIn(t *testing.T) {
 	th.AssertEquals(t, ExpectedActionID, actionID)
 }
 
+func TestClusterScaleInNoCount(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleScaleInNoCountSuccessfully(t)
+
+	actionID, err := clusters.ScaleIn(fake.ServiceClient(), "edce3528-864f-41fb-8759-f4707925cc09", clusters.ScaleInOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ExpectedActionID, actionID)
+}
+
 func TestListClusterPolicies(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -0,0 +1,29 @@
+/*
+Package quotareport aggregates the quotas and usage of a project across the
+Compute, Block Storage and Networking services into a single report, e.g. for
+chargeback or capacity dashboards.
+
+Example to Get a Quota Report
+
+	clients := quotareport.Clients{
+		Compute:      computeClient,
+		BlockStorage: blockStorageClient,
+		Network:      networkClient,
+	}
+
+	report, err := quotareport.Get(clients, projectID)
+	if err != nil {
+		panic(err)
+	}
+
+	cores := report.Compute["cores"]
+	fmt.Printf("cores: %d of %d in use\n", cores.InUse, cores.Limit)
+
+	for resource, usage := range report.Network {
+		if usage.Unlimited() {
+			continue
+		}
+		fmt.Printf("%s: %d free\n", resource, usage.Free())
+	}
+*/
+package quotareport
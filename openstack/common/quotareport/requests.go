@@ -0,0 +1,111 @@
+package quotareport
+
+import (
+	"github.com/gophercloud/gophercloud"
+	blockstoragequotas "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	computequotas "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
+	networkquotas "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+)
+
+// Clients holds the service clients used to build a Report. Services whose
+// client is nil are skipped.
+type Clients struct {
+	// Compute is a Compute v2 client.
+	Compute *gophercloud.ServiceClient
+
+	// BlockStorage is a Block Storage v2 or v3 client.
+	BlockStorage *gophercloud.ServiceClient
+
+	// Network is a Networking v2 client.
+	Network *gophercloud.ServiceClient
+}
+
+// Get retrieves the quotas and usage of a project from each service of
+// clients and combines them into a Report.
+func Get(clients Clients, projectID string) (*Report, error) {
+	report := &Report{ProjectID: projectID}
+
+	if clients.Compute != nil {
+		q, err := computequotas.GetDetail(clients.Compute, projectID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		report.Compute = computeUsage(q)
+	}
+
+	if clients.BlockStorage != nil {
+		q, err := blockstoragequotas.GetUsage(clients.BlockStorage, projectID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		report.BlockStorage = blockStorageUsage(q)
+	}
+
+	if clients.Network != nil {
+		q, err := networkquotas.GetDetail(clients.Network, projectID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		report.Network = networkUsage(q)
+	}
+
+	return report, nil
+}
+
+func computeUsage(q computequotas.QuotaDetailSet) map[string]Usage {
+	usage := func(d computequotas.QuotaDetail) Usage {
+		return Usage{Limit: d.Limit, InUse: d.InUse, Reserved: d.Reserved}
+	}
+
+	return map[string]Usage{
+		"cores":                       usage(q.Cores),
+		"fixed_ips":                   usage(q.FixedIPs),
+		"floating_ips":                usage(q.FloatingIPs),
+		"injected_file_content_bytes": usage(q.InjectedFileContentBytes),
+		"injected_file_path_bytes":    usage(q.InjectedFilePathBytes),
+		"injected_files":              usage(q.InjectedFiles),
+		"instances":                   usage(q.Instances),
+		"key_pairs":                   usage(q.KeyPairs),
+		"metadata_items":              usage(q.MetadataItems),
+		"ram":                         usage(q.RAM),
+		"security_group_rules":        usage(q.SecurityGroupRules),
+		"security_groups":             usage(q.SecurityGroups),
+		"server_group_members":        usage(q.ServerGroupMembers),
+		"server_groups":               usage(q.ServerGroups),
+	}
+}
+
+func blockStorageUsage(q blockstoragequotas.QuotaUsageSet) map[string]Usage {
+	usage := func(u blockstoragequotas.QuotaUsage) Usage {
+		return Usage{Limit: u.Limit, InUse: u.InUse, Reserved: u.Reserved}
+	}
+
+	return map[string]Usage{
+		"backup_gigabytes":     usage(q.BackupGigabytes),
+		"backups":              usage(q.Backups),
+		"gigabytes":            usage(q.Gigabytes),
+		"groups":               usage(q.Groups),
+		"per_volume_gigabytes": usage(q.PerVolumeGigabytes),
+		"snapshots":            usage(q.Snapshots),
+		"volumes":              usage(q.Volumes),
+	}
+}
+
+func networkUsage(q *networkquotas.QuotaDetailSet) map[string]Usage {
+	usage := func(d networkquotas.QuotaDetail) Usage {
+		return Usage{Limit: d.Limit, InUse: d.Used, Reserved: d.Reserved}
+	}
+
+	return map[string]Usage{
+		"floatingip":          usage(q.FloatingIP),
+		"network":             usage(q.Network),
+		"port":                usage(q.Port),
+		"rbac_policy":         usage(q.RBACPolicy),
+		"router":              usage(q.Router),
+		"security_group":      usage(q.SecurityGroup),
+		"security_group_rule": usage(q.SecurityGroupRule),
+		"subnet":              usage(q.Subnet),
+		"subnetpool":          usage(q.SubnetPool),
+		"trunk":               usage(q.Trunk),
+	}
+}
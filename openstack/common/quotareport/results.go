@@ -0,0 +1,51 @@
+package quotareport
+
+// Usage is the limit and usage of a single resource.
+type Usage struct {
+	// Limit is the maximum amount of the resource. A value of -1 means no
+	// limit.
+	Limit int
+
+	// InUse is the amount of the resource in use.
+	InUse int
+
+	// Reserved is the amount of the resource claimed but not yet in use.
+	Reserved int
+}
+
+// Unlimited reports whether the resource has no limit.
+func (u Usage) Unlimited() bool {
+	return u.Limit < 0
+}
+
+// Free returns the amount of the resource which can still be used. It is
+// -1 for unlimited resources.
+func (u Usage) Free() int {
+	if u.Unlimited() {
+		return -1
+	}
+	free := u.Limit - u.InUse - u.Reserved
+	if free < 0 {
+		return 0
+	}
+	return free
+}
+
+// Report is the quota report of a project. Its maps are keyed by the
+// resource names of the respective service APIs, e.g. "cores" or "ram" for
+// Compute, "volumes" or "gigabytes" for Block Storage and "port" or
+// "floatingip" for Networking. The map of a service which was not queried is
+// nil.
+type Report struct {
+	// ProjectID is the ID of the project.
+	ProjectID string
+
+	// Compute is the Compute quota usage of the project.
+	Compute map[string]Usage
+
+	// BlockStorage is the Block Storage quota usage of the project.
+	BlockStorage map[string]Usage
+
+	// Network is the Networking quota usage of the project.
+	Network map[string]Usage
+}
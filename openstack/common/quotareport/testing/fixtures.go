@@ -0,0 +1,82 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const projectID = "c7f1b4a1f2c4462ab6d5dc5e8a3bdfe3"
+
+// ComputeResponse is a trimmed Compute quota detail response.
+const ComputeResponse = `
+{
+  "quota_set": {
+    "id": "c7f1b4a1f2c4462ab6d5dc5e8a3bdfe3",
+    "cores": {"in_use": 4, "reserved": 0, "limit": 20},
+    "instances": {"in_use": 2, "reserved": 1, "limit": 10},
+    "ram": {"in_use": 8192, "reserved": 0, "limit": -1}
+  }
+}
+`
+
+// BlockStorageResponse is a trimmed Block Storage quota usage response.
+const BlockStorageResponse = `
+{
+  "quota_set": {
+    "id": "c7f1b4a1f2c4462ab6d5dc5e8a3bdfe3",
+    "volumes": {"in_use": 3, "allocated": 0, "reserved": 0, "limit": 10},
+    "gigabytes": {"in_use": 120, "allocated": 0, "reserved": 10, "limit": 100}
+  }
+}
+`
+
+// NetworkResponse is a trimmed Networking quota detail response.
+const NetworkResponse = `
+{
+  "quota": {
+    "network": {"used": 1, "reserved": 0, "limit": 100},
+    "port": {"used": 5, "reserved": 2, "limit": 50}
+  }
+}
+`
+
+func handleJSON(t *testing.T, path, body string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, body)
+	})
+}
+
+// HandleComputeSuccessfully registers a handler for the Compute quota detail
+// of the test project.
+func HandleComputeSuccessfully(t *testing.T) {
+	handleJSON(t, "/os-quota-sets/"+projectID+"/detail", ComputeResponse)
+}
+
+// HandleBlockStorageSuccessfully registers a handler for the Block Storage
+// quota usage of the test project.
+func HandleBlockStorageSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-sets/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"usage": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, BlockStorageResponse)
+	})
+}
+
+// HandleNetworkSuccessfully registers a handler for the Networking quota
+// detail of the test project.
+func HandleNetworkSuccessfully(t *testing.T) {
+	handleJSON(t, "/v2.0/quotas/"+projectID+"/details.json", NetworkResponse)
+}
@@ -0,0 +1,61 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/common/quotareport"
+	networkingfake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleComputeSuccessfully(t)
+	HandleBlockStorageSuccessfully(t)
+	HandleNetworkSuccessfully(t)
+
+	clients := quotareport.Clients{
+		Compute:      client.ServiceClient(),
+		BlockStorage: client.ServiceClient(),
+		Network:      networkingfake.ServiceClient(),
+	}
+
+	report, err := quotareport.Get(clients, projectID)
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, projectID, report.ProjectID)
+	th.CheckEquals(t, 14, len(report.Compute))
+	th.CheckEquals(t, 7, len(report.BlockStorage))
+	th.CheckEquals(t, 10, len(report.Network))
+
+	th.CheckDeepEquals(t, quotareport.Usage{Limit: 20, InUse: 4}, report.Compute["cores"])
+	th.CheckDeepEquals(t, quotareport.Usage{Limit: 10, InUse: 2, Reserved: 1}, report.Compute["instances"])
+	th.CheckDeepEquals(t, quotareport.Usage{Limit: 100, InUse: 120, Reserved: 10}, report.BlockStorage["gigabytes"])
+	th.CheckDeepEquals(t, quotareport.Usage{Limit: 50, InUse: 5, Reserved: 2}, report.Network["port"])
+}
+
+func TestGetSkipsServices(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNetworkSuccessfully(t)
+
+	clients := quotareport.Clients{
+		Network: networkingfake.ServiceClient(),
+	}
+
+	report, err := quotareport.Get(clients, projectID)
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, true, report.Compute == nil)
+	th.CheckEquals(t, true, report.BlockStorage == nil)
+	th.CheckDeepEquals(t, quotareport.Usage{Limit: 100, InUse: 1}, report.Network["network"])
+}
+
+func TestUsageFree(t *testing.T) {
+	th.CheckEquals(t, 7, quotareport.Usage{Limit: 10, InUse: 2, Reserved: 1}.Free())
+	th.CheckEquals(t, 0, quotareport.Usage{Limit: 100, InUse: 120}.Free())
+	th.CheckEquals(t, -1, quotareport.Usage{Limit: -1, InUse: 8192}.Free())
+	th.CheckEquals(t, true, quotareport.Usage{Limit: -1}.Unlimited())
+}
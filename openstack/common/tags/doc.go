@@ -0,0 +1,42 @@
+/*
+Package tags provides a common interface to the tags of resources of several
+OpenStack services, so that tools can tag resources uniformly.
+
+A Tagger is available for:
+
+  - Compute servers, through the compute tags extension. It requires
+    microversion 2.26 or later on the compute client.
+  - Networking resources which support the standard attribute tags
+    extension, e.g. "networks", "ports", "subnets", "routers",
+    "security-groups", "floatingips", "subnetpools" or "trunks".
+
+The Block Storage and DNS services have no tag API; their resources are
+labelled with metadata and descriptions respectively, which this package
+does not emulate.
+
+Example to Tag Resources of Several Services
+
+	computeClient.Microversion = "2.26"
+
+	taggers := map[string]tags.Tagger{
+		serverID:  tags.NewComputeTagger(computeClient),
+		networkID: tags.NewNetworkingTagger(networkClient, "networks"),
+	}
+
+	for id, tagger := range taggers {
+		err := tagger.Add(id, "inventory:checked")
+		if err != nil {
+			panic(err)
+		}
+	}
+
+Example to Replace the Tags of a Port
+
+	tagger := tags.NewNetworkingTagger(networkClient, "ports")
+
+	allTags, err := tagger.Replace(portID, []string{"web", "production"})
+	if err != nil {
+		panic(err)
+	}
+*/
+package tags
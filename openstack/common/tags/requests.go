@@ -0,0 +1,84 @@
+package tags
+
+import (
+	"github.com/gophercloud/gophercloud"
+	computetags "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/tags"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+)
+
+// Tagger manages the tags of the resources of a service.
+type Tagger interface {
+	// List returns the tags of a resource.
+	List(resourceID string) ([]string, error)
+
+	// Add adds a tag to a resource. Adding an existing tag succeeds.
+	Add(resourceID, tag string) error
+
+	// Remove removes a tag from a resource.
+	Remove(resourceID, tag string) error
+
+	// Replace replaces all tags of a resource and returns the new tags. An
+	// empty slice removes all tags.
+	Replace(resourceID string, tags []string) ([]string, error)
+}
+
+// NewComputeTagger returns a Tagger for compute servers. The client must use
+// microversion 2.26 or later.
+func NewComputeTagger(client *gophercloud.ServiceClient) Tagger {
+	return computeTagger{client: client}
+}
+
+type computeTagger struct {
+	client *gophercloud.ServiceClient
+}
+
+func (t computeTagger) List(serverID string) ([]string, error) {
+	return computetags.List(t.client, serverID).Extract()
+}
+
+func (t computeTagger) Add(serverID, tag string) error {
+	return computetags.Add(t.client, serverID, tag).ExtractErr()
+}
+
+func (t computeTagger) Remove(serverID, tag string) error {
+	return computetags.Delete(t.client, serverID, tag).ExtractErr()
+}
+
+func (t computeTagger) Replace(serverID string, tags []string) ([]string, error) {
+	if tags == nil {
+		tags = []string{}
+	}
+	opts := computetags.ReplaceAllOpts{Tags: tags}
+	return computetags.ReplaceAll(t.client, serverID, opts).Extract()
+}
+
+// NewNetworkingTagger returns a Tagger for networking resources of the given
+// type, e.g. "networks" or "ports".
+func NewNetworkingTagger(client *gophercloud.ServiceClient, resourceType string) Tagger {
+	return networkingTagger{client: client, resourceType: resourceType}
+}
+
+type networkingTagger struct {
+	client       *gophercloud.ServiceClient
+	resourceType string
+}
+
+func (t networkingTagger) List(resourceID string) ([]string, error) {
+	return attributestags.List(t.client, t.resourceType, resourceID).Extract()
+}
+
+func (t networkingTagger) Add(resourceID, tag string) error {
+	return attributestags.Add(t.client, t.resourceType, resourceID, tag).ExtractErr()
+}
+
+func (t networkingTagger) Remove(resourceID, tag string) error {
+	return attributestags.Delete(t.client, t.resourceType, resourceID, tag).ExtractErr()
+}
+
+func (t networkingTagger) Replace(resourceID string, tags []string) ([]string, error) {
+	if tags == nil {
+		tags = []string{}
+	}
+	opts := attributestags.ReplaceAllOpts{Tags: tags}
+	return attributestags.ReplaceAll(t.client, t.resourceType, resourceID, opts).Extract()
+}
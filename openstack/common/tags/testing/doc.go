@@ -0,0 +1,2 @@
+// common tags unit tests
+package testing
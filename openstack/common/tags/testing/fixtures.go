@@ -0,0 +1,49 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// TagsResponse is the response of a List or Replace request.
+const TagsResponse = `
+{
+  "tags": ["foo", "bar"]
+}
+`
+
+// HandleTagsSuccessfully sets up the test server to respond to the tag
+// requests of the resource at path.
+func HandleTagsSuccessfully(t *testing.T, path string) {
+	th.Mux.HandleFunc(path+"/tags", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			th.TestJSONRequest(t, r, `{"tags": []}`)
+		default:
+			t.Fatalf("Unexpected method %s", r.Method)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, TagsResponse)
+	})
+
+	th.Mux.HandleFunc(path+"/tags/foo", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case http.MethodPut:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("Unexpected method %s", r.Method)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/common/tags"
+	networkingfake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func testTagger(t *testing.T, tagger tags.Tagger) {
+	actual, err := tagger.List("uuid1")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"foo", "bar"}, actual)
+
+	err = tagger.Add("uuid1", "foo")
+	th.AssertNoErr(t, err)
+
+	err = tagger.Remove("uuid1", "foo")
+	th.AssertNoErr(t, err)
+
+	actual, err = tagger.Replace("uuid1", nil)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"foo", "bar"}, actual)
+}
+
+func TestComputeTagger(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTagsSuccessfully(t, "/servers/uuid1")
+
+	testTagger(t, tags.NewComputeTagger(client.ServiceClient()))
+}
+
+func TestNetworkingTagger(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTagsSuccessfully(t, "/v2.0/ports/uuid1")
+
+	testTagger(t, tags.NewNetworkingTagger(networkingfake.ServiceClient(), "ports"))
+}
@@ -11,10 +11,10 @@ import (
 // SingleTenantOpts are options for fetching usage of a single tenant.
 type SingleTenantOpts struct {
 	// The ending time to calculate usage statistics on compute and storage resources.
-	End *time.Time `q:"end"`
+	End *time.Time `q:"end" format:"2006-01-02T15:04:05.999999"`
 
 	// The beginning time to calculate usage statistics on compute and storage resources.
-	Start *time.Time `q:"start"`
+	Start *time.Time `q:"start" format:"2006-01-02T15:04:05.999999"`
 
 	// Limit limits the amount of results returned by the API.
 	// This requires the client to be set to microversion 2.40 or later.
@@ -37,18 +37,6 @@ func (opts SingleTenantOpts) ToUsageSingleTenantQuery() (string, error) {
 	if err != nil {
 		return "", err
 	}
-
-	params := q.Query()
-
-	if opts.Start != nil {
-		params.Add("start", opts.Start.Format(gophercloud.RFC3339MilliNoZ))
-	}
-
-	if opts.End != nil {
-		params.Add("end", opts.End.Format(gophercloud.RFC3339MilliNoZ))
-	}
-
-	q = &url.URL{RawQuery: params.Encode()}
 	return q.String(), nil
 }
 
@@ -73,10 +61,10 @@ type AllTenantsOpts struct {
 	Detailed bool
 
 	// The ending time to calculate usage statistics on compute and storage resources.
-	End *time.Time `q:"end"`
+	End *time.Time `q:"end" format:"2006-01-02T15:04:05.999999"`
 
 	// The beginning time to calculate usage statistics on compute and storage resources.
-	Start *time.Time `q:"start"`
+	Start *time.Time `q:"start" format:"2006-01-02T15:04:05.999999"`
 
 	// Limit limits the amount of results returned by the API.
 	// This requires the client to be set to microversion 2.40 or later.
@@ -102,14 +90,6 @@ func (opts AllTenantsOpts) ToUsageAllTenantsQuery() (string, error) {
 
 	params := q.Query()
 
-	if opts.Start != nil {
-		params.Add("start", opts.Start.Format(gophercloud.RFC3339MilliNoZ))
-	}
-
-	if opts.End != nil {
-		params.Add("end", opts.End.Format(gophercloud.RFC3339MilliNoZ))
-	}
-
 	if opts.Detailed == true {
 		params.Add("detailed", "1")
 	}
@@ -139,6 +139,19 @@ func (r SingleTenantPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r SingleTenantPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"tenant_usage_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // ExtractSingleTenant interprets a SingleTenantPage as a TenantUsage result.
 func ExtractSingleTenant(page pagination.Page) (*TenantUsage, error) {
 	var s struct {
@@ -2,6 +2,7 @@ package testing
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/usage"
 	"github.com/gophercloud/gophercloud/pagination"
@@ -50,3 +51,16 @@ func TestAllTenants(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, count, 1)
 }
+
+func TestUsageQuery(t *testing.T) {
+	start := time.Date(2017, 11, 2, 3, 25, 1, 0, time.UTC)
+	end := time.Date(2017, 11, 30, 3, 25, 1, 500000000, time.UTC)
+
+	query, err := usage.SingleTenantOpts{Start: &start, End: &end}.ToUsageSingleTenantQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?end=2017-11-30T03%3A25%3A01.5&start=2017-11-02T03%3A25%3A01", query)
+
+	query, err = usage.AllTenantsOpts{Detailed: true, Start: &start}.ToUsageAllTenantsQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?detailed=1&start=2017-11-02T03%3A25%3A01", query)
+}
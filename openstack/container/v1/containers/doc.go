@@ -0,0 +1,87 @@
+/*
+Package containers contains functionality for working with Zun container
+resources.
+
+Example to Run a Container
+
+	createOpts := containers.CreateOpts{
+		Name:    "web",
+		Image:   "nginx",
+		Command: []string{"nginx", "-g", "daemon off;"},
+		Memory:  512,
+		Run:     true,
+	}
+
+	container, err := containers.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Containers
+
+	listOpts := containers.ListOpts{
+		Status: "Running",
+	}
+
+	allPages, err := containers.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allContainers, err := containers.ExtractContainers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, container := range allContainers {
+		fmt.Printf("%+v\n", container)
+	}
+
+Example to Get the Logs of a Container
+
+	tail := "50"
+	logs, err := containers.Logs(client, containerID, containers.LogsOpts{
+		Tail: tail,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(logs)
+
+Example to Execute a Command in a Container
+
+	executeOpts := containers.ExecuteOpts{
+		Command: "ls /",
+	}
+
+	output, err := containers.Execute(client, containerID, executeOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("exit code %d: %s\n", output.ExitCode, output.Output)
+
+Example to Kill a Container
+
+	killOpts := containers.KillOpts{
+		Signal: "SIGTERM",
+	}
+
+	err := containers.Kill(client, containerID, killOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Container
+
+	deleteOpts := containers.DeleteOpts{
+		Stop: true,
+	}
+
+	err := containers.Delete(client, containerID, deleteOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package containers
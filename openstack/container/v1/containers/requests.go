@@ -0,0 +1,334 @@
+package containers
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToContainerCreateMap() (map[string]interface{}, error)
+	ToContainerCreateQuery() (string, error)
+}
+
+// CreateOpts is the common options struct used in this package's Create
+// operation.
+type CreateOpts struct {
+	// Run starts the container right after it was created.
+	Run bool `json:"-" q:"run"`
+
+	// The name of the container.
+	Name string `json:"name,omitempty"`
+
+	// The name or ID of the image.
+	Image string `json:"image" required:"true"`
+
+	// The command to run in the container.
+	// Requires microversion 1.20 or above.
+	Command []string `json:"command,omitempty"`
+
+	// The overwritten entrypoint of the image.
+	Entrypoint []string `json:"entrypoint,omitempty"`
+
+	// The number of virtual cpus.
+	CPU float64 `json:"cpu,omitempty"`
+
+	// The container memory size in MiB.
+	Memory int `json:"memory,omitempty"`
+
+	// The environment variables to set in the container.
+	Environment map[string]string `json:"environment,omitempty"`
+
+	// Labels to attach to the container.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// The working directory for commands to run in.
+	WorkDir string `json:"workdir,omitempty"`
+
+	// The policy which determines if the image should be pulled prior to
+	// starting the container. One of "ifnotpresent", "always" or "never".
+	ImagePullPolicy string `json:"image_pull_policy,omitempty"`
+
+	// The image driver to use to pull the image, e.g. "docker" or "glance".
+	ImageDriver string `json:"image_driver,omitempty"`
+
+	// Restart policy to apply when the container exits, e.g.
+	// {"Name": "on-failure", "MaximumRetryCount": "3"}.
+	RestartPolicy map[string]string `json:"restart_policy,omitempty"`
+
+	// Keep STDIN open even if not attached, allocate a pseudo-TTY.
+	Interactive bool `json:"interactive,omitempty"`
+
+	// The security groups of the container.
+	SecurityGroups []string `json:"security_groups,omitempty"`
+
+	// The networks the container connects to, e.g.
+	// [{"network": "private"}].
+	Nets []map[string]string `json:"nets,omitempty"`
+
+	// The container runtime tool to create the container with.
+	Runtime string `json:"runtime,omitempty"`
+
+	// The hostname of the container.
+	Hostname string `json:"hostname,omitempty"`
+
+	// Remove the container automatically when it exits.
+	AutoRemove bool `json:"auto_remove,omitempty"`
+
+	// Restart the container automatically when it exits unexpectedly.
+	AutoHeal bool `json:"auto_heal,omitempty"`
+
+	// The availability zone to create the container in.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+
+	// Arbitrary key-value pairs for scheduling the container.
+	Hints map[string]string `json:"hints,omitempty"`
+
+	// Give extended privileges to the container.
+	Privileged bool `json:"privileged,omitempty"`
+}
+
+// ToContainerCreateMap assembles a request body based on the contents of
+// a CreateOpts.
+func (opts CreateOpts) ToContainerCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ToContainerCreateQuery formats a CreateOpts into a query string.
+func (opts CreateOpts) ToContainerCreateQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Create requests the creation of a container. Set Run to also start it.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToContainerCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	query, err := opts.ToContainerCreateQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client)+query, b, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{202}})
+	return
+}
+
+// Get requests details on a single container, by ID or name.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 203},
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToContainerListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the container attributes you want to see returned. Marker and Limit are used
+// for pagination.
+type ListOpts struct {
+	Marker      string `q:"marker"`
+	Limit       int    `q:"limit"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+	AllProjects bool   `q:"all_projects"`
+	Name        string `q:"name"`
+	Image       string `q:"image"`
+	Status      string `q:"status"`
+	Host        string `q:"host"`
+	ProjectID   string `q:"project_id"`
+	UserID      string `q:"user_id"`
+}
+
+// ToContainerListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToContainerListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List makes a request against the API to list containers accessible to you.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToContainerListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ContainerPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// DeleteOptsBuilder allows extensions to add additional parameters to the
+// Delete request.
+type DeleteOptsBuilder interface {
+	ToContainerDeleteQuery() (string, error)
+}
+
+// DeleteOpts specifies how a container is deleted.
+type DeleteOpts struct {
+	// Force deletes the container even if it is running.
+	Force bool `q:"force"`
+
+	// Stop stops the container before deleting it.
+	Stop bool `q:"stop"`
+
+	// AllProjects allows administrators to delete containers of other
+	// projects.
+	AllProjects bool `q:"all_projects"`
+}
+
+// ToContainerDeleteQuery formats a DeleteOpts into a query string.
+func (opts DeleteOpts) ToContainerDeleteQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Delete implements container delete request. Opts may be nil.
+func Delete(client *gophercloud.ServiceClient, id string, opts DeleteOptsBuilder) (r DeleteResult) {
+	url := deleteURL(client, id)
+	if opts != nil {
+		query, err := opts.ToContainerDeleteQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Delete(url, nil)
+	return
+}
+
+// LogsOptsBuilder allows extensions to add additional parameters to the
+// Logs request.
+type LogsOptsBuilder interface {
+	ToContainerLogsQuery() (string, error)
+}
+
+// LogsOpts specifies which logs of a container are retrieved.
+type LogsOpts struct {
+	// Stdout includes the standard output. Defaults to true.
+	Stdout *bool `q:"stdout"`
+
+	// Stderr includes the standard error. Defaults to true.
+	Stderr *bool `q:"stderr"`
+
+	// Timestamps prefixes every line with its timestamp.
+	Timestamps bool `q:"timestamps"`
+
+	// Tail is the number of lines to show from the end of the logs,
+	// or "all".
+	Tail string `q:"tail"`
+
+	// Since shows logs since a given UNIX timestamp or datetime.
+	Since string `q:"since"`
+}
+
+// ToContainerLogsQuery formats a LogsOpts into a query string.
+func (opts LogsOpts) ToContainerLogsQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Logs retrieves the logs of a container. Opts may be nil.
+func Logs(client *gophercloud.ServiceClient, id string, opts LogsOptsBuilder) (r LogsResult) {
+	url := logsURL(client, id)
+	if opts != nil {
+		query, err := opts.ToContainerLogsQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// ExecuteOptsBuilder allows extensions to add additional parameters to the
+// Execute request.
+type ExecuteOptsBuilder interface {
+	ToContainerExecuteQuery() (string, error)
+}
+
+// ExecuteOpts specifies the command to execute in a container.
+type ExecuteOpts struct {
+	// Command is the command to execute.
+	Command string `q:"command" required:"true"`
+
+	// Run executes the command immediately and returns its output.
+	// Defaults to true.
+	Run *bool `q:"run"`
+
+	// Interactive keeps STDIN open and allocates a pseudo-TTY.
+	Interactive bool `q:"interactive"`
+}
+
+// ToContainerExecuteQuery formats an ExecuteOpts into a query string.
+func (opts ExecuteOpts) ToContainerExecuteQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// Execute runs a command inside a running container.
+func Execute(client *gophercloud.ServiceClient, id string, opts ExecuteOptsBuilder) (r ExecuteResult) {
+	query, err := opts.ToContainerExecuteQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(executeURL(client, id)+query, nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// KillOptsBuilder allows extensions to add additional parameters to the
+// Kill request.
+type KillOptsBuilder interface {
+	ToContainerKillQuery() (string, error)
+}
+
+// KillOpts specifies the signal sent to a container.
+type KillOpts struct {
+	// Signal is the signal to send, e.g. "SIGKILL" or "SIGTERM".
+	// Defaults to SIGKILL.
+	Signal string `q:"signal"`
+}
+
+// ToContainerKillQuery formats a KillOpts into a query string.
+func (opts KillOpts) ToContainerKillQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Kill sends a signal to a running container. Opts may be nil.
+func Kill(client *gophercloud.ServiceClient, id string, opts KillOptsBuilder) (r KillResult) {
+	url := killURL(client, id)
+	if opts != nil {
+		query, err := opts.ToContainerKillQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Post(url, nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,280 @@
+package containers
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a container
+// resource.
+func (r commonResult) Extract() (*Container, error) {
+	var s *Container
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Container.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// KillResult represents the result of a kill operation.
+type KillResult struct {
+	gophercloud.ErrResult
+}
+
+// LogsResult represents the result of a logs operation. Call its Extract
+// method to interpret it as a string.
+type LogsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a LogsResult as the logs of a container.
+func (r LogsResult) Extract() (string, error) {
+	var s string
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ExecuteResult represents the result of an execute operation. Call its
+// Extract method to interpret it as an ExecuteOutput.
+type ExecuteResult struct {
+	gophercloud.Result
+}
+
+// ExecuteOutput represents the output of a command executed in a container.
+type ExecuteOutput struct {
+	// Output is the output of the command. It is only set when the command
+	// was run immediately.
+	Output string `json:"output"`
+
+	// ExitCode is the exit code of the command.
+	ExitCode int `json:"exit_code"`
+
+	// ExecID is the ID of the exec instance. It is only set when the command
+	// was not run immediately.
+	ExecID string `json:"exec_id"`
+
+	// ProxyURL is the URL to attach to an interactive exec instance.
+	ProxyURL string `json:"proxy_url"`
+}
+
+// Extract interprets an ExecuteResult as an ExecuteOutput.
+func (r ExecuteResult) Extract() (*ExecuteOutput, error) {
+	var s *ExecuteOutput
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// Container represents a Zun container.
+type Container struct {
+	// The Container IP addresses
+	Addresses map[string][]Address `json:"addresses"`
+
+	// UUID for the container
+	UUID string `json:"uuid"`
+
+	// User ID for the container
+	UserID string `json:"user_id"`
+
+	// Project ID for the container
+	ProjectID string `json:"project_id"`
+
+	// cpu for the container
+	CPU float64 `json:"cpu"`
+
+	// Memory for the container
+	Memory string `json:"memory"`
+
+	// Image for the container
+	Image string `json:"image"`
+
+	// The container labels
+	Labels map[string]string `json:"labels"`
+
+	// The created time of the container
+	CreatedAt time.Time `json:"-"`
+
+	// The updated time of the container
+	UpdatedAt time.Time `json:"-"`
+
+	// The started time of the container
+	StartedAt time.Time `json:"-"`
+
+	// Name for the container
+	Name string `json:"name"`
+
+	// Links includes HTTP references to the itself, useful for passing along to
+	// other APIs that might want a container reference.
+	Links []interface{} `json:"links"`
+
+	// auto remove flag token for the container
+	AutoRemove bool `json:"auto_remove"`
+
+	// Host for the container
+	Host string `json:"host"`
+
+	// Work directory for the container
+	WorkDir string `json:"workdir"`
+
+	// Disk for the container
+	Disk int `json:"disk"`
+
+	// Image pull policy for the container
+	ImagePullPolicy string `json:"image_pull_policy"`
+
+	// Task state for the container
+	TaskState string `json:"task_state"`
+
+	// Host name for the container
+	HostName string `json:"hostname"`
+
+	// Environment for the container
+	Environment map[string]string `json:"environment"`
+
+	// Status for the container
+	Status string `json:"status"`
+
+	// Auto Heal flag for the container
+	AutoHeal bool `json:"auto_heal"`
+
+	// Status details for the container
+	StatusDetail string `json:"status_detail"`
+
+	// Status reason for the container
+	StatusReason string `json:"status_reason"`
+
+	// Image driver for the container
+	ImageDriver string `json:"image_driver"`
+
+	// Command for the container
+	Command []string `json:"command"`
+
+	// Entrypoint for the container
+	Entrypoint []string `json:"entrypoint"`
+
+	// Runtime for the container
+	Runtime string `json:"runtime"`
+
+	// Interactive flag for the container
+	Interactive bool `json:"interactive"`
+
+	// Privileged flag for the container
+	Privileged bool `json:"privileged"`
+
+	// Restart Policy for the container
+	RestartPolicy map[string]string `json:"restart_policy"`
+
+	// Ports information for the container
+	Ports []int `json:"ports"`
+
+	// Security groups for the container
+	SecurityGroups []string `json:"security_groups"`
+}
+
+// Address represents an IP address of a container.
+type Address struct {
+	PreserveOnDelete bool    `json:"preserve_on_delete"`
+	Addr             string  `json:"addr"`
+	Port             string  `json:"port"`
+	Version          float64 `json:"version"`
+	SubnetID         string  `json:"subnet_id"`
+}
+
+// ContainerPage is the page returned by a pager when traversing over a
+// collection of containers.
+type ContainerPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of containers has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r ContainerPage) NextPageURL() (string, error) {
+	var s struct {
+		Next string `json:"next"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Next, nil
+}
+
+// IsEmpty checks whether a ContainerPage struct is empty.
+func (r ContainerPage) IsEmpty() (bool, error) {
+	is, err := ExtractContainers(r)
+	return len(is) == 0, err
+}
+
+// ExtractContainers accepts a Page struct, specifically a ContainerPage
+// struct, and extracts the elements into a slice of Container structs.
+func ExtractContainers(r pagination.Page) ([]Container, error) {
+	var s struct {
+		Containers []Container `json:"containers"`
+	}
+	err := (r.(ContainerPage)).ExtractInto(&s)
+	return s.Containers, err
+}
+
+func (r *Container) UnmarshalJSON(b []byte) error {
+	type tmp Container
+
+	// Support for "older" zun time formats.
+	var s1 struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339ZNoT `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339ZNoT `json:"updated_at"`
+		StartedAt gophercloud.JSONRFC3339ZNoT `json:"started_at"`
+	}
+
+	err := json.Unmarshal(b, &s1)
+	if err == nil {
+		*r = Container(s1.tmp)
+
+		r.CreatedAt = time.Time(s1.CreatedAt)
+		r.UpdatedAt = time.Time(s1.UpdatedAt)
+		r.StartedAt = time.Time(s1.StartedAt)
+
+		return nil
+	}
+
+	// Support for "new" zun time formats.
+	var s2 struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"updated_at"`
+		StartedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"started_at"`
+	}
+
+	err = json.Unmarshal(b, &s2)
+	if err != nil {
+		return err
+	}
+
+	*r = Container(s2.tmp)
+
+	r.CreatedAt = time.Time(s2.CreatedAt)
+	r.UpdatedAt = time.Time(s2.UpdatedAt)
+	r.StartedAt = time.Time(s2.StartedAt)
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/container/v1/containers"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const ContainerID = "b5b4d5c3-5b5d-4a0c-9d3a-0c5f4d5e6f7a"
+
+// ContainerBody is a sample response to a Get or Create call.
+const ContainerBody = `
+{
+  "uuid": "b5b4d5c3-5b5d-4a0c-9d3a-0c5f4d5e6f7a",
+  "name": "web",
+  "image": "nginx",
+  "cpu": 1.0,
+  "memory": "512",
+  "command": ["nginx", "-g", "daemon off;"],
+  "status": "Running",
+  "status_reason": "",
+  "task_state": null,
+  "environment": {"FOO": "bar"},
+  "labels": {"app": "web"},
+  "workdir": "/",
+  "image_pull_policy": "ifnotpresent",
+  "image_driver": "docker",
+  "host": "compute-1",
+  "hostname": "web",
+  "restart_policy": {"Name": "no", "MaximumRetryCount": "0"},
+  "security_groups": ["default"],
+  "interactive": false,
+  "auto_remove": false,
+  "auto_heal": false,
+  "privileged": false,
+  "runtime": "runc",
+  "disk": 0,
+  "project_id": "6b8ffef2a0ac42ee87887b9cc98bdf68",
+  "user_id": "d33b18c384574fd2a3299447aac285f0",
+  "addresses": {
+    "b1295212-64e1-471d-aa01-25ff46f9818d": [
+      {
+        "version": 4,
+        "preserve_on_delete": false,
+        "addr": "172.24.4.11",
+        "port": "8439060f-381a-4386-a518-33d5a4058636",
+        "subnet_id": "4a2bcd64-93ad-4436-9f48-3a7f9b267e0a"
+      }
+    ]
+  },
+  "links": [],
+  "created_at": "2018-01-12 09:37:25",
+  "updated_at": "2018-01-12 09:37:26",
+  "started_at": "2018-01-12 09:37:26"
+}
+`
+
+// ContainerListBody is a sample response to a List call.
+var ContainerListBody = fmt.Sprintf(`
+{
+  "containers": [%s],
+  "next": null
+}
+`, ContainerBody)
+
+// ExpectedContainer is the container described by ContainerBody.
+var ExpectedContainer = containers.Container{
+	UUID:            ContainerID,
+	Name:            "web",
+	Image:           "nginx",
+	CPU:             1.0,
+	Memory:          "512",
+	Command:         []string{"nginx", "-g", "daemon off;"},
+	Status:          "Running",
+	Environment:     map[string]string{"FOO": "bar"},
+	Labels:          map[string]string{"app": "web"},
+	WorkDir:         "/",
+	ImagePullPolicy: "ifnotpresent",
+	ImageDriver:     "docker",
+	Host:            "compute-1",
+	HostName:        "web",
+	RestartPolicy: map[string]string{
+		"Name":              "no",
+		"MaximumRetryCount": "0",
+	},
+	SecurityGroups: []string{"default"},
+	Runtime:        "runc",
+	ProjectID:      "6b8ffef2a0ac42ee87887b9cc98bdf68",
+	UserID:         "d33b18c384574fd2a3299447aac285f0",
+	Addresses: map[string][]containers.Address{
+		"b1295212-64e1-471d-aa01-25ff46f9818d": {
+			{
+				Version:  4,
+				Addr:     "172.24.4.11",
+				Port:     "8439060f-381a-4386-a518-33d5a4058636",
+				SubnetID: "4a2bcd64-93ad-4436-9f48-3a7f9b267e0a",
+			},
+		},
+	},
+	Links:     []interface{}{},
+	CreatedAt: time.Date(2018, 1, 12, 9, 37, 25, 0, time.UTC),
+	UpdatedAt: time.Date(2018, 1, 12, 9, 37, 26, 0, time.UTC),
+	StartedAt: time.Date(2018, 1, 12, 9, 37, 26, 0, time.UTC),
+}
+
+// CreateRequest is the expected request body of a Create call.
+const CreateRequest = `
+{
+  "name": "web",
+  "image": "nginx",
+  "command": ["nginx", "-g", "daemon off;"],
+  "memory": 512,
+  "environment": {"FOO": "bar"},
+  "labels": {"app": "web"},
+  "nets": [{"network": "private"}]
+}
+`
+
+// ExecuteBody is a sample response to an Execute call.
+const ExecuteBody = `
+{
+  "output": "bin\nboot\ndev\n",
+  "exit_code": 0,
+  "exec_id": null,
+  "proxy_url": null
+}
+`
+
+// HandleContainerCreateSuccessfully creates an HTTP handler at `/containers`
+// on the test handler mux that responds with a `Create` response.
+func HandleContainerCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/containers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"run": "true"})
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, ContainerBody)
+	})
+}
+
+// HandleContainerGetSuccessfully test setup
+func HandleContainerGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/containers/"+ContainerID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ContainerBody)
+	})
+}
+
+// HandleContainerListSuccessfully test setup
+func HandleContainerListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/containers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"status": "Running"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ContainerListBody)
+	})
+}
+
+// HandleContainerDeleteSuccessfully test setup
+func HandleContainerDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/containers/"+ContainerID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"stop": "true"})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleContainerLogsSuccessfully test setup
+func HandleContainerLogsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/containers/"+ContainerID+"/logs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"stderr": "false", "tail": "2"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `"line one\nline two\n"`)
+	})
+}
+
+// HandleContainerExecuteSuccessfully test setup
+func HandleContainerExecuteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/containers/"+ContainerID+"/execute", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"command": "ls /"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ExecuteBody)
+	})
+}
+
+// HandleContainerKillSuccessfully test setup
+func HandleContainerKillSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/containers/"+ContainerID+"/kill", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"signal": "SIGTERM"})
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,125 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/container/v1/containers"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestCreateContainer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleContainerCreateSuccessfully(t)
+
+	createOpts := containers.CreateOpts{
+		Run:         true,
+		Name:        "web",
+		Image:       "nginx",
+		Command:     []string{"nginx", "-g", "daemon off;"},
+		Memory:      512,
+		Environment: map[string]string{"FOO": "bar"},
+		Labels:      map[string]string{"app": "web"},
+		Nets:        []map[string]string{{"network": "private"}},
+	}
+
+	actual, err := containers.Create(fakeclient.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedContainer, actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	_, err := containers.CreateOpts{Name: "web"}.ToContainerCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestGetContainer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleContainerGetSuccessfully(t)
+
+	actual, err := containers.Get(fakeclient.ServiceClient(), ContainerID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedContainer, actual)
+}
+
+func TestListContainers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleContainerListSuccessfully(t)
+
+	count := 0
+	listOpts := containers.ListOpts{Status: "Running"}
+	err := containers.List(fakeclient.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := containers.ExtractContainers(page)
+		th.AssertNoErr(t, err)
+		th.AssertDeepEquals(t, []containers.Container{ExpectedContainer}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestDeleteContainer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleContainerDeleteSuccessfully(t)
+
+	deleteOpts := containers.DeleteOpts{Stop: true}
+	res := containers.Delete(fakeclient.ServiceClient(), ContainerID, deleteOpts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestContainerLogs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleContainerLogsSuccessfully(t)
+
+	stderr := false
+	logsOpts := containers.LogsOpts{
+		Stderr: &stderr,
+		Tail:   "2",
+	}
+	actual, err := containers.Logs(fakeclient.ServiceClient(), ContainerID, logsOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "line one\nline two\n", actual)
+}
+
+func TestContainerExecute(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleContainerExecuteSuccessfully(t)
+
+	executeOpts := containers.ExecuteOpts{Command: "ls /"}
+	actual, err := containers.Execute(fakeclient.ServiceClient(), ContainerID, executeOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := containers.ExecuteOutput{
+		Output:   "bin\nboot\ndev\n",
+		ExitCode: 0,
+	}
+	th.AssertDeepEquals(t, &expected, actual)
+}
+
+func TestRequiredExecuteOpts(t *testing.T) {
+	_, err := containers.ExecuteOpts{}.ToContainerExecuteQuery()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestContainerKill(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleContainerKillSuccessfully(t)
+
+	killOpts := containers.KillOpts{Signal: "SIGTERM"}
+	res := containers.Kill(fakeclient.ServiceClient(), ContainerID, killOpts)
+	th.AssertNoErr(t, res.Err)
+}
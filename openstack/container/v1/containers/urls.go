@@ -0,0 +1,31 @@
+package containers
+
+import "github.com/gophercloud/gophercloud"
+
+func getURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("containers", id)
+}
+
+func createURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("containers")
+}
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("containers")
+}
+
+func deleteURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("containers", id)
+}
+
+func logsURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("containers", id, "logs")
+}
+
+func executeURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("containers", id, "execute")
+}
+
+func killURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("containers", id, "kill")
+}
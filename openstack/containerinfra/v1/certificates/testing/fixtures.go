@@ -84,9 +84,10 @@ func HandleGetCertificateSuccessfully(t *testing.T) {
 }
 
 func HandleCreateCertificateSuccessfully(t *testing.T) {
-	th.Mux.HandleFunc("/v1/certificates/", func(w http.ResponseWriter, r *http.Request) {
-		th.TestMethod(t, r, "GET")
+	th.Mux.HandleFunc("/v1/certificates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
 		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"bay_uuid": "d564b18a-2890-4152-be3d-e05d784ff727", "csr": "FAKE_CERTIFICATE_CSR"}`)
 
 		w.Header().Add("Content-Type", "application/json")
 		w.Header().Add("OpenStack-API-Minimum-Version", "container-infra 1.1")
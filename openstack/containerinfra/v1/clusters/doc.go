@@ -89,6 +89,40 @@ Example to Update a Cluster
 	}
 	fmt.Printf("%s\n", clusterUUID)
 
+Example to Resize a Cluster
+
+	nodeCount := 3
+	resizeOpts := clusters.ResizeOpts{
+		NodeCount: &nodeCount,
+	}
+	clusterUUID, err := clusters.Resize(serviceClient, clusterUUID, resizeOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Upgrade a Cluster
+
+	serviceClient.Microversion = "1.8"
+	upgradeOpts := clusters.UpgradeOpts{
+		ClusterTemplate: "e7ffbd1a-54e8-4e31-8e85-2d8f6b4c7b85",
+	}
+	clusterUUID, err := clusters.Upgrade(serviceClient, clusterUUID, upgradeOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Generate a kubeconfig for a Kubernetes Cluster
+
+	kubeconfig, err := clusters.GetKubeconfig(serviceClient, clusterUUID)
+	if err != nil {
+		panic(err)
+	}
+
+	err = ioutil.WriteFile("config", kubeconfig, 0600)
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Cluster
 
 	clusterUUID := "dc6d336e3fc4c0a951b5698cd1236ee"
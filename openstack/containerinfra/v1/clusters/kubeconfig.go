@@ -0,0 +1,125 @@
+package clusters
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/certificates"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	kubeconfigUser    = "admin"
+	kubeconfigGroup   = "system:masters"
+	kubeconfigKeySize = 2048
+)
+
+type kubeconfig struct {
+	APIVersion     string                 `yaml:"apiVersion"`
+	Kind           string                 `yaml:"kind"`
+	Clusters       []kubeconfigCluster    `yaml:"clusters"`
+	Contexts       []kubeconfigContext    `yaml:"contexts"`
+	CurrentContext string                 `yaml:"current-context"`
+	Preferences    map[string]interface{} `yaml:"preferences"`
+	Users          []kubeconfigUserEntry  `yaml:"users"`
+}
+
+type kubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		Server                   string `yaml:"server"`
+	} `yaml:"cluster"`
+}
+
+type kubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+}
+
+type kubeconfigUserEntry struct {
+	Name string `yaml:"name"`
+	User struct {
+		ClientCertificateData string `yaml:"client-certificate-data"`
+		ClientKeyData         string `yaml:"client-key-data"`
+	} `yaml:"user"`
+}
+
+// GetKubeconfig generates a kubeconfig file granting administrative access
+// to a Kubernetes cluster. A new private key is generated locally and its
+// certificate signing request is signed by the cluster CA through the
+// certificates API, so the private key never leaves the client.
+func GetKubeconfig(client *gophercloud.ServiceClient, id string) ([]byte, error) {
+	cluster, err := Get(client, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.APIAddress == "" {
+		return nil, fmt.Errorf("cluster %s has no API address", id)
+	}
+
+	ca, err := certificates.Get(client, cluster.UUID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, kubeconfigKeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   kubeconfigUser,
+			Organization: []string{kubeconfigGroup},
+		},
+	}, key)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := certificates.Create(client, certificates.CreateOpts{
+		ClusterUUID: cluster.UUID,
+		CSR:         string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})),
+	}).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	var c kubeconfigCluster
+	c.Name = cluster.Name
+	c.Cluster.CertificateAuthorityData = base64.StdEncoding.EncodeToString([]byte(ca.PEM))
+	c.Cluster.Server = cluster.APIAddress
+
+	var ctx kubeconfigContext
+	ctx.Name = "default"
+	ctx.Context.Cluster = cluster.Name
+	ctx.Context.User = kubeconfigUser
+
+	var u kubeconfigUserEntry
+	u.Name = kubeconfigUser
+	u.User.ClientCertificateData = base64.StdEncoding.EncodeToString([]byte(cert.PEM))
+	u.User.ClientKeyData = base64.StdEncoding.EncodeToString(keyPEM)
+
+	return yaml.Marshal(kubeconfig{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		Clusters:       []kubeconfigCluster{c},
+		Contexts:       []kubeconfigContext{ctx},
+		CurrentContext: ctx.Name,
+		Preferences:    map[string]interface{}{},
+		Users:          []kubeconfigUserEntry{u},
+	})
+}
@@ -215,3 +215,41 @@ func Resize(client *gophercloud.ServiceClient, id string, opts ResizeOptsBuilder
 	}
 	return
 }
+
+// UpgradeOptsBuilder allows extensions to add additional parameters to the
+// Upgrade request.
+type UpgradeOptsBuilder interface {
+	ToClusterUpgradeMap() (map[string]interface{}, error)
+}
+
+// UpgradeOpts params
+type UpgradeOpts struct {
+	ClusterTemplate string `json:"cluster_template" required:"true"`
+	MaxBatchSize    *int   `json:"max_batch_size,omitempty"`
+	NodeGroup       string `json:"nodegroup,omitempty"`
+}
+
+// ToClusterUpgradeMap constructs a request body from UpgradeOpts.
+func (opts UpgradeOpts) ToClusterUpgradeMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Upgrade an existing cluster to the given cluster template. Requires
+// microversion 1.8 or later.
+func Upgrade(client *gophercloud.ServiceClient, id string, opts UpgradeOptsBuilder) (r UpgradeResult) {
+	b, err := opts.ToClusterUpgradeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	var result *http.Response
+	result, r.Err = client.Post(upgradeURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	if r.Err == nil {
+		r.Header = result.Header
+	}
+	return
+}
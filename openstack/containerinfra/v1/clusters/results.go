@@ -44,6 +44,11 @@ type ResizeResult struct {
 	commonResult
 }
 
+// UpgradeResult is the response of an Upgrade operations.
+type UpgradeResult struct {
+	commonResult
+}
+
 func (r CreateResult) Extract() (string, error) {
 	var s struct {
 		UUID string
@@ -68,6 +73,14 @@ func (r ResizeResult) Extract() (string, error) {
 	return s.UUID, err
 }
 
+func (r UpgradeResult) Extract() (string, error) {
+	var s struct {
+		UUID string
+	}
+	err := r.ExtractInto(&s)
+	return s.UUID, err
+}
+
 type Cluster struct {
 	APIAddress        string             `json:"api_address"`
 	COEVersion        string             `json:"coe_version"`
@@ -1,6 +1,9 @@
 package testing
 
 import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"net/http"
 	"testing"
@@ -304,3 +307,66 @@ func HandleResizeClusterSuccessfully(t *testing.T) {
 		fmt.Fprint(w, ResizeResponse)
 	})
 }
+
+var UpgradeResponse = fmt.Sprintf(`
+{
+	"uuid": "%s"
+}`, clusterUUID)
+
+func HandleUpgradeClusterSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v1/clusters/"+clusterUUID+"/actions/upgrade", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "OpenStack-API-Version", "container-infra 1.8")
+		th.TestJSONRequest(t, r, `
+		{
+			"cluster_template": "e7ffbd1a-54e8-4e31-8e85-2d8f6b4c7b85",
+			"max_batch_size": 1,
+			"nodegroup": "default-worker"
+		}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.Header().Add("X-OpenStack-Request-Id", requestUUID)
+		w.WriteHeader(http.StatusAccepted)
+
+		fmt.Fprint(w, UpgradeResponse)
+	})
+}
+
+func HandleGetKubeconfigCertificatesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v1/certificates/"+clusterUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `{"cluster_uuid": "%s", "pem": "FAKE_CA_CERTIFICATE"}`, clusterUUID)
+	})
+
+	th.Mux.HandleFunc("/v1/certificates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		var req struct {
+			ClusterUUID string `json:"cluster_uuid"`
+			CSR         string `json:"csr"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&req))
+		th.AssertEquals(t, clusterUUID, req.ClusterUUID)
+
+		block, _ := pem.Decode([]byte(req.CSR))
+		if block == nil {
+			t.Fatalf("unable to decode CSR: %s", req.CSR)
+		}
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, "admin", csr.Subject.CommonName)
+		th.AssertDeepEquals(t, []string{"system:masters"}, csr.Subject.Organization)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, `{"cluster_uuid": "%s", "pem": "FAKE_CLIENT_CERTIFICATE"}`, clusterUUID)
+	})
+}
@@ -1,6 +1,9 @@
 package testing
 
 import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"testing"
 
 	"github.com/gophercloud/gophercloud"
@@ -8,6 +11,7 @@ import (
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
 	fake "github.com/gophercloud/gophercloud/testhelper/client"
+	yaml "gopkg.in/yaml.v2"
 )
 
 func TestCreateCluster(t *testing.T) {
@@ -203,3 +207,83 @@ func TestResizeCluster(t *testing.T) {
 
 	th.AssertDeepEquals(t, clusterUUID, actual)
 }
+
+func TestUpgradeCluster(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleUpgradeClusterSuccessfully(t)
+
+	maxBatchSize := 1
+	opts := clusters.UpgradeOpts{
+		ClusterTemplate: "e7ffbd1a-54e8-4e31-8e85-2d8f6b4c7b85",
+		MaxBatchSize:    &maxBatchSize,
+		NodeGroup:       "default-worker",
+	}
+
+	sc := fake.ServiceClient()
+	sc.Endpoint = sc.Endpoint + "v1/"
+	sc.Type = "container-infra"
+	sc.Microversion = "1.8"
+	res := clusters.Upgrade(sc, clusterUUID, opts)
+	th.AssertNoErr(t, res.Err)
+
+	requestID := res.Header.Get("X-OpenStack-Request-Id")
+	th.AssertEquals(t, requestUUID, requestID)
+
+	actual, err := res.Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, clusterUUID, actual)
+}
+
+func TestGetKubeconfig(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetClusterSuccessfully(t)
+	HandleGetKubeconfigCertificatesSuccessfully(t)
+
+	sc := fake.ServiceClient()
+	sc.Endpoint = sc.Endpoint + "v1/"
+	b, err := clusters.GetKubeconfig(sc, clusterUUID)
+	th.AssertNoErr(t, err)
+
+	var actual struct {
+		Clusters []struct {
+			Name    string `yaml:"name"`
+			Cluster struct {
+				CertificateAuthorityData string `yaml:"certificate-authority-data"`
+				Server                   string `yaml:"server"`
+			} `yaml:"cluster"`
+		} `yaml:"clusters"`
+		CurrentContext string `yaml:"current-context"`
+		Users          []struct {
+			Name string `yaml:"name"`
+			User struct {
+				ClientCertificateData string `yaml:"client-certificate-data"`
+				ClientKeyData         string `yaml:"client-key-data"`
+			} `yaml:"user"`
+		} `yaml:"users"`
+	}
+	th.AssertNoErr(t, yaml.Unmarshal(b, &actual))
+
+	th.AssertEquals(t, 1, len(actual.Clusters))
+	th.AssertEquals(t, "k8s", actual.Clusters[0].Name)
+	th.AssertEquals(t, "https://172.24.4.6:6443", actual.Clusters[0].Cluster.Server)
+	th.AssertEquals(t, base64.StdEncoding.EncodeToString([]byte("FAKE_CA_CERTIFICATE")), actual.Clusters[0].Cluster.CertificateAuthorityData)
+	th.AssertEquals(t, "default", actual.CurrentContext)
+
+	th.AssertEquals(t, 1, len(actual.Users))
+	th.AssertEquals(t, "admin", actual.Users[0].Name)
+	th.AssertEquals(t, base64.StdEncoding.EncodeToString([]byte("FAKE_CLIENT_CERTIFICATE")), actual.Users[0].User.ClientCertificateData)
+
+	keyPEM, err := base64.StdEncoding.DecodeString(actual.Users[0].User.ClientKeyData)
+	th.AssertNoErr(t, err)
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		t.Fatal("unable to decode client key")
+	}
+	_, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	th.AssertNoErr(t, err)
+}
@@ -41,3 +41,7 @@ func updateURL(client *gophercloud.ServiceClient, id string) string {
 func resizeURL(client *gophercloud.ServiceClient, id string) string {
 	return client.ServiceURL("clusters", id, "actions/resize")
 }
+
+func upgradeURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("clusters", id, "actions/upgrade")
+}
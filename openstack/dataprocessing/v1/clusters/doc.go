@@ -0,0 +1,73 @@
+/*
+Package clusters manages clusters of the OpenStack Data Processing service
+(Sahara), such as Hadoop or Spark clusters.
+
+Example to List Clusters
+
+	allPages, err := clusters.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allClusters, err := clusters.ExtractClusters(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, cluster := range allClusters {
+		fmt.Printf("%+v\n", cluster)
+	}
+
+Example to Create a Cluster from a Template
+
+	createOpts := clusters.CreateOpts{
+		Name:                     "hadoop",
+		PluginName:               "vanilla",
+		HadoopVersion:            "2.7.1",
+		ClusterTemplateID:        templateID,
+		DefaultImageID:           imageID,
+		UserKeypairID:            "mykey",
+		NeutronManagementNetwork: networkID,
+	}
+
+	cluster, err := clusters.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Scale a Cluster
+
+	scaleOpts := clusters.ScaleOpts{
+		ResizeNodeGroups: []clusters.ResizeNodeGroupOpts{
+			{
+				Name:  "worker",
+				Count: 5,
+			},
+		},
+	}
+
+	cluster, err := clusters.Scale(client, clusterID, scaleOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Cluster
+
+	description := "Nightly batch cluster"
+	updateOpts := clusters.UpdateOpts{
+		Description: &description,
+	}
+
+	cluster, err := clusters.Update(client, clusterID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Cluster
+
+	err := clusters.Delete(client, clusterID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package clusters
@@ -0,0 +1,246 @@
+package clusters
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToClusterListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// Name filters the clusters by name.
+	Name string `q:"name"`
+
+	// PluginName filters the clusters by plugin.
+	PluginName string `q:"plugin_name"`
+
+	// HadoopVersion filters the clusters by plugin version.
+	HadoopVersion string `q:"hadoop_version"`
+
+	// Limit limits the number of clusters to return.
+	Limit int `q:"limit"`
+
+	// Marker is the ID of the last cluster of the previous page.
+	Marker string `q:"marker"`
+
+	// SortBy sorts the clusters by the given attribute. Prefix it with
+	// "-" to sort in descending order.
+	SortBy string `q:"sort_by"`
+}
+
+// ToClusterListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToClusterListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// clusters.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToClusterListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ClusterPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific cluster based on its unique ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// NodeGroupOpts describes a node group of a cluster.
+type NodeGroupOpts struct {
+	// Name is the name of the node group.
+	Name string `json:"name" required:"true"`
+
+	// Count is the number of nodes of the group.
+	Count int `json:"count"`
+
+	// NodeGroupTemplateID is the ID of the node group template of the
+	// group. If it is not set, the group is described by the other fields.
+	NodeGroupTemplateID string `json:"node_group_template_id,omitempty"`
+
+	// FlavorID is the ID of the flavor of the nodes.
+	FlavorID string `json:"flavor_id,omitempty"`
+
+	// NodeProcesses are the processes run on the nodes.
+	NodeProcesses []string `json:"node_processes,omitempty"`
+
+	// FloatingIPPool is the network floating IPs are allocated from.
+	FloatingIPPool string `json:"floating_ip_pool,omitempty"`
+
+	// NodeConfigs are the service configurations of the nodes.
+	NodeConfigs map[string]interface{} `json:"node_configs,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToClusterCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new cluster.
+type CreateOpts struct {
+	// Name is the name of the cluster.
+	Name string `json:"name" required:"true"`
+
+	// Description is a description of the cluster.
+	Description string `json:"description,omitempty"`
+
+	// PluginName is the name of the plugin, e.g. "vanilla" or "spark".
+	PluginName string `json:"plugin_name" required:"true"`
+
+	// HadoopVersion is the version of the plugin.
+	HadoopVersion string `json:"hadoop_version" required:"true"`
+
+	// ClusterTemplateID is the ID of the template of the cluster.
+	ClusterTemplateID string `json:"cluster_template_id,omitempty"`
+
+	// NodeGroups are the node groups of the cluster, if no template is
+	// used.
+	NodeGroups []NodeGroupOpts `json:"node_groups,omitempty"`
+
+	// DefaultImageID is the ID of the image of the nodes.
+	DefaultImageID string `json:"default_image_id,omitempty"`
+
+	// UserKeypairID is the name of the keypair injected in the nodes.
+	UserKeypairID string `json:"user_keypair_id,omitempty"`
+
+	// NeutronManagementNetwork is the ID of the network the nodes are
+	// attached to.
+	NeutronManagementNetwork string `json:"neutron_management_network,omitempty"`
+
+	// ClusterConfigs are the service configurations of the cluster.
+	ClusterConfigs map[string]interface{} `json:"cluster_configs,omitempty"`
+
+	// AntiAffinity are the processes which are not run on the same host.
+	AntiAffinity []string `json:"anti_affinity,omitempty"`
+
+	// IsTransient deletes the cluster once its jobs are done.
+	IsTransient *bool `json:"is_transient,omitempty"`
+
+	// IsPublic shares the cluster with other projects.
+	IsPublic *bool `json:"is_public,omitempty"`
+
+	// IsProtected protects the cluster from modification and deletion.
+	IsProtected *bool `json:"is_protected,omitempty"`
+}
+
+// ToClusterCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToClusterCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new cluster.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToClusterCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToClusterUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters to update a cluster.
+type UpdateOpts struct {
+	// Name is the name of the cluster.
+	Name string `json:"name,omitempty"`
+
+	// Description is a description of the cluster.
+	Description *string `json:"description,omitempty"`
+
+	// IsPublic shares the cluster with other projects.
+	IsPublic *bool `json:"is_public,omitempty"`
+
+	// IsProtected protects the cluster from modification and deletion.
+	IsProtected *bool `json:"is_protected,omitempty"`
+}
+
+// ToClusterUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToClusterUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of a cluster.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToClusterUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// ResizeNodeGroupOpts changes the number of nodes of a node group.
+type ResizeNodeGroupOpts struct {
+	// Name is the name of the node group.
+	Name string `json:"name" required:"true"`
+
+	// Count is the new number of nodes of the group.
+	Count int `json:"count"`
+}
+
+// ScaleOptsBuilder allows extensions to add additional parameters to the
+// Scale request.
+type ScaleOptsBuilder interface {
+	ToClusterScaleMap() (map[string]interface{}, error)
+}
+
+// ScaleOpts specifies the node groups to add to or resize in a cluster.
+type ScaleOpts struct {
+	// AddNodeGroups are the node groups to add to the cluster.
+	AddNodeGroups []NodeGroupOpts `json:"add_node_groups,omitempty"`
+
+	// ResizeNodeGroups are the node groups to resize.
+	ResizeNodeGroups []ResizeNodeGroupOpts `json:"resize_node_groups,omitempty"`
+}
+
+// ToClusterScaleMap constructs a request body from ScaleOpts.
+func (opts ScaleOpts) ToClusterScaleMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Scale adds node groups to or resizes node groups of a cluster.
+func Scale(client *gophercloud.ServiceClient, id string, opts ScaleOptsBuilder) (r ScaleResult) {
+	b, err := opts.ToClusterScaleMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(scaleURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Delete requests the deletion of a cluster.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
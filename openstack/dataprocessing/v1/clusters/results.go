@@ -0,0 +1,239 @@
+package clusters
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Cluster represents a data processing cluster.
+type Cluster struct {
+	// ID is the unique ID of the cluster.
+	ID string `json:"id"`
+
+	// Name is the name of the cluster.
+	Name string `json:"name"`
+
+	// Description is a description of the cluster.
+	Description string `json:"description"`
+
+	// PluginName is the name of the plugin.
+	PluginName string `json:"plugin_name"`
+
+	// HadoopVersion is the version of the plugin.
+	HadoopVersion string `json:"hadoop_version"`
+
+	// ClusterTemplateID is the ID of the template of the cluster.
+	ClusterTemplateID string `json:"cluster_template_id"`
+
+	// Status is the status of the cluster, e.g. "Active" or "Error".
+	Status string `json:"status"`
+
+	// StatusDescription describes the status of the cluster.
+	StatusDescription string `json:"status_description"`
+
+	// NodeGroups are the node groups of the cluster.
+	NodeGroups []NodeGroup `json:"node_groups"`
+
+	// DefaultImageID is the ID of the image of the nodes.
+	DefaultImageID string `json:"default_image_id"`
+
+	// UserKeypairID is the name of the keypair injected in the nodes.
+	UserKeypairID string `json:"user_keypair_id"`
+
+	// NeutronManagementNetwork is the ID of the network the nodes are
+	// attached to.
+	NeutronManagementNetwork string `json:"neutron_management_network"`
+
+	// ManagementPublicKey is the public key used to manage the nodes.
+	ManagementPublicKey string `json:"management_public_key"`
+
+	// ClusterConfigs are the service configurations of the cluster.
+	ClusterConfigs map[string]interface{} `json:"cluster_configs"`
+
+	// AntiAffinity are the processes which are not run on the same host.
+	AntiAffinity []string `json:"anti_affinity"`
+
+	// Info describes the services of the cluster, e.g. their web UIs.
+	Info map[string]interface{} `json:"info"`
+
+	// IsTransient indicates whether the cluster is deleted once its jobs
+	// are done.
+	IsTransient bool `json:"is_transient"`
+
+	// IsPublic indicates whether the cluster is shared.
+	IsPublic bool `json:"is_public"`
+
+	// IsProtected indicates whether the cluster is protected.
+	IsProtected bool `json:"is_protected"`
+
+	// TrustID is the ID of the trust used by the cluster.
+	TrustID string `json:"trust_id"`
+
+	// TenantID is the ID of the project owning the cluster.
+	TenantID string `json:"tenant_id"`
+
+	// CreatedAt is the date the cluster was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the cluster was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// NodeGroup is a node group of a cluster.
+type NodeGroup struct {
+	// Name is the name of the node group.
+	Name string `json:"name"`
+
+	// Count is the number of nodes of the group.
+	Count int `json:"count"`
+
+	// NodeGroupTemplateID is the ID of the node group template of the
+	// group.
+	NodeGroupTemplateID string `json:"node_group_template_id"`
+
+	// FlavorID is the ID of the flavor of the nodes.
+	FlavorID string `json:"flavor_id"`
+
+	// ImageID is the ID of the image of the nodes.
+	ImageID string `json:"image_id"`
+
+	// NodeProcesses are the processes run on the nodes.
+	NodeProcesses []string `json:"node_processes"`
+
+	// FloatingIPPool is the network floating IPs are allocated from.
+	FloatingIPPool string `json:"floating_ip_pool"`
+
+	// SecurityGroups are the security groups of the nodes.
+	SecurityGroups []string `json:"security_groups"`
+
+	// NodeConfigs are the service configurations of the nodes.
+	NodeConfigs map[string]interface{} `json:"node_configs"`
+
+	// Instances are the nodes of the group.
+	Instances []Instance `json:"instances"`
+}
+
+// Instance is a node of a cluster.
+type Instance struct {
+	// ID is the unique ID of the node.
+	ID string `json:"id"`
+
+	// InstanceID is the ID of the compute instance of the node.
+	InstanceID string `json:"instance_id"`
+
+	// InstanceName is the name of the compute instance of the node.
+	InstanceName string `json:"instance_name"`
+
+	// InternalIP is the private IP address of the node.
+	InternalIP string `json:"internal_ip"`
+
+	// ManagementIP is the IP address used to manage the node.
+	ManagementIP string `json:"management_ip"`
+}
+
+func (r *Cluster) UnmarshalJSON(b []byte) error {
+	type tmp Cluster
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Cluster(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Cluster.
+func (r commonResult) Extract() (*Cluster, error) {
+	var s struct {
+		Cluster *Cluster `json:"cluster"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Cluster, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Cluster.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Cluster.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Cluster.
+type UpdateResult struct {
+	commonResult
+}
+
+// ScaleResult is the response from a Scale operation. Call its Extract
+// method to interpret it as a Cluster.
+type ScaleResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ClusterPage is a single page of Cluster results.
+type ClusterPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL builds the URL of the next page from the marker returned by
+// the API.
+func (r ClusterPage) NextPageURL() (string, error) {
+	var s struct {
+		Markers struct {
+			Next string `json:"next"`
+		} `json:"markers"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil || s.Markers.Next == "" {
+		return "", err
+	}
+
+	u := r.URL
+	q := u.Query()
+	q.Set("marker", s.Markers.Next)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// IsEmpty determines whether or not a ClusterPage contains any results.
+func (r ClusterPage) IsEmpty() (bool, error) {
+	clusters, err := ExtractClusters(r)
+	return len(clusters) == 0, err
+}
+
+// ExtractClusters returns a slice of Clusters contained in a single page of
+// results.
+func ExtractClusters(r pagination.Page) ([]Cluster, error) {
+	var s struct {
+		Clusters []Cluster `json:"clusters"`
+	}
+	err := (r.(ClusterPage)).ExtractInto(&s)
+	return s.Clusters, err
+}
@@ -0,0 +1,2 @@
+// dataprocessing_clusters_v1
+package testing
@@ -0,0 +1,247 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/clusters"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const ClusterID = "e172d86c-906d-418e-a29c-6189f53bfa42"
+
+// ClusterBody is the JSON representation of a single cluster.
+const ClusterBody = `
+{
+  "id": "e172d86c-906d-418e-a29c-6189f53bfa42",
+  "name": "hadoop",
+  "description": "Hadoop cluster",
+  "plugin_name": "vanilla",
+  "hadoop_version": "2.7.1",
+  "cluster_template_id": "2c76e0d3-56cd-4d28-bb4f-4808e538c7b9",
+  "status": "Active",
+  "status_description": "",
+  "node_groups": [
+    {
+      "name": "worker",
+      "count": 1,
+      "node_group_template_id": "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad",
+      "flavor_id": "2",
+      "image_id": null,
+      "node_processes": ["datanode", "nodemanager"],
+      "floating_ip_pool": "public",
+      "security_groups": null,
+      "node_configs": {},
+      "instances": [
+        {
+          "id": "8d1c5f9c-f57b-4a58-9fd6-7b4cbd2b0d2c",
+          "instance_id": "b9f16a07-88fc-423e-83a3-489598fe6737",
+          "instance_name": "hadoop-worker-0",
+          "internal_ip": "10.50.0.60",
+          "management_ip": "172.24.4.225"
+        }
+      ]
+    }
+  ],
+  "default_image_id": "cb9ef7a4-9b42-4b96-aeb3-6a25b5f2e0fc",
+  "user_keypair_id": "mykey",
+  "neutron_management_network": "b5bc49be-ea6f-472d-9240-968f75d7a16a",
+  "management_public_key": "ssh-rsa AAAA Generated-by-Sahara",
+  "cluster_configs": {},
+  "anti_affinity": [],
+  "info": {
+    "HDFS": {
+      "Web UI": "http://172.24.4.225:50070"
+    }
+  },
+  "is_transient": false,
+  "is_public": false,
+  "is_protected": false,
+  "trust_id": null,
+  "tenant_id": "808d5032ea0446889097723bfc8e919d",
+  "created_at": "2015-09-14T10:57:11",
+  "updated_at": "2015-09-14T11:02:43"
+}
+`
+
+// GetResponse is a sample response to a Get call.
+var GetResponse = fmt.Sprintf(`{"cluster": %s}`, ClusterBody)
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`
+{
+  "clusters": [%s],
+  "markers": {
+    "prev": null,
+    "next": null
+  }
+}
+`, ClusterBody)
+
+// CreateRequest is a sample request to create a cluster.
+const CreateRequest = `
+{
+  "name": "hadoop",
+  "description": "Hadoop cluster",
+  "plugin_name": "vanilla",
+  "hadoop_version": "2.7.1",
+  "cluster_template_id": "2c76e0d3-56cd-4d28-bb4f-4808e538c7b9",
+  "default_image_id": "cb9ef7a4-9b42-4b96-aeb3-6a25b5f2e0fc",
+  "user_keypair_id": "mykey",
+  "neutron_management_network": "b5bc49be-ea6f-472d-9240-968f75d7a16a"
+}
+`
+
+// UpdateRequest is a sample request to update a cluster.
+const UpdateRequest = `
+{
+  "name": "hadoop",
+  "is_protected": false
+}
+`
+
+// ScaleRequest is a sample request to scale a cluster.
+const ScaleRequest = `
+{
+  "add_node_groups": [
+    {
+      "name": "worker2",
+      "count": 1,
+      "node_group_template_id": "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad"
+    }
+  ],
+  "resize_node_groups": [
+    {
+      "name": "worker",
+      "count": 0
+    }
+  ]
+}
+`
+
+// FirstCluster is the cluster described by ClusterBody.
+var FirstCluster = clusters.Cluster{
+	ID:                ClusterID,
+	Name:              "hadoop",
+	Description:       "Hadoop cluster",
+	PluginName:        "vanilla",
+	HadoopVersion:     "2.7.1",
+	ClusterTemplateID: "2c76e0d3-56cd-4d28-bb4f-4808e538c7b9",
+	Status:            "Active",
+	NodeGroups: []clusters.NodeGroup{
+		{
+			Name:                "worker",
+			Count:               1,
+			NodeGroupTemplateID: "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad",
+			FlavorID:            "2",
+			NodeProcesses:       []string{"datanode", "nodemanager"},
+			FloatingIPPool:      "public",
+			NodeConfigs:         map[string]interface{}{},
+			Instances: []clusters.Instance{
+				{
+					ID:           "8d1c5f9c-f57b-4a58-9fd6-7b4cbd2b0d2c",
+					InstanceID:   "b9f16a07-88fc-423e-83a3-489598fe6737",
+					InstanceName: "hadoop-worker-0",
+					InternalIP:   "10.50.0.60",
+					ManagementIP: "172.24.4.225",
+				},
+			},
+		},
+	},
+	DefaultImageID:           "cb9ef7a4-9b42-4b96-aeb3-6a25b5f2e0fc",
+	UserKeypairID:            "mykey",
+	NeutronManagementNetwork: "b5bc49be-ea6f-472d-9240-968f75d7a16a",
+	ManagementPublicKey:      "ssh-rsa AAAA Generated-by-Sahara",
+	ClusterConfigs:           map[string]interface{}{},
+	AntiAffinity:             []string{},
+	Info: map[string]interface{}{
+		"HDFS": map[string]interface{}{
+			"Web UI": "http://172.24.4.225:50070",
+		},
+	},
+	TenantID:  "808d5032ea0446889097723bfc8e919d",
+	CreatedAt: time.Date(2015, 9, 14, 10, 57, 11, 0, time.UTC),
+	UpdatedAt: time.Date(2015, 9, 14, 11, 2, 43, 0, time.UTC),
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/clusters", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/clusters/"+ClusterID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/clusters", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/clusters/"+ClusterID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleScaleSuccessfully configures the test server to respond to a Scale
+// request.
+func HandleScaleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/clusters/"+ClusterID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, ScaleRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/clusters/"+ClusterID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,117 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/clusters"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListClusters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := clusters.List(fake.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := clusters.ExtractClusters(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []clusters.Cluster{FirstCluster}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetCluster(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := clusters.Get(fake.ServiceClient(), ClusterID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstCluster, actual)
+}
+
+func TestCreateCluster(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := clusters.CreateOpts{
+		Name:                     "hadoop",
+		Description:              "Hadoop cluster",
+		PluginName:               "vanilla",
+		HadoopVersion:            "2.7.1",
+		ClusterTemplateID:        "2c76e0d3-56cd-4d28-bb4f-4808e538c7b9",
+		DefaultImageID:           "cb9ef7a4-9b42-4b96-aeb3-6a25b5f2e0fc",
+		UserKeypairID:            "mykey",
+		NeutronManagementNetwork: "b5bc49be-ea6f-472d-9240-968f75d7a16a",
+	}
+
+	actual, err := clusters.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstCluster, actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	_, err := clusters.CreateOpts{Name: "hadoop"}.ToClusterCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdateCluster(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	isProtected := false
+	updateOpts := clusters.UpdateOpts{
+		Name:        "hadoop",
+		IsProtected: &isProtected,
+	}
+
+	actual, err := clusters.Update(fake.ServiceClient(), ClusterID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstCluster, actual)
+}
+
+func TestScaleCluster(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleScaleSuccessfully(t)
+
+	scaleOpts := clusters.ScaleOpts{
+		AddNodeGroups: []clusters.NodeGroupOpts{
+			{
+				Name:                "worker2",
+				Count:               1,
+				NodeGroupTemplateID: "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad",
+			},
+		},
+		ResizeNodeGroups: []clusters.ResizeNodeGroupOpts{
+			{
+				Name:  "worker",
+				Count: 0,
+			},
+		},
+	}
+
+	actual, err := clusters.Scale(fake.ServiceClient(), ClusterID, scaleOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstCluster, actual)
+}
+
+func TestDeleteCluster(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := clusters.Delete(fake.ServiceClient(), ClusterID)
+	th.AssertNoErr(t, res.Err)
+}
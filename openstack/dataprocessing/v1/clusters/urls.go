@@ -0,0 +1,37 @@
+package clusters
+
+import "github.com/gophercloud/gophercloud"
+
+const clustersPath = "clusters"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(clustersPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, clusterID string) string {
+	return c.ServiceURL(clustersPath, clusterID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, clusterID string) string {
+	return resourceURL(c, clusterID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, clusterID string) string {
+	return resourceURL(c, clusterID)
+}
+
+func scaleURL(c *gophercloud.ServiceClient, clusterID string) string {
+	return resourceURL(c, clusterID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, clusterID string) string {
+	return resourceURL(c, clusterID)
+}
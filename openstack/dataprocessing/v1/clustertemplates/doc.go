@@ -0,0 +1,66 @@
+/*
+Package clustertemplates manages cluster templates of the OpenStack Data
+Processing service (Sahara). A cluster template describes the node groups
+and the service configuration of a cluster.
+
+Example to List Cluster Templates
+
+	allPages, err := clustertemplates.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTemplates, err := clustertemplates.ExtractClusterTemplates(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, template := range allTemplates {
+		fmt.Printf("%+v\n", template)
+	}
+
+Example to Create a Cluster Template
+
+	createOpts := clustertemplates.CreateOpts{
+		Name:          "hadoop",
+		PluginName:    "vanilla",
+		HadoopVersion: "2.7.1",
+		NodeGroups: []clustertemplates.NodeGroupOpts{
+			{
+				Name:                "master",
+				Count:               1,
+				NodeGroupTemplateID: masterTemplateID,
+			},
+			{
+				Name:                "worker",
+				Count:               3,
+				NodeGroupTemplateID: workerTemplateID,
+			},
+		},
+	}
+
+	template, err := clustertemplates.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Cluster Template
+
+	isPublic := true
+	updateOpts := clustertemplates.UpdateOpts{
+		IsPublic: &isPublic,
+	}
+
+	template, err := clustertemplates.Update(client, templateID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Cluster Template
+
+	err := clustertemplates.Delete(client, templateID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package clustertemplates
@@ -0,0 +1,222 @@
+package clustertemplates
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToClusterTemplateListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// Name filters the templates by name.
+	Name string `q:"name"`
+
+	// PluginName filters the templates by plugin.
+	PluginName string `q:"plugin_name"`
+
+	// HadoopVersion filters the templates by plugin version.
+	HadoopVersion string `q:"hadoop_version"`
+
+	// Limit limits the number of templates to return.
+	Limit int `q:"limit"`
+
+	// Marker is the ID of the last template of the previous page.
+	Marker string `q:"marker"`
+
+	// SortBy sorts the templates by the given attribute. Prefix it with
+	// "-" to sort in descending order.
+	SortBy string `q:"sort_by"`
+}
+
+// ToClusterTemplateListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToClusterTemplateListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// cluster templates.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToClusterTemplateListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ClusterTemplatePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific cluster template based on its unique ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// NodeGroupOpts describes a node group of a cluster template.
+type NodeGroupOpts struct {
+	// Name is the name of the node group.
+	Name string `json:"name" required:"true"`
+
+	// Count is the number of nodes of the group.
+	Count int `json:"count"`
+
+	// NodeGroupTemplateID is the ID of the node group template of the
+	// group. If it is not set, the group is described by the other fields.
+	NodeGroupTemplateID string `json:"node_group_template_id,omitempty"`
+
+	// FlavorID is the ID of the flavor of the nodes.
+	FlavorID string `json:"flavor_id,omitempty"`
+
+	// NodeProcesses are the processes run on the nodes.
+	NodeProcesses []string `json:"node_processes,omitempty"`
+
+	// FloatingIPPool is the network floating IPs are allocated from.
+	FloatingIPPool string `json:"floating_ip_pool,omitempty"`
+
+	// NodeConfigs are the service configurations of the nodes.
+	NodeConfigs map[string]interface{} `json:"node_configs,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToClusterTemplateCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new cluster template.
+type CreateOpts struct {
+	// Name is the name of the template.
+	Name string `json:"name" required:"true"`
+
+	// Description is a description of the template.
+	Description string `json:"description,omitempty"`
+
+	// PluginName is the name of the plugin, e.g. "vanilla" or "spark".
+	PluginName string `json:"plugin_name" required:"true"`
+
+	// HadoopVersion is the version of the plugin.
+	HadoopVersion string `json:"hadoop_version" required:"true"`
+
+	// NodeGroups are the node groups of the clusters.
+	NodeGroups []NodeGroupOpts `json:"node_groups,omitempty"`
+
+	// DefaultImageID is the ID of the image of the nodes.
+	DefaultImageID string `json:"default_image_id,omitempty"`
+
+	// NeutronManagementNetwork is the ID of the network the nodes are
+	// attached to.
+	NeutronManagementNetwork string `json:"neutron_management_network,omitempty"`
+
+	// ClusterConfigs are the service configurations of the clusters.
+	ClusterConfigs map[string]interface{} `json:"cluster_configs,omitempty"`
+
+	// AntiAffinity are the processes which are not run on the same host.
+	AntiAffinity []string `json:"anti_affinity,omitempty"`
+
+	// UseAutoconfig lets the plugin configure the clusters automatically.
+	UseAutoconfig *bool `json:"use_autoconfig,omitempty"`
+
+	// DomainName is the domain name of the nodes.
+	DomainName string `json:"domain_name,omitempty"`
+
+	// IsPublic shares the template with other projects.
+	IsPublic *bool `json:"is_public,omitempty"`
+
+	// IsProtected protects the template from modification and deletion.
+	IsProtected *bool `json:"is_protected,omitempty"`
+}
+
+// ToClusterTemplateCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToClusterTemplateCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new cluster template.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToClusterTemplateCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToClusterTemplateUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters to update a cluster template.
+type UpdateOpts struct {
+	// Name is the name of the template.
+	Name string `json:"name,omitempty"`
+
+	// Description is a description of the template.
+	Description *string `json:"description,omitempty"`
+
+	// PluginName is the name of the plugin.
+	PluginName string `json:"plugin_name,omitempty"`
+
+	// HadoopVersion is the version of the plugin.
+	HadoopVersion string `json:"hadoop_version,omitempty"`
+
+	// NodeGroups replace the node groups of the template.
+	NodeGroups []NodeGroupOpts `json:"node_groups,omitempty"`
+
+	// DefaultImageID is the ID of the image of the nodes.
+	DefaultImageID *string `json:"default_image_id,omitempty"`
+
+	// NeutronManagementNetwork is the ID of the network the nodes are
+	// attached to.
+	NeutronManagementNetwork *string `json:"neutron_management_network,omitempty"`
+
+	// ClusterConfigs are the service configurations of the clusters.
+	ClusterConfigs map[string]interface{} `json:"cluster_configs,omitempty"`
+
+	// AntiAffinity are the processes which are not run on the same host.
+	AntiAffinity []string `json:"anti_affinity,omitempty"`
+
+	// IsPublic shares the template with other projects.
+	IsPublic *bool `json:"is_public,omitempty"`
+
+	// IsProtected protects the template from modification and deletion.
+	IsProtected *bool `json:"is_protected,omitempty"`
+}
+
+// ToClusterTemplateUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToClusterTemplateUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of a cluster template.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToClusterTemplateUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Delete requests the deletion of a cluster template.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
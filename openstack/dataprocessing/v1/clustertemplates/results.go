@@ -0,0 +1,209 @@
+package clustertemplates
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ClusterTemplate describes the node groups and configuration of a
+// cluster.
+type ClusterTemplate struct {
+	// ID is the unique ID of the template.
+	ID string `json:"id"`
+
+	// Name is the name of the template.
+	Name string `json:"name"`
+
+	// Description is a description of the template.
+	Description string `json:"description"`
+
+	// PluginName is the name of the plugin.
+	PluginName string `json:"plugin_name"`
+
+	// HadoopVersion is the version of the plugin.
+	HadoopVersion string `json:"hadoop_version"`
+
+	// NodeGroups are the node groups of the clusters.
+	NodeGroups []NodeGroup `json:"node_groups"`
+
+	// DefaultImageID is the ID of the image of the nodes.
+	DefaultImageID string `json:"default_image_id"`
+
+	// NeutronManagementNetwork is the ID of the network the nodes are
+	// attached to.
+	NeutronManagementNetwork string `json:"neutron_management_network"`
+
+	// ClusterConfigs are the service configurations of the clusters.
+	ClusterConfigs map[string]interface{} `json:"cluster_configs"`
+
+	// AntiAffinity are the processes which are not run on the same host.
+	AntiAffinity []string `json:"anti_affinity"`
+
+	// UseAutoconfig indicates whether the plugin configures the clusters
+	// automatically.
+	UseAutoconfig bool `json:"use_autoconfig"`
+
+	// DomainName is the domain name of the nodes.
+	DomainName string `json:"domain_name"`
+
+	// IsPublic indicates whether the template is shared.
+	IsPublic bool `json:"is_public"`
+
+	// IsProtected indicates whether the template is protected.
+	IsProtected bool `json:"is_protected"`
+
+	// IsDefault indicates whether the template is a plugin default.
+	IsDefault bool `json:"is_default"`
+
+	// TenantID is the ID of the project owning the template.
+	TenantID string `json:"tenant_id"`
+
+	// CreatedAt is the date the template was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the template was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// NodeGroup is a node group of a cluster template.
+type NodeGroup struct {
+	// Name is the name of the node group.
+	Name string `json:"name"`
+
+	// Count is the number of nodes of the group.
+	Count int `json:"count"`
+
+	// NodeGroupTemplateID is the ID of the node group template of the
+	// group.
+	NodeGroupTemplateID string `json:"node_group_template_id"`
+
+	// FlavorID is the ID of the flavor of the nodes.
+	FlavorID string `json:"flavor_id"`
+
+	// ImageID is the ID of the image of the nodes.
+	ImageID string `json:"image_id"`
+
+	// NodeProcesses are the processes run on the nodes.
+	NodeProcesses []string `json:"node_processes"`
+
+	// FloatingIPPool is the network floating IPs are allocated from.
+	FloatingIPPool string `json:"floating_ip_pool"`
+
+	// SecurityGroups are the security groups of the nodes.
+	SecurityGroups []string `json:"security_groups"`
+
+	// AutoSecurityGroup indicates whether a security group is created for
+	// the nodes.
+	AutoSecurityGroup bool `json:"auto_security_group"`
+
+	// VolumesPerNode is the number of volumes attached to each node.
+	VolumesPerNode int `json:"volumes_per_node"`
+
+	// VolumesSize is the size of each volume in GB.
+	VolumesSize int `json:"volumes_size"`
+
+	// NodeConfigs are the service configurations of the nodes.
+	NodeConfigs map[string]interface{} `json:"node_configs"`
+}
+
+func (r *ClusterTemplate) UnmarshalJSON(b []byte) error {
+	type tmp ClusterTemplate
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ClusterTemplate(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a ClusterTemplate.
+func (r commonResult) Extract() (*ClusterTemplate, error) {
+	var s struct {
+		ClusterTemplate *ClusterTemplate `json:"cluster_template"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ClusterTemplate, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a ClusterTemplate.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a ClusterTemplate.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a ClusterTemplate.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ClusterTemplatePage is a single page of ClusterTemplate results.
+type ClusterTemplatePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL builds the URL of the next page from the marker returned by
+// the API.
+func (r ClusterTemplatePage) NextPageURL() (string, error) {
+	var s struct {
+		Markers struct {
+			Next string `json:"next"`
+		} `json:"markers"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil || s.Markers.Next == "" {
+		return "", err
+	}
+
+	u := r.URL
+	q := u.Query()
+	q.Set("marker", s.Markers.Next)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// IsEmpty determines whether or not a ClusterTemplatePage contains any
+// results.
+func (r ClusterTemplatePage) IsEmpty() (bool, error) {
+	templates, err := ExtractClusterTemplates(r)
+	return len(templates) == 0, err
+}
+
+// ExtractClusterTemplates returns a slice of ClusterTemplates contained in a
+// single page of results.
+func ExtractClusterTemplates(r pagination.Page) ([]ClusterTemplate, error) {
+	var s struct {
+		ClusterTemplates []ClusterTemplate `json:"cluster_templates"`
+	}
+	err := (r.(ClusterTemplatePage)).ExtractInto(&s)
+	return s.ClusterTemplates, err
+}
@@ -0,0 +1,2 @@
+// dataprocessing_clustertemplates_v1
+package testing
@@ -0,0 +1,189 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/clustertemplates"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const TemplateID = "2c76e0d3-56cd-4d28-bb4f-4808e538c7b9"
+
+// TemplateBody is the JSON representation of a single cluster template.
+const TemplateBody = `
+{
+  "id": "2c76e0d3-56cd-4d28-bb4f-4808e538c7b9",
+  "name": "hadoop",
+  "description": "Hadoop cluster",
+  "plugin_name": "vanilla",
+  "hadoop_version": "2.7.1",
+  "node_groups": [
+    {
+      "name": "worker",
+      "count": 3,
+      "node_group_template_id": "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad",
+      "flavor_id": "2",
+      "image_id": null,
+      "node_processes": ["datanode", "nodemanager"],
+      "floating_ip_pool": "public",
+      "security_groups": null,
+      "auto_security_group": true,
+      "volumes_per_node": 0,
+      "volumes_size": 0,
+      "node_configs": {}
+    }
+  ],
+  "default_image_id": "cb9ef7a4-9b42-4b96-aeb3-6a25b5f2e0fc",
+  "neutron_management_network": "b5bc49be-ea6f-472d-9240-968f75d7a16a",
+  "cluster_configs": {},
+  "anti_affinity": ["datanode"],
+  "use_autoconfig": true,
+  "domain_name": null,
+  "is_public": false,
+  "is_protected": false,
+  "is_default": false,
+  "tenant_id": "808d5032ea0446889097723bfc8e919d",
+  "created_at": "2015-09-14T10:57:23",
+  "updated_at": "2015-09-14T10:58:01"
+}
+`
+
+// GetResponse is a sample response to a Get call.
+var GetResponse = fmt.Sprintf(`{"cluster_template": %s}`, TemplateBody)
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`
+{
+  "cluster_templates": [%s],
+  "markers": {
+    "prev": null,
+    "next": null
+  }
+}
+`, TemplateBody)
+
+// CreateRequest is a sample request to create a cluster template.
+const CreateRequest = `
+{
+  "name": "hadoop",
+  "description": "Hadoop cluster",
+  "plugin_name": "vanilla",
+  "hadoop_version": "2.7.1",
+  "node_groups": [
+    {
+      "name": "worker",
+      "count": 3,
+      "node_group_template_id": "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad"
+    }
+  ],
+  "default_image_id": "cb9ef7a4-9b42-4b96-aeb3-6a25b5f2e0fc",
+  "neutron_management_network": "b5bc49be-ea6f-472d-9240-968f75d7a16a",
+  "anti_affinity": ["datanode"]
+}
+`
+
+// UpdateRequest is a sample request to update a cluster template.
+const UpdateRequest = `
+{
+  "description": "Hadoop cluster",
+  "is_public": false
+}
+`
+
+// FirstTemplate is the template described by TemplateBody.
+var FirstTemplate = clustertemplates.ClusterTemplate{
+	ID:            TemplateID,
+	Name:          "hadoop",
+	Description:   "Hadoop cluster",
+	PluginName:    "vanilla",
+	HadoopVersion: "2.7.1",
+	NodeGroups: []clustertemplates.NodeGroup{
+		{
+			Name:                "worker",
+			Count:               3,
+			NodeGroupTemplateID: "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad",
+			FlavorID:            "2",
+			NodeProcesses:       []string{"datanode", "nodemanager"},
+			FloatingIPPool:      "public",
+			AutoSecurityGroup:   true,
+			NodeConfigs:         map[string]interface{}{},
+		},
+	},
+	DefaultImageID:           "cb9ef7a4-9b42-4b96-aeb3-6a25b5f2e0fc",
+	NeutronManagementNetwork: "b5bc49be-ea6f-472d-9240-968f75d7a16a",
+	ClusterConfigs:           map[string]interface{}{},
+	AntiAffinity:             []string{"datanode"},
+	UseAutoconfig:            true,
+	TenantID:                 "808d5032ea0446889097723bfc8e919d",
+	CreatedAt:                time.Date(2015, 9, 14, 10, 57, 23, 0, time.UTC),
+	UpdatedAt:                time.Date(2015, 9, 14, 10, 58, 1, 0, time.UTC),
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/cluster-templates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/cluster-templates/"+TemplateID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/cluster-templates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/cluster-templates/"+TemplateID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/cluster-templates/"+TemplateID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
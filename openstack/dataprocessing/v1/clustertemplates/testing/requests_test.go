@@ -0,0 +1,91 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/clustertemplates"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListClusterTemplates(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := clustertemplates.List(fake.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := clustertemplates.ExtractClusterTemplates(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []clustertemplates.ClusterTemplate{FirstTemplate}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetClusterTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := clustertemplates.Get(fake.ServiceClient(), TemplateID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTemplate, actual)
+}
+
+func TestCreateClusterTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := clustertemplates.CreateOpts{
+		Name:          "hadoop",
+		Description:   "Hadoop cluster",
+		PluginName:    "vanilla",
+		HadoopVersion: "2.7.1",
+		NodeGroups: []clustertemplates.NodeGroupOpts{
+			{
+				Name:                "worker",
+				Count:               3,
+				NodeGroupTemplateID: "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad",
+			},
+		},
+		DefaultImageID:           "cb9ef7a4-9b42-4b96-aeb3-6a25b5f2e0fc",
+		NeutronManagementNetwork: "b5bc49be-ea6f-472d-9240-968f75d7a16a",
+		AntiAffinity:             []string{"datanode"},
+	}
+
+	actual, err := clustertemplates.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTemplate, actual)
+}
+
+func TestUpdateClusterTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	description := "Hadoop cluster"
+	isPublic := false
+	updateOpts := clustertemplates.UpdateOpts{
+		Description: &description,
+		IsPublic:    &isPublic,
+	}
+
+	actual, err := clustertemplates.Update(fake.ServiceClient(), TemplateID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTemplate, actual)
+}
+
+func TestDeleteClusterTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := clustertemplates.Delete(fake.ServiceClient(), TemplateID)
+	th.AssertNoErr(t, res.Err)
+}
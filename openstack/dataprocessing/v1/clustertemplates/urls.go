@@ -0,0 +1,33 @@
+package clustertemplates
+
+import "github.com/gophercloud/gophercloud"
+
+const templatesPath = "cluster-templates"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(templatesPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, templateID string) string {
+	return c.ServiceURL(templatesPath, templateID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, templateID string) string {
+	return resourceURL(c, templateID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, templateID string) string {
+	return resourceURL(c, templateID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, templateID string) string {
+	return resourceURL(c, templateID)
+}
@@ -0,0 +1,62 @@
+/*
+Package jobexecutions runs jobs on clusters of the OpenStack Data Processing
+service (Sahara) and manages their executions.
+
+Example to List Job Executions
+
+	allPages, err := jobexecutions.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allJobExecutions, err := jobexecutions.ExtractJobExecutions(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, jobExecution := range allJobExecutions {
+		fmt.Printf("%+v\n", jobExecution)
+	}
+
+Example to Run a Job
+
+	createOpts := jobexecutions.CreateOpts{
+		ClusterID: clusterID,
+		InputID:   inputID,
+		OutputID:  outputID,
+		JobConfigs: &jobexecutions.JobConfigs{
+			Configs: map[string]interface{}{
+				"mapred.map.tasks": "1",
+			},
+		},
+	}
+
+	jobExecution, err := jobexecutions.Create(client, jobID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Cancel a Job Execution
+
+	jobExecution, err := jobexecutions.Cancel(client, jobExecutionID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Refresh the Status of a Job Execution
+
+	jobExecution, err := jobexecutions.RefreshStatus(client, jobExecutionID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(jobExecution.Info.Status)
+
+Example to Delete a Job Execution
+
+	err := jobexecutions.Delete(client, jobExecutionID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package jobexecutions
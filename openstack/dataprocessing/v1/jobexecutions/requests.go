@@ -0,0 +1,171 @@
+package jobexecutions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToJobExecutionListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// Limit limits the number of job executions to return.
+	Limit int `q:"limit"`
+
+	// Marker is the ID of the last job execution of the previous page.
+	Marker string `q:"marker"`
+
+	// SortBy sorts the job executions by the given attribute. Prefix it
+	// with "-" to sort in descending order.
+	SortBy string `q:"sort_by"`
+}
+
+// ToJobExecutionListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToJobExecutionListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of job
+// executions.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToJobExecutionListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return JobExecutionPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific job execution based on its unique ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// JobConfigs are the configuration, arguments and parameters of a job
+// execution.
+type JobConfigs struct {
+	// Configs are the configuration values of the job.
+	Configs map[string]interface{} `json:"configs,omitempty"`
+
+	// Args are the arguments passed to the job.
+	Args []string `json:"args,omitempty"`
+
+	// Params are the parameters of the job, e.g. of a Pig or Hive script.
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToJobExecutionCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new job execution.
+type CreateOpts struct {
+	// ClusterID is the ID of the cluster the job is run on.
+	ClusterID string `json:"cluster_id" required:"true"`
+
+	// InputID is the ID of the input data source of the job.
+	InputID string `json:"input_id,omitempty"`
+
+	// OutputID is the ID of the output data source of the job.
+	OutputID string `json:"output_id,omitempty"`
+
+	// JobConfigs are the configuration, arguments and parameters of the
+	// job execution.
+	JobConfigs *JobConfigs `json:"job_configs,omitempty"`
+
+	// Interface are the values of the arguments declared by the interface
+	// of the job.
+	Interface map[string]string `json:"interface,omitempty"`
+
+	// IsPublic shares the job execution with other projects.
+	IsPublic *bool `json:"is_public,omitempty"`
+
+	// IsProtected protects the job execution from modification and
+	// deletion.
+	IsProtected *bool `json:"is_protected,omitempty"`
+}
+
+// ToJobExecutionCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToJobExecutionCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create runs the job with the given ID.
+func Create(client *gophercloud.ServiceClient, jobID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToJobExecutionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, jobID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToJobExecutionUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters to update a job execution.
+type UpdateOpts struct {
+	// IsPublic shares the job execution with other projects.
+	IsPublic *bool `json:"is_public,omitempty"`
+
+	// IsProtected protects the job execution from modification and
+	// deletion.
+	IsProtected *bool `json:"is_protected,omitempty"`
+}
+
+// ToJobExecutionUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToJobExecutionUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of a job execution.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToJobExecutionUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Cancel requests the cancellation of a running job execution.
+func Cancel(client *gophercloud.ServiceClient, id string) (r CancelResult) {
+	_, r.Err = client.Get(cancelURL(client, id), &r.Body, nil)
+	return
+}
+
+// RefreshStatus requests the status of a job execution to be refreshed from
+// the cluster it runs on.
+func RefreshStatus(client *gophercloud.ServiceClient, id string) (r RefreshStatusResult) {
+	_, r.Err = client.Get(refreshStatusURL(client, id), &r.Body, nil)
+	return
+}
+
+// Delete requests the deletion of a job execution.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
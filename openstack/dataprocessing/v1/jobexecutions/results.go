@@ -0,0 +1,187 @@
+package jobexecutions
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// JobExecution represents a run of a job on a data processing cluster.
+type JobExecution struct {
+	// ID is the unique ID of the job execution.
+	ID string `json:"id"`
+
+	// JobID is the ID of the job which is run.
+	JobID string `json:"job_id"`
+
+	// ClusterID is the ID of the cluster the job is run on.
+	ClusterID string `json:"cluster_id"`
+
+	// InputID is the ID of the input data source of the job.
+	InputID string `json:"input_id"`
+
+	// OutputID is the ID of the output data source of the job.
+	OutputID string `json:"output_id"`
+
+	// JobConfigs are the configuration, arguments and parameters of the
+	// job execution.
+	JobConfigs JobConfigs `json:"job_configs"`
+
+	// Interface are the values of the arguments declared by the interface
+	// of the job.
+	Interface map[string]string `json:"interface"`
+
+	// Info describes the status of the job execution.
+	Info Info `json:"info"`
+
+	// OozieJobID is the ID of the job in the workflow engine of the
+	// cluster.
+	OozieJobID string `json:"oozie_job_id"`
+
+	// ReturnCode is the return code of the job.
+	ReturnCode string `json:"return_code"`
+
+	// IsPublic indicates whether the job execution is shared.
+	IsPublic bool `json:"is_public"`
+
+	// IsProtected indicates whether the job execution is protected.
+	IsProtected bool `json:"is_protected"`
+
+	// TenantID is the ID of the project owning the job execution.
+	TenantID string `json:"tenant_id"`
+
+	// StartTime is the date the job started.
+	StartTime time.Time `json:"-"`
+
+	// EndTime is the date the job ended.
+	EndTime time.Time `json:"-"`
+
+	// CreatedAt is the date the job execution was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the job execution was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// Info describes the status of a job execution.
+type Info struct {
+	// Status is the status of the job execution, e.g. "RUNNING",
+	// "SUCCEEDED" or "KILLED".
+	Status string `json:"status"`
+}
+
+func (r *JobExecution) UnmarshalJSON(b []byte) error {
+	type tmp JobExecution
+	var s struct {
+		tmp
+		StartTime gophercloud.JSONRFC3339MilliNoZ `json:"start_time"`
+		EndTime   gophercloud.JSONRFC3339MilliNoZ `json:"end_time"`
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = JobExecution(s.tmp)
+
+	r.StartTime = time.Time(s.StartTime)
+	r.EndTime = time.Time(s.EndTime)
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a JobExecution.
+func (r commonResult) Extract() (*JobExecution, error) {
+	var s struct {
+		JobExecution *JobExecution `json:"job_execution"`
+	}
+	err := r.ExtractInto(&s)
+	return s.JobExecution, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a JobExecution.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a JobExecution.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a JobExecution.
+type UpdateResult struct {
+	commonResult
+}
+
+// CancelResult is the response from a Cancel operation. Call its Extract
+// method to interpret it as a JobExecution.
+type CancelResult struct {
+	commonResult
+}
+
+// RefreshStatusResult is the response from a RefreshStatus operation. Call
+// its Extract method to interpret it as a JobExecution.
+type RefreshStatusResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// JobExecutionPage is a single page of JobExecution results.
+type JobExecutionPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL builds the URL of the next page from the marker returned by
+// the API.
+func (r JobExecutionPage) NextPageURL() (string, error) {
+	var s struct {
+		Markers struct {
+			Next string `json:"next"`
+		} `json:"markers"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil || s.Markers.Next == "" {
+		return "", err
+	}
+
+	u := r.URL
+	q := u.Query()
+	q.Set("marker", s.Markers.Next)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// IsEmpty determines whether or not a JobExecutionPage contains any results.
+func (r JobExecutionPage) IsEmpty() (bool, error) {
+	jobExecutions, err := ExtractJobExecutions(r)
+	return len(jobExecutions) == 0, err
+}
+
+// ExtractJobExecutions returns a slice of JobExecutions contained in a
+// single page of results.
+func ExtractJobExecutions(r pagination.Page) ([]JobExecution, error) {
+	var s struct {
+		JobExecutions []JobExecution `json:"job_executions"`
+	}
+	err := (r.(JobExecutionPage)).ExtractInto(&s)
+	return s.JobExecutions, err
+}
@@ -0,0 +1,2 @@
+// dataprocessing_jobexecutions_v1
+package testing
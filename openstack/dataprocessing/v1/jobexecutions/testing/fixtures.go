@@ -0,0 +1,199 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/jobexecutions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const (
+	JobID          = "548ea8d4-a5sd-33a4-bt22-asf4n87a8e2dh"
+	JobExecutionID = "e63bdc21-0126-4fd2-90c6-5163d16f31df"
+)
+
+// JobExecutionBody is the JSON representation of a single job execution.
+const JobExecutionBody = `
+{
+  "id": "e63bdc21-0126-4fd2-90c6-5163d16f31df",
+  "job_id": "548ea8d4-a5sd-33a4-bt22-asf4n87a8e2dh",
+  "cluster_id": "811e1134-666f-4c48-bc92-afb5b10c9d8c",
+  "input_id": "3e1bc8e6-8c69-4749-8e52-90d9341d15bc",
+  "output_id": "52146b52-6540-4aac-a024-fee253cf52a9",
+  "job_configs": {
+    "configs": {
+      "mapred.map.tasks": "1"
+    },
+    "args": [],
+    "params": {}
+  },
+  "interface": {},
+  "info": {
+    "status": "RUNNING"
+  },
+  "oozie_job_id": "0000000-150914111117640-oozie-hado-W",
+  "return_code": null,
+  "is_public": false,
+  "is_protected": false,
+  "tenant_id": "808d5032ea0446889097723bfc8e919d",
+  "start_time": "2015-09-15T12:49:43",
+  "end_time": null,
+  "created_at": "2015-09-15T12:49:43",
+  "updated_at": "2015-09-15T12:50:46"
+}
+`
+
+// GetResponse is a sample response to a Get call.
+var GetResponse = fmt.Sprintf(`{"job_execution": %s}`, JobExecutionBody)
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`
+{
+  "job_executions": [%s],
+  "markers": {
+    "prev": null,
+    "next": null
+  }
+}
+`, JobExecutionBody)
+
+// CreateRequest is a sample request to run a job.
+const CreateRequest = `
+{
+  "cluster_id": "811e1134-666f-4c48-bc92-afb5b10c9d8c",
+  "input_id": "3e1bc8e6-8c69-4749-8e52-90d9341d15bc",
+  "output_id": "52146b52-6540-4aac-a024-fee253cf52a9",
+  "job_configs": {
+    "configs": {
+      "mapred.map.tasks": "1"
+    }
+  }
+}
+`
+
+// UpdateRequest is a sample request to update a job execution.
+const UpdateRequest = `
+{
+  "is_public": true
+}
+`
+
+// FirstJobExecution is the job execution described by JobExecutionBody.
+var FirstJobExecution = jobexecutions.JobExecution{
+	ID:        JobExecutionID,
+	JobID:     JobID,
+	ClusterID: "811e1134-666f-4c48-bc92-afb5b10c9d8c",
+	InputID:   "3e1bc8e6-8c69-4749-8e52-90d9341d15bc",
+	OutputID:  "52146b52-6540-4aac-a024-fee253cf52a9",
+	JobConfigs: jobexecutions.JobConfigs{
+		Configs: map[string]interface{}{
+			"mapred.map.tasks": "1",
+		},
+		Args:   []string{},
+		Params: map[string]interface{}{},
+	},
+	Interface: map[string]string{},
+	Info: jobexecutions.Info{
+		Status: "RUNNING",
+	},
+	OozieJobID: "0000000-150914111117640-oozie-hado-W",
+	TenantID:   "808d5032ea0446889097723bfc8e919d",
+	StartTime:  time.Date(2015, 9, 15, 12, 49, 43, 0, time.UTC),
+	CreatedAt:  time.Date(2015, 9, 15, 12, 49, 43, 0, time.UTC),
+	UpdatedAt:  time.Date(2015, 9, 15, 12, 50, 46, 0, time.UTC),
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/job-executions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/job-executions/"+JobExecutionID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs/"+JobID+"/execute", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/job-executions/"+JobExecutionID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCancelSuccessfully configures the test server to respond to a
+// Cancel request.
+func HandleCancelSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/job-executions/"+JobExecutionID+"/cancel", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleRefreshStatusSuccessfully configures the test server to respond to
+// a RefreshStatus request.
+func HandleRefreshStatusSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/job-executions/"+JobExecutionID+"/refresh-status", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/job-executions/"+JobExecutionID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
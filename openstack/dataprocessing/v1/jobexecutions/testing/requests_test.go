@@ -0,0 +1,110 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/jobexecutions"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListJobExecutions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := jobexecutions.List(fake.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := jobexecutions.ExtractJobExecutions(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []jobexecutions.JobExecution{FirstJobExecution}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetJobExecution(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := jobexecutions.Get(fake.ServiceClient(), JobExecutionID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstJobExecution, actual)
+}
+
+func TestCreateJobExecution(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := jobexecutions.CreateOpts{
+		ClusterID: "811e1134-666f-4c48-bc92-afb5b10c9d8c",
+		InputID:   "3e1bc8e6-8c69-4749-8e52-90d9341d15bc",
+		OutputID:  "52146b52-6540-4aac-a024-fee253cf52a9",
+		JobConfigs: &jobexecutions.JobConfigs{
+			Configs: map[string]interface{}{
+				"mapred.map.tasks": "1",
+			},
+		},
+	}
+
+	actual, err := jobexecutions.Create(fake.ServiceClient(), JobID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstJobExecution, actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := jobexecutions.Create(fake.ServiceClient(), JobID, jobexecutions.CreateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdateJobExecution(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	isPublic := true
+	updateOpts := jobexecutions.UpdateOpts{
+		IsPublic: &isPublic,
+	}
+
+	actual, err := jobexecutions.Update(fake.ServiceClient(), JobExecutionID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstJobExecution, actual)
+}
+
+func TestCancelJobExecution(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCancelSuccessfully(t)
+
+	actual, err := jobexecutions.Cancel(fake.ServiceClient(), JobExecutionID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstJobExecution, actual)
+}
+
+func TestRefreshJobExecutionStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRefreshStatusSuccessfully(t)
+
+	actual, err := jobexecutions.RefreshStatus(fake.ServiceClient(), JobExecutionID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstJobExecution, actual)
+}
+
+func TestDeleteJobExecution(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := jobexecutions.Delete(fake.ServiceClient(), JobExecutionID)
+	th.AssertNoErr(t, res.Err)
+}
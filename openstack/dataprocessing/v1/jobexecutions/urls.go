@@ -0,0 +1,37 @@
+package jobexecutions
+
+import "github.com/gophercloud/gophercloud"
+
+const jobExecutionsPath = "job-executions"
+
+func resourceURL(c *gophercloud.ServiceClient, jobExecutionID string) string {
+	return c.ServiceURL(jobExecutionsPath, jobExecutionID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(jobExecutionsPath)
+}
+
+func createURL(c *gophercloud.ServiceClient, jobID string) string {
+	return c.ServiceURL("jobs", jobID, "execute")
+}
+
+func getURL(c *gophercloud.ServiceClient, jobExecutionID string) string {
+	return resourceURL(c, jobExecutionID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, jobExecutionID string) string {
+	return resourceURL(c, jobExecutionID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, jobExecutionID string) string {
+	return resourceURL(c, jobExecutionID)
+}
+
+func cancelURL(c *gophercloud.ServiceClient, jobExecutionID string) string {
+	return c.ServiceURL(jobExecutionsPath, jobExecutionID, "cancel")
+}
+
+func refreshStatusURL(c *gophercloud.ServiceClient, jobExecutionID string) string {
+	return c.ServiceURL(jobExecutionsPath, jobExecutionID, "refresh-status")
+}
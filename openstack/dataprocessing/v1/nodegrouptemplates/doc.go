@@ -0,0 +1,60 @@
+/*
+Package nodegrouptemplates manages node group templates of the OpenStack
+Data Processing service (Sahara). A node group template describes the
+flavor, image, storage and processes of a group of nodes of a cluster.
+
+Example to List Node Group Templates
+
+	listOpts := nodegrouptemplates.ListOpts{
+		PluginName: "vanilla",
+	}
+
+	allPages, err := nodegrouptemplates.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTemplates, err := nodegrouptemplates.ExtractNodeGroupTemplates(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, template := range allTemplates {
+		fmt.Printf("%+v\n", template)
+	}
+
+Example to Create a Node Group Template
+
+	createOpts := nodegrouptemplates.CreateOpts{
+		Name:          "worker",
+		PluginName:    "vanilla",
+		HadoopVersion: "2.7.1",
+		NodeProcesses: []string{"datanode", "nodemanager"},
+		FlavorID:      "2",
+	}
+
+	template, err := nodegrouptemplates.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Node Group Template
+
+	volumesPerNode := 2
+	updateOpts := nodegrouptemplates.UpdateOpts{
+		VolumesPerNode: &volumesPerNode,
+	}
+
+	template, err := nodegrouptemplates.Update(client, templateID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Node Group Template
+
+	err := nodegrouptemplates.Delete(client, templateID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package nodegrouptemplates
@@ -0,0 +1,231 @@
+package nodegrouptemplates
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToNodeGroupTemplateListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// Name filters the templates by name.
+	Name string `q:"name"`
+
+	// PluginName filters the templates by plugin.
+	PluginName string `q:"plugin_name"`
+
+	// HadoopVersion filters the templates by plugin version.
+	HadoopVersion string `q:"hadoop_version"`
+
+	// Limit limits the number of templates to return.
+	Limit int `q:"limit"`
+
+	// Marker is the ID of the last template of the previous page.
+	Marker string `q:"marker"`
+
+	// SortBy sorts the templates by the given attribute. Prefix it with
+	// "-" to sort in descending order.
+	SortBy string `q:"sort_by"`
+}
+
+// ToNodeGroupTemplateListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToNodeGroupTemplateListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// node group templates.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToNodeGroupTemplateListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return NodeGroupTemplatePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific node group template based on its unique ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToNodeGroupTemplateCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new node group template.
+type CreateOpts struct {
+	// Name is the name of the template.
+	Name string `json:"name" required:"true"`
+
+	// Description is a description of the template.
+	Description string `json:"description,omitempty"`
+
+	// PluginName is the name of the plugin, e.g. "vanilla" or "spark".
+	PluginName string `json:"plugin_name" required:"true"`
+
+	// HadoopVersion is the version of the plugin.
+	HadoopVersion string `json:"hadoop_version" required:"true"`
+
+	// NodeProcesses are the processes run on the nodes, e.g. "namenode".
+	NodeProcesses []string `json:"node_processes" required:"true"`
+
+	// FlavorID is the ID of the flavor of the nodes.
+	FlavorID string `json:"flavor_id" required:"true"`
+
+	// ImageID is the ID of the image of the nodes.
+	ImageID string `json:"image_id,omitempty"`
+
+	// FloatingIPPool is the network floating IPs are allocated from.
+	FloatingIPPool string `json:"floating_ip_pool,omitempty"`
+
+	// SecurityGroups are the security groups of the nodes.
+	SecurityGroups []string `json:"security_groups,omitempty"`
+
+	// AutoSecurityGroup creates a security group for the nodes.
+	AutoSecurityGroup *bool `json:"auto_security_group,omitempty"`
+
+	// AvailabilityZone is the availability zone of the nodes.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+
+	// VolumesPerNode is the number of volumes attached to each node.
+	VolumesPerNode int `json:"volumes_per_node,omitempty"`
+
+	// VolumesSize is the size of each volume in GB.
+	VolumesSize int `json:"volumes_size,omitempty"`
+
+	// VolumeType is the type of the volumes.
+	VolumeType string `json:"volume_type,omitempty"`
+
+	// VolumesAvailabilityZone is the availability zone of the volumes.
+	VolumesAvailabilityZone string `json:"volumes_availability_zone,omitempty"`
+
+	// VolumeLocalToInstance creates the volumes on the host of the node.
+	VolumeLocalToInstance *bool `json:"volume_local_to_instance,omitempty"`
+
+	// IsProxyGateway uses the nodes as proxy to access the other nodes.
+	IsProxyGateway *bool `json:"is_proxy_gateway,omitempty"`
+
+	// UseAutoconfig lets the plugin configure the nodes automatically.
+	UseAutoconfig *bool `json:"use_autoconfig,omitempty"`
+
+	// IsPublic shares the template with other projects.
+	IsPublic *bool `json:"is_public,omitempty"`
+
+	// IsProtected protects the template from modification and deletion.
+	IsProtected *bool `json:"is_protected,omitempty"`
+
+	// NodeConfigs are the service configurations of the nodes.
+	NodeConfigs map[string]interface{} `json:"node_configs,omitempty"`
+}
+
+// ToNodeGroupTemplateCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToNodeGroupTemplateCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new node group template.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToNodeGroupTemplateCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToNodeGroupTemplateUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters to update a node group template.
+type UpdateOpts struct {
+	// Name is the name of the template.
+	Name string `json:"name,omitempty"`
+
+	// Description is a description of the template.
+	Description *string `json:"description,omitempty"`
+
+	// PluginName is the name of the plugin.
+	PluginName string `json:"plugin_name,omitempty"`
+
+	// HadoopVersion is the version of the plugin.
+	HadoopVersion string `json:"hadoop_version,omitempty"`
+
+	// NodeProcesses are the processes run on the nodes.
+	NodeProcesses []string `json:"node_processes,omitempty"`
+
+	// FlavorID is the ID of the flavor of the nodes.
+	FlavorID string `json:"flavor_id,omitempty"`
+
+	// ImageID is the ID of the image of the nodes.
+	ImageID *string `json:"image_id,omitempty"`
+
+	// FloatingIPPool is the network floating IPs are allocated from.
+	FloatingIPPool *string `json:"floating_ip_pool,omitempty"`
+
+	// SecurityGroups are the security groups of the nodes.
+	SecurityGroups []string `json:"security_groups,omitempty"`
+
+	// AutoSecurityGroup creates a security group for the nodes.
+	AutoSecurityGroup *bool `json:"auto_security_group,omitempty"`
+
+	// VolumesPerNode is the number of volumes attached to each node.
+	VolumesPerNode *int `json:"volumes_per_node,omitempty"`
+
+	// VolumesSize is the size of each volume in GB.
+	VolumesSize *int `json:"volumes_size,omitempty"`
+
+	// IsPublic shares the template with other projects.
+	IsPublic *bool `json:"is_public,omitempty"`
+
+	// IsProtected protects the template from modification and deletion.
+	IsProtected *bool `json:"is_protected,omitempty"`
+
+	// NodeConfigs are the service configurations of the nodes.
+	NodeConfigs map[string]interface{} `json:"node_configs,omitempty"`
+}
+
+// ToNodeGroupTemplateUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToNodeGroupTemplateUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of a node group template.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToNodeGroupTemplateUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Delete requests the deletion of a node group template.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
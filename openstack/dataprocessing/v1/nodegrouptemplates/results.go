@@ -0,0 +1,196 @@
+package nodegrouptemplates
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// NodeGroupTemplate describes the configuration of a group of nodes of a
+// cluster.
+type NodeGroupTemplate struct {
+	// ID is the unique ID of the template.
+	ID string `json:"id"`
+
+	// Name is the name of the template.
+	Name string `json:"name"`
+
+	// Description is a description of the template.
+	Description string `json:"description"`
+
+	// PluginName is the name of the plugin.
+	PluginName string `json:"plugin_name"`
+
+	// HadoopVersion is the version of the plugin.
+	HadoopVersion string `json:"hadoop_version"`
+
+	// NodeProcesses are the processes run on the nodes.
+	NodeProcesses []string `json:"node_processes"`
+
+	// FlavorID is the ID of the flavor of the nodes.
+	FlavorID string `json:"flavor_id"`
+
+	// ImageID is the ID of the image of the nodes.
+	ImageID string `json:"image_id"`
+
+	// FloatingIPPool is the network floating IPs are allocated from.
+	FloatingIPPool string `json:"floating_ip_pool"`
+
+	// SecurityGroups are the security groups of the nodes.
+	SecurityGroups []string `json:"security_groups"`
+
+	// AutoSecurityGroup indicates whether a security group is created for
+	// the nodes.
+	AutoSecurityGroup bool `json:"auto_security_group"`
+
+	// AvailabilityZone is the availability zone of the nodes.
+	AvailabilityZone string `json:"availability_zone"`
+
+	// VolumesPerNode is the number of volumes attached to each node.
+	VolumesPerNode int `json:"volumes_per_node"`
+
+	// VolumesSize is the size of each volume in GB.
+	VolumesSize int `json:"volumes_size"`
+
+	// VolumeType is the type of the volumes.
+	VolumeType string `json:"volume_type"`
+
+	// VolumesAvailabilityZone is the availability zone of the volumes.
+	VolumesAvailabilityZone string `json:"volumes_availability_zone"`
+
+	// VolumeLocalToInstance indicates whether the volumes are created on
+	// the host of the node.
+	VolumeLocalToInstance bool `json:"volume_local_to_instance"`
+
+	// VolumeMountPrefix is the prefix of the mount points of the volumes.
+	VolumeMountPrefix string `json:"volume_mount_prefix"`
+
+	// IsProxyGateway indicates whether the nodes are used as proxy.
+	IsProxyGateway bool `json:"is_proxy_gateway"`
+
+	// UseAutoconfig indicates whether the plugin configures the nodes
+	// automatically.
+	UseAutoconfig bool `json:"use_autoconfig"`
+
+	// IsPublic indicates whether the template is shared.
+	IsPublic bool `json:"is_public"`
+
+	// IsProtected indicates whether the template is protected.
+	IsProtected bool `json:"is_protected"`
+
+	// IsDefault indicates whether the template is a plugin default.
+	IsDefault bool `json:"is_default"`
+
+	// NodeConfigs are the service configurations of the nodes.
+	NodeConfigs map[string]interface{} `json:"node_configs"`
+
+	// TenantID is the ID of the project owning the template.
+	TenantID string `json:"tenant_id"`
+
+	// CreatedAt is the date the template was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the template was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+func (r *NodeGroupTemplate) UnmarshalJSON(b []byte) error {
+	type tmp NodeGroupTemplate
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = NodeGroupTemplate(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a NodeGroupTemplate.
+func (r commonResult) Extract() (*NodeGroupTemplate, error) {
+	var s struct {
+		NodeGroupTemplate *NodeGroupTemplate `json:"node_group_template"`
+	}
+	err := r.ExtractInto(&s)
+	return s.NodeGroupTemplate, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a NodeGroupTemplate.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a NodeGroupTemplate.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a NodeGroupTemplate.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// NodeGroupTemplatePage is a single page of NodeGroupTemplate results.
+type NodeGroupTemplatePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL builds the URL of the next page from the marker returned by
+// the API.
+func (r NodeGroupTemplatePage) NextPageURL() (string, error) {
+	var s struct {
+		Markers struct {
+			Next string `json:"next"`
+		} `json:"markers"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil || s.Markers.Next == "" {
+		return "", err
+	}
+
+	u := r.URL
+	q := u.Query()
+	q.Set("marker", s.Markers.Next)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// IsEmpty determines whether or not a NodeGroupTemplatePage contains any
+// results.
+func (r NodeGroupTemplatePage) IsEmpty() (bool, error) {
+	templates, err := ExtractNodeGroupTemplates(r)
+	return len(templates) == 0, err
+}
+
+// ExtractNodeGroupTemplates returns a slice of NodeGroupTemplates contained
+// in a single page of results.
+func ExtractNodeGroupTemplates(r pagination.Page) ([]NodeGroupTemplate, error) {
+	var s struct {
+		NodeGroupTemplates []NodeGroupTemplate `json:"node_group_templates"`
+	}
+	err := (r.(NodeGroupTemplatePage)).ExtractInto(&s)
+	return s.NodeGroupTemplates, err
+}
@@ -0,0 +1,2 @@
+// dataprocessing_nodegrouptemplates_v1
+package testing
@@ -0,0 +1,206 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/nodegrouptemplates"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const TemplateID = "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad"
+
+// TemplateBody is the JSON representation of a single node group template.
+const TemplateBody = `
+{
+  "id": "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad",
+  "name": "worker",
+  "description": "Hadoop workers",
+  "plugin_name": "vanilla",
+  "hadoop_version": "2.7.1",
+  "node_processes": ["datanode", "nodemanager"],
+  "flavor_id": "2",
+  "image_id": null,
+  "floating_ip_pool": "public",
+  "security_groups": null,
+  "auto_security_group": true,
+  "availability_zone": null,
+  "volumes_per_node": 1,
+  "volumes_size": 10,
+  "volume_type": null,
+  "volumes_availability_zone": null,
+  "volume_local_to_instance": false,
+  "volume_mount_prefix": "/volumes/disk",
+  "is_proxy_gateway": false,
+  "use_autoconfig": true,
+  "is_public": false,
+  "is_protected": false,
+  "is_default": false,
+  "node_configs": {
+    "HDFS": {
+      "DataNode Heap Size": 1024
+    }
+  },
+  "tenant_id": "808d5032ea0446889097723bfc8e919d",
+  "created_at": "2015-09-14T10:20:11",
+  "updated_at": null
+}
+`
+
+// GetResponse is a sample response to a Get call.
+var GetResponse = fmt.Sprintf(`{"node_group_template": %s}`, TemplateBody)
+
+// ListResponse is the first page of a List call.
+var ListResponse = fmt.Sprintf(`
+{
+  "node_group_templates": [%s],
+  "markers": {
+    "prev": null,
+    "next": "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad"
+  }
+}
+`, TemplateBody)
+
+// ListLastPageResponse is the last page of a List call.
+const ListLastPageResponse = `
+{
+  "node_group_templates": [],
+  "markers": {
+    "prev": "0bb9f1a4-0c44-4dc5-9452-6741c62ed9ad",
+    "next": null
+  }
+}
+`
+
+// CreateRequest is a sample request to create a node group template.
+const CreateRequest = `
+{
+  "name": "worker",
+  "description": "Hadoop workers",
+  "plugin_name": "vanilla",
+  "hadoop_version": "2.7.1",
+  "node_processes": ["datanode", "nodemanager"],
+  "flavor_id": "2",
+  "floating_ip_pool": "public",
+  "auto_security_group": true,
+  "volumes_per_node": 1,
+  "volumes_size": 10,
+  "node_configs": {
+    "HDFS": {
+      "DataNode Heap Size": 1024
+    }
+  }
+}
+`
+
+// UpdateRequest is a sample request to update a node group template.
+const UpdateRequest = `
+{
+  "description": "Hadoop workers",
+  "volumes_per_node": 1
+}
+`
+
+// FirstTemplate is the template described by TemplateBody.
+var FirstTemplate = nodegrouptemplates.NodeGroupTemplate{
+	ID:                TemplateID,
+	Name:              "worker",
+	Description:       "Hadoop workers",
+	PluginName:        "vanilla",
+	HadoopVersion:     "2.7.1",
+	NodeProcesses:     []string{"datanode", "nodemanager"},
+	FlavorID:          "2",
+	FloatingIPPool:    "public",
+	AutoSecurityGroup: true,
+	VolumesPerNode:    1,
+	VolumesSize:       10,
+	VolumeMountPrefix: "/volumes/disk",
+	UseAutoconfig:     true,
+	NodeConfigs: map[string]interface{}{
+		"HDFS": map[string]interface{}{
+			"DataNode Heap Size": float64(1024),
+		},
+	},
+	TenantID:  "808d5032ea0446889097723bfc8e919d",
+	CreatedAt: time.Date(2015, 9, 14, 10, 20, 11, 0, time.UTC),
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/node-group-templates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			th.CheckEquals(t, "vanilla", r.Form.Get("plugin_name"))
+			fmt.Fprint(w, ListResponse)
+		case TemplateID:
+			th.CheckEquals(t, "vanilla", r.Form.Get("plugin_name"))
+			fmt.Fprint(w, ListLastPageResponse)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/node-group-templates/"+TemplateID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/node-group-templates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/node-group-templates/"+TemplateID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/node-group-templates/"+TemplateID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,109 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dataprocessing/v1/nodegrouptemplates"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListNodeGroupTemplates(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := nodegrouptemplates.ListOpts{
+		PluginName: "vanilla",
+	}
+
+	count := 0
+	err := nodegrouptemplates.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := nodegrouptemplates.ExtractNodeGroupTemplates(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []nodegrouptemplates.NodeGroupTemplate{FirstTemplate}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetNodeGroupTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := nodegrouptemplates.Get(fake.ServiceClient(), TemplateID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTemplate, actual)
+}
+
+func TestCreateNodeGroupTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	autoSecurityGroup := true
+	createOpts := nodegrouptemplates.CreateOpts{
+		Name:              "worker",
+		Description:       "Hadoop workers",
+		PluginName:        "vanilla",
+		HadoopVersion:     "2.7.1",
+		NodeProcesses:     []string{"datanode", "nodemanager"},
+		FlavorID:          "2",
+		FloatingIPPool:    "public",
+		AutoSecurityGroup: &autoSecurityGroup,
+		VolumesPerNode:    1,
+		VolumesSize:       10,
+		NodeConfigs: map[string]interface{}{
+			"HDFS": map[string]interface{}{
+				"DataNode Heap Size": 1024,
+			},
+		},
+	}
+
+	actual, err := nodegrouptemplates.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTemplate, actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	createOpts := nodegrouptemplates.CreateOpts{
+		Name:          "worker",
+		PluginName:    "vanilla",
+		HadoopVersion: "2.7.1",
+	}
+	_, err := createOpts.ToNodeGroupTemplateCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdateNodeGroupTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	description := "Hadoop workers"
+	volumesPerNode := 1
+	updateOpts := nodegrouptemplates.UpdateOpts{
+		Description:    &description,
+		VolumesPerNode: &volumesPerNode,
+	}
+
+	actual, err := nodegrouptemplates.Update(fake.ServiceClient(), TemplateID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTemplate, actual)
+}
+
+func TestDeleteNodeGroupTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := nodegrouptemplates.Delete(fake.ServiceClient(), TemplateID)
+	th.AssertNoErr(t, res.Err)
+}
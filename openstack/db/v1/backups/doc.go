@@ -0,0 +1,7 @@
+// Package backups provides information and interaction with the backup API
+// resource in the OpenStack Database service.
+//
+// A backup is a snapshot of the data of a database instance. It can be used
+// to restore a new instance by passing its ID as the RestorePoint of
+// instances.CreateOpts.
+package backups
@@ -0,0 +1,84 @@
+package backups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// CreateOptsBuilder is the top-level interface for creating JSON maps.
+type CreateOptsBuilder interface {
+	ToBackupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is the struct responsible for configuring a new backup.
+type CreateOpts struct {
+	// Name of the backup. Required.
+	Name string `json:"name" required:"true"`
+	// The ID of the instance to back up. Required.
+	InstanceID string `json:"instance" required:"true"`
+	// A description of the backup. Optional.
+	Description string `json:"description,omitempty"`
+	// The ID of a previous backup of the instance to base an incremental
+	// backup on. Optional.
+	ParentID string `json:"parent_id,omitempty"`
+	// Whether to create an incremental backup based on the last full backup
+	// of the instance. Optional.
+	Incremental *bool `json:"incremental,omitempty"`
+}
+
+// ToBackupCreateMap will render a JSON map.
+func (opts CreateOpts) ToBackupCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "backup")
+	if err != nil {
+		return nil, err
+	}
+
+	// The Database service expects "incremental" to be 0 or 1.
+	if opts.Incremental != nil {
+		incremental := 0
+		if *opts.Incremental {
+			incremental = 1
+		}
+		b["backup"].(map[string]interface{})["incremental"] = incremental
+	}
+
+	return b, nil
+}
+
+// Create asynchronously creates a backup of a database instance.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBackupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(baseURL(client), &b, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{202}})
+	return
+}
+
+// List retrieves the status and information for all backups.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, baseURL(client), func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListForInstance retrieves the status and information for all backups of
+// a given database instance.
+func ListForInstance(client *gophercloud.ServiceClient, instanceID string) pagination.Pager {
+	return pagination.NewPager(client, instanceBackupsURL(client, instanceID), func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves the status and information for a specified backup.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// Delete permanently destroys the backup.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), nil)
+	return
+}
@@ -0,0 +1,139 @@
+package backups
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/datastores"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Backup represents a backup of a database instance.
+type Backup struct {
+	// Indicates the datetime that the backup was created
+	Created time.Time `json:"-"`
+
+	// Indicates the most recent datetime that the backup was updated.
+	Updated time.Time `json:"-"`
+
+	// The backup's unique identifier.
+	ID string
+
+	// The human-readable name of the backup.
+	Name string
+
+	// A description of the backup.
+	Description string
+
+	// The ID of the instance the backup was taken from.
+	InstanceID string `json:"instance_id"`
+
+	// The ID of the backup this incremental backup is based on.
+	ParentID string `json:"parent_id"`
+
+	// The location of the backup data in the object store.
+	LocationRef string `json:"locationRef"`
+
+	// The size of the backup in GB.
+	Size float64
+
+	// The build status of the backup.
+	Status string
+
+	// Indicates the datastore the backup was taken from.
+	Datastore datastores.DatastorePartial
+}
+
+func (r *Backup) UnmarshalJSON(b []byte) error {
+	type tmp Backup
+	var s struct {
+		tmp
+		Created gophercloud.JSONRFC3339NoZ `json:"created"`
+		Updated gophercloud.JSONRFC3339NoZ `json:"updated"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Backup(s.tmp)
+
+	r.Created = time.Time(s.Created)
+	r.Updated = time.Time(s.Updated)
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// CreateResult represents the result of a Create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract will extract a Backup from various result structs.
+func (r commonResult) Extract() (*Backup, error) {
+	var s struct {
+		Backup *Backup `json:"backup"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Backup, err
+}
+
+// BackupPage represents a single page of a paginated backup collection.
+type BackupPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty checks to see whether the collection is empty.
+func (page BackupPage) IsEmpty() (bool, error) {
+	backups, err := ExtractBackups(page)
+	return len(backups) == 0, err
+}
+
+// NextPageURL will retrieve the next page URL.
+func (page BackupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"backups_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (page BackupPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"backups_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
+// ExtractBackups will convert a generic pagination struct into a more
+// relevant slice of Backup structs.
+func ExtractBackups(r pagination.Page) ([]Backup, error) {
+	var s struct {
+		Backups []Backup `json:"backups"`
+	}
+	err := (r.(BackupPage)).ExtractInto(&s)
+	return s.Backups, err
+}
@@ -0,0 +1,2 @@
+// db_backups_v1
+package testing
@@ -0,0 +1,99 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/backups"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/datastores"
+	"github.com/gophercloud/gophercloud/testhelper/fixture"
+)
+
+var (
+	timestamp  = "2015-11-12T14:22:42"
+	timeVal, _ = time.Parse(gophercloud.RFC3339NoZ, timestamp)
+)
+
+var backup = `
+{
+  "created": "` + timestamp + `",
+  "datastore": {
+    "type": "mysql",
+    "version": "5.6",
+    "version_id": "b00000b0-00b0-0b00-00b0-000b000000bb"
+  },
+  "description": "My backup",
+  "id": "{backupID}",
+  "instance_id": "{instanceID}",
+  "locationRef": "http://localhost/path/to/backup",
+  "name": "snapshot",
+  "parent_id": null,
+  "size": 0.14,
+  "status": "NEW",
+  "updated": "` + timestamp + `"
+}
+`
+
+var createReq = `
+{
+	"backup": {
+		"description": "My backup",
+		"incremental": 0,
+		"instance": "{instanceID}",
+		"name": "snapshot"
+	}
+}
+`
+
+var (
+	backupID        = "{backupID}"
+	instanceID      = "{instanceID}"
+	rootURL         = "/backups"
+	resURL          = rootURL + "/" + backupID
+	instanceBackups = "/instances/" + instanceID + "/backups"
+)
+
+var (
+	createResp      = fmt.Sprintf(`{"backup": %s}`, backup)
+	listBackupsResp = fmt.Sprintf(`{"backups":[%s]}`, backup)
+	getBackupResp   = createResp
+)
+
+var expectedBackup = backups.Backup{
+	Created:     timeVal,
+	Updated:     timeVal,
+	ID:          backupID,
+	Name:        "snapshot",
+	Description: "My backup",
+	InstanceID:  instanceID,
+	LocationRef: "http://localhost/path/to/backup",
+	Size:        0.14,
+	Status:      "NEW",
+	Datastore: datastores.DatastorePartial{
+		Type:      "mysql",
+		Version:   "5.6",
+		VersionID: "b00000b0-00b0-0b00-00b0-000b000000bb",
+	},
+}
+
+func HandleCreate(t *testing.T) {
+	fixture.SetupHandler(t, rootURL, "POST", createReq, createResp, 202)
+}
+
+func HandleList(t *testing.T) {
+	fixture.SetupHandler(t, rootURL, "GET", "", listBackupsResp, 200)
+}
+
+func HandleListForInstance(t *testing.T) {
+	fixture.SetupHandler(t, instanceBackups, "GET", "", listBackupsResp, 200)
+}
+
+func HandleGet(t *testing.T) {
+	fixture.SetupHandler(t, resURL, "GET", "", getBackupResp, 200)
+}
+
+func HandleDelete(t *testing.T) {
+	fixture.SetupHandler(t, resURL, "DELETE", "", "", 202)
+}
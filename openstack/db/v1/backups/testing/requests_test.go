@@ -0,0 +1,91 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/db/v1/backups"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreate(t)
+
+	incremental := false
+	opts := backups.CreateOpts{
+		Name:        "snapshot",
+		InstanceID:  instanceID,
+		Description: "My backup",
+		Incremental: &incremental,
+	}
+
+	backup, err := backups.Create(fake.ServiceClient(), opts).Extract()
+
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &expectedBackup, backup)
+}
+
+func TestCreateRequiresInstance(t *testing.T) {
+	res := backups.Create(fake.ServiceClient(), backups.CreateOpts{Name: "snapshot"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleList(t)
+
+	pages := 0
+	err := backups.List(fake.ServiceClient()).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := backups.ExtractBackups(page)
+		if err != nil {
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, []backups.Backup{expectedBackup}, actual)
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestListForInstance(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListForInstance(t)
+
+	allPages, err := backups.ListForInstance(fake.ServiceClient(), instanceID).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := backups.ExtractBackups(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []backups.Backup{expectedBackup}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGet(t)
+
+	backup, err := backups.Get(fake.ServiceClient(), backupID).Extract()
+
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &expectedBackup, backup)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDelete(t)
+
+	res := backups.Delete(fake.ServiceClient(), backupID)
+	th.AssertNoErr(t, res.Err)
+}
@@ -65,6 +65,8 @@ type CreateOpts struct {
 	Datastore *DatastoreOpts
 	// Networks dictates how this server will be attached to available networks.
 	Networks []NetworkOpts
+	// The ID of a backup to restore the new instance from. Optional.
+	RestorePoint string
 }
 
 // ToInstanceCreateMap will render a JSON map.
@@ -111,6 +113,10 @@ func (opts CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
 		instance["datastore"] = datastore
 	}
 
+	if opts.RestorePoint != "" {
+		instance["restorePoint"] = map[string]string{"backupRef": opts.RestorePoint}
+	}
+
 	if len(opts.Networks) > 0 {
 		networks := make([]map[string]interface{}, len(opts.Networks))
 		for i, net := range opts.Networks {
@@ -86,6 +86,21 @@ var createReq = `
 }
 `
 
+var createFromBackupReq = `
+{
+	"instance": {
+		"flavorRef": "1",
+		"name": "json_rack_instance",
+		"restorePoint": {
+			"backupRef": "a9832168-7541-4536-b8d9-a8a9b79cf1b4"
+		},
+		"volume": {
+			"size": 2
+		}
+	}
+}
+`
+
 var instanceWithFault = `
 {
   "created": "` + timestamp + `",
@@ -215,6 +230,10 @@ func HandleCreateWithFault(t *testing.T) {
 	fixture.SetupHandler(t, rootURL, "POST", createReq, createWithFaultResp, 200)
 }
 
+func HandleCreateFromBackup(t *testing.T) {
+	fixture.SetupHandler(t, rootURL, "POST", createFromBackupReq, createResp, 200)
+}
+
 func HandleList(t *testing.T) {
 	fixture.SetupHandler(t, rootURL, "GET", "", listInstancesResp, 200)
 }
@@ -41,6 +41,24 @@ func TestCreate(t *testing.T) {
 	th.AssertDeepEquals(t, &expectedInstance, instance)
 }
 
+func TestCreateFromBackup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateFromBackup(t)
+
+	opts := instances.CreateOpts{
+		Name:         "json_rack_instance",
+		FlavorRef:    "1",
+		Size:         2,
+		RestorePoint: "a9832168-7541-4536-b8d9-a8a9b79cf1b4",
+	}
+
+	instance, err := instances.Create(fake.ServiceClient(), opts).Extract()
+
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &expectedInstance, instance)
+}
+
 func TestCreateWithFault(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -0,0 +1,47 @@
+/*
+Package floatingips provides information and interaction with the reverse DNS
+(PTR) records of floating IPs for the OpenStack DNS service.
+
+Example to List PTR Records of Floating IPs
+
+	allPages, err := floatingips.List(dnsClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allFloatingIPs, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, fip := range allFloatingIPs {
+		fmt.Printf("%+v\n", fip)
+	}
+
+Example to Set the PTR Record of a Floating IP
+
+	region := "RegionOne"
+	floatingIPID := "c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a"
+
+	setOpts := floatingips.SetOpts{
+		PTRDName:    "smtp.example.com.",
+		Description: "This is a floating ip for 192.0.2.10",
+		TTL:         600,
+	}
+
+	fip, err := floatingips.Set(dnsClient, region, floatingIPID, setOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Unset the PTR Record of a Floating IP
+
+	region := "RegionOne"
+	floatingIPID := "c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a"
+
+	err := floatingips.Unset(dnsClient, region, floatingIPID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package floatingips
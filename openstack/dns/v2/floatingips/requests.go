@@ -0,0 +1,65 @@
+package floatingips
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List implements a floating IP PTR record List request.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, baseURL(client), func(r pagination.PageResult) pagination.Page {
+		return FloatingIPPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns the PTR record of a floating IP, given the region it lives in
+// and its ID.
+func Get(client *gophercloud.ServiceClient, region, floatingIPID string) (r GetResult) {
+	_, r.Err = client.Get(floatingIPURL(client, region, floatingIPID), &r.Body, nil)
+	return
+}
+
+// SetOptsBuilder allows extensions to add additional attributes to the
+// Set request.
+type SetOptsBuilder interface {
+	ToFloatingIPSetMap() (map[string]interface{}, error)
+}
+
+// SetOpts specifies the attributes of the PTR record of a floating IP.
+type SetOpts struct {
+	// PTRDName is the domain name the floating IP resolves to.
+	PTRDName string `json:"ptrdname" required:"true"`
+
+	// Description of the PTR record.
+	Description string `json:"description,omitempty"`
+
+	// TTL is the time to live of the PTR record.
+	TTL int `json:"ttl,omitempty"`
+}
+
+// ToFloatingIPSetMap formats a SetOpts structure into a request body.
+func (opts SetOpts) ToFloatingIPSetMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Set creates or updates the PTR record of a floating IP.
+func Set(client *gophercloud.ServiceClient, region, floatingIPID string, opts SetOptsBuilder) (r SetResult) {
+	b, err := opts.ToFloatingIPSetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(floatingIPURL(client, region, floatingIPID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// Unset removes the PTR record of a floating IP.
+func Unset(client *gophercloud.ServiceClient, region, floatingIPID string) (r UnsetResult) {
+	b := map[string]interface{}{"ptrdname": nil}
+	_, r.Err = client.Patch(floatingIPURL(client, region, floatingIPID), &b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
@@ -0,0 +1,83 @@
+package floatingips
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or SetResult as a FloatingIP.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*FloatingIP, error) {
+	var s *FloatingIP
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a FloatingIP.
+type GetResult struct {
+	commonResult
+}
+
+// SetResult is the result of a Set request. Call its Extract method
+// to interpret the result as a FloatingIP.
+type SetResult struct {
+	commonResult
+}
+
+// UnsetResult is the result of an Unset request. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type UnsetResult struct {
+	gophercloud.ErrResult
+}
+
+// FloatingIPPage is a single page of FloatingIP results.
+type FloatingIPPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r FloatingIPPage) IsEmpty() (bool, error) {
+	s, err := ExtractFloatingIPs(r)
+	return len(s) == 0, err
+}
+
+// ExtractFloatingIPs extracts a slice of FloatingIPs from a List result.
+func ExtractFloatingIPs(r pagination.Page) ([]FloatingIP, error) {
+	var s struct {
+		FloatingIPs []FloatingIP `json:"floatingips"`
+	}
+	err := (r.(FloatingIPPage)).ExtractInto(&s)
+	return s.FloatingIPs, err
+}
+
+// FloatingIP represents the PTR record of a floating IP.
+type FloatingIP struct {
+	// ID is the region and ID of the floating IP, separated by a colon.
+	ID string `json:"id"`
+
+	// PTRDName is the domain name the floating IP resolves to.
+	PTRDName string `json:"ptrdname"`
+
+	// Description of the PTR record.
+	Description string `json:"description"`
+
+	// TTL is the time to live of the PTR record.
+	TTL int `json:"ttl"`
+
+	// Address is the floating IP address.
+	Address string `json:"address"`
+
+	// Status is the status of the resource.
+	Status string `json:"status"`
+
+	// Action is the current action in progress on the resource.
+	Action string `json:"action"`
+
+	// Links includes HTTP references to the itself.
+	Links map[string]interface{} `json:"links"`
+}
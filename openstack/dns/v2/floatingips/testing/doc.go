@@ -0,0 +1,2 @@
+// floatingips unit tests
+package testing
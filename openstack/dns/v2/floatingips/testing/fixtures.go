@@ -0,0 +1,123 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/floatingips"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "floatingips": [
+        {
+            "id": "RegionOne:c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a",
+            "ptrdname": "smtp.example.com.",
+            "description": "This is a floating ip for 192.0.2.10",
+            "ttl": 600,
+            "address": "192.0.2.10",
+            "status": "ACTIVE",
+            "action": "CREATE",
+            "links": {
+              "self": "https://127.0.0.1:9001/v2/reverse/floatingips/RegionOne:c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a"
+            }
+        }
+    ],
+    "links": {
+      "self": "https://127.0.0.1:9001/v2/reverse/floatingips"
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "RegionOne:c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a",
+    "ptrdname": "smtp.example.com.",
+    "description": "This is a floating ip for 192.0.2.10",
+    "ttl": 600,
+    "address": "192.0.2.10",
+    "status": "ACTIVE",
+    "action": "CREATE",
+    "links": {
+      "self": "https://127.0.0.1:9001/v2/reverse/floatingips/RegionOne:c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a"
+    }
+}
+`
+
+// FirstFloatingIP is the first result in ListOutput.
+var FirstFloatingIP = floatingips.FloatingIP{
+	ID:          "RegionOne:c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a",
+	PTRDName:    "smtp.example.com.",
+	Description: "This is a floating ip for 192.0.2.10",
+	TTL:         600,
+	Address:     "192.0.2.10",
+	Status:      "ACTIVE",
+	Action:      "CREATE",
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/reverse/floatingips/RegionOne:c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a",
+	},
+}
+
+// ExpectedFloatingIPsSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedFloatingIPsSlice = []floatingips.FloatingIP{FirstFloatingIP}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/reverse/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/reverse/floatingips/RegionOne:c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// SetRequest is a sample request to set the PTR record of a floating IP.
+const SetRequest = `
+{
+    "ptrdname": "smtp.example.com.",
+    "description": "This is a floating ip for 192.0.2.10",
+    "ttl": 600
+}
+`
+
+// HandleSetSuccessfully configures the test server to respond to a Set request.
+func HandleSetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/reverse/floatingips/RegionOne:c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, SetRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUnsetSuccessfully configures the test server to respond to an Unset request.
+func HandleUnsetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/reverse/floatingips/RegionOne:c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"ptrdname": null}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
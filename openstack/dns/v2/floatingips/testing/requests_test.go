@@ -0,0 +1,63 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/floatingips"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := floatingips.List(client.ServiceClient()).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := floatingips.ExtractFloatingIPs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedFloatingIPsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := floatingips.Get(client.ServiceClient(), "RegionOne", "c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstFloatingIP, actual)
+}
+
+func TestSet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSetSuccessfully(t)
+
+	setOpts := floatingips.SetOpts{
+		PTRDName:    "smtp.example.com.",
+		Description: "This is a floating ip for 192.0.2.10",
+		TTL:         600,
+	}
+
+	actual, err := floatingips.Set(client.ServiceClient(), "RegionOne", "c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a", setOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstFloatingIP, actual)
+}
+
+func TestUnset(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUnsetSuccessfully(t)
+
+	err := floatingips.Unset(client.ServiceClient(), "RegionOne", "c5c8c6ee-3f45-4b5f-9c4e-1f2e3d4c5b6a").ExtractErr()
+	th.AssertNoErr(t, err)
+}
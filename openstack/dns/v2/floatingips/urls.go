@@ -0,0 +1,11 @@
+package floatingips
+
+import "github.com/gophercloud/gophercloud"
+
+func baseURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("reverse", "floatingips")
+}
+
+func floatingIPURL(c *gophercloud.ServiceClient, region, floatingIPID string) string {
+	return c.ServiceURL("reverse", "floatingips", region+":"+floatingIPID)
+}
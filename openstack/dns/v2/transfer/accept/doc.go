@@ -0,0 +1,33 @@
+/*
+Package accept enables accepting zone transfer requests for the OpenStack DNS
+service.
+
+Example to List Zone Transfer Accepts
+
+	allPages, err := accept.List(dnsClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTransferAccepts, err := accept.ExtractTransferAccepts(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, transferAccept := range allTransferAccepts {
+		fmt.Printf("%+v\n", transferAccept)
+	}
+
+Example to Accept a Zone Transfer Request
+
+	createOpts := accept.CreateOpts{
+		Key:                   "KJ3K2JY7HKJ3HHLFG",
+		ZoneTransferRequestID: "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+	}
+
+	transferAccept, err := accept.Create(dnsClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package accept
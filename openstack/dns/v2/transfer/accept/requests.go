@@ -0,0 +1,81 @@
+package accept
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToTransferAcceptListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the transfer accept attributes you want to see returned.
+// https://developer.openstack.org/api-ref/dns/
+type ListOpts struct {
+	Status string `q:"status"`
+}
+
+// ToTransferAcceptListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTransferAcceptListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List implements a transfer accept List request.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToTransferAcceptListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TransferAcceptPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns information about a transfer accept, given its ID.
+func Get(client *gophercloud.ServiceClient, transferAcceptID string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, transferAcceptID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional attributes to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToTransferAcceptCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes used to accept a zone transfer request.
+type CreateOpts struct {
+	// Key is the secret of the transfer request.
+	Key string `json:"key" required:"true"`
+
+	// ZoneTransferRequestID is the ID of the transfer request to accept.
+	ZoneTransferRequestID string `json:"zone_transfer_request_id" required:"true"`
+}
+
+// ToTransferAcceptCreateMap formats an CreateOpts structure into a request
+// body.
+func (opts CreateOpts) ToTransferAcceptCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create accepts a zone transfer request, moving the zone to the current
+// project.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTransferAcceptCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(baseURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201, 202},
+	})
+	return
+}
@@ -0,0 +1,106 @@
+package accept
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or CreateResult as a TransferAccept.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*TransferAccept, error) {
+	var s *TransferAccept
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// CreateResult is the result of a Create request. Call its Extract method
+// to interpret the result as a TransferAccept.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a TransferAccept.
+type GetResult struct {
+	commonResult
+}
+
+// TransferAcceptPage is a single page of TransferAccept results.
+type TransferAcceptPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r TransferAcceptPage) IsEmpty() (bool, error) {
+	s, err := ExtractTransferAccepts(r)
+	return len(s) == 0, err
+}
+
+// ExtractTransferAccepts extracts a slice of TransferAccepts from a List
+// result.
+func ExtractTransferAccepts(r pagination.Page) ([]TransferAccept, error) {
+	var s struct {
+		TransferAccepts []TransferAccept `json:"transfer_accepts"`
+	}
+	err := (r.(TransferAcceptPage)).ExtractInto(&s)
+	return s.TransferAccepts, err
+}
+
+// TransferAccept represents the acceptance of a zone transfer request.
+type TransferAccept struct {
+	// ID uniquely identifies this transfer accept amongst all other transfer
+	// accepts, including those not accessible to the current tenant.
+	ID string `json:"id"`
+
+	// ZoneID is the ID of the zone being transferred.
+	ZoneID string `json:"zone_id"`
+
+	// ProjectID identifies the project/tenant owning this resource.
+	ProjectID string `json:"project_id"`
+
+	// ZoneTransferRequestID is the ID of the accepted transfer request.
+	ZoneTransferRequestID string `json:"zone_transfer_request_id"`
+
+	// Key is the secret of the accepted transfer request.
+	Key string `json:"key"`
+
+	// Status is the status of the resource.
+	Status string `json:"status"`
+
+	// CreatedAt is the date when the transfer accept was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date when the last change was made to the transfer
+	// accept.
+	UpdatedAt time.Time `json:"-"`
+
+	// Links includes HTTP references to the itself, useful for passing along
+	// to other APIs that might want a transfer accept reference.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (r *TransferAccept) UnmarshalJSON(b []byte) error {
+	type tmp TransferAccept
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = TransferAccept(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
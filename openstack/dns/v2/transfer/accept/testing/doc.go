@@ -0,0 +1,2 @@
+// transfer accepts unit tests
+package testing
@@ -0,0 +1,121 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/transfer/accept"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "links": {
+      "self": "http://example.com:9001/v2/zones/tasks/transfer_accepts"
+    },
+    "transfer_accepts": [
+        {
+            "id": "92236f39-0fad-4f8f-bf25-fbdf027de34d",
+            "zone_id": "a6a8515c-5d80-48c0-955b-fde631b59791",
+            "project_id": "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+            "zone_transfer_request_id": "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+            "key": "KJ3K2JY7HKJ3HHLFG",
+            "status": "COMPLETE",
+            "created_at": "2020-10-12T12:25:18.000000",
+            "updated_at": "2020-10-12T12:25:20.000000",
+            "links": {
+              "self": "https://127.0.0.1:9001/v2/zones/tasks/transfer_accepts/92236f39-0fad-4f8f-bf25-fbdf027de34d",
+              "zone": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791"
+            }
+        }
+    ]
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "92236f39-0fad-4f8f-bf25-fbdf027de34d",
+    "zone_id": "a6a8515c-5d80-48c0-955b-fde631b59791",
+    "project_id": "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+    "zone_transfer_request_id": "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+    "key": "KJ3K2JY7HKJ3HHLFG",
+    "status": "COMPLETE",
+    "created_at": "2020-10-12T12:25:18.000000",
+    "updated_at": "2020-10-12T12:25:20.000000",
+    "links": {
+      "self": "https://127.0.0.1:9001/v2/zones/tasks/transfer_accepts/92236f39-0fad-4f8f-bf25-fbdf027de34d",
+      "zone": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791"
+    }
+}
+`
+
+// FirstTransferAccept is the first result in ListOutput
+var FirstTransferAcceptCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2020-10-12T12:25:18.000000")
+var FirstTransferAcceptUpdatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2020-10-12T12:25:20.000000")
+var FirstTransferAccept = accept.TransferAccept{
+	ID:                    "92236f39-0fad-4f8f-bf25-fbdf027de34d",
+	ZoneID:                "a6a8515c-5d80-48c0-955b-fde631b59791",
+	ProjectID:             "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+	ZoneTransferRequestID: "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+	Key:                   "KJ3K2JY7HKJ3HHLFG",
+	Status:                "COMPLETE",
+	CreatedAt:             FirstTransferAcceptCreatedAt,
+	UpdatedAt:             FirstTransferAcceptUpdatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/zones/tasks/transfer_accepts/92236f39-0fad-4f8f-bf25-fbdf027de34d",
+		"zone": "https://127.0.0.1:9001/v2/zones/a6a8515c-5d80-48c0-955b-fde631b59791",
+	},
+}
+
+// ExpectedTransferAcceptsSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedTransferAcceptsSlice = []accept.TransferAccept{FirstTransferAccept}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/transfer_accepts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/transfer_accepts/92236f39-0fad-4f8f-bf25-fbdf027de34d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// CreateTransferAccept is a sample request to accept a transfer request.
+const CreateTransferAccept = `
+{
+    "key": "KJ3K2JY7HKJ3HHLFG",
+    "zone_transfer_request_id": "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3"
+}
+`
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/transfer_accepts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateTransferAccept)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
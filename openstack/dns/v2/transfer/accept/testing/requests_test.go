@@ -0,0 +1,62 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/transfer/accept"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := accept.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := accept.ExtractTransferAccepts(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedTransferAcceptsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := accept.Get(client.ServiceClient(), "92236f39-0fad-4f8f-bf25-fbdf027de34d").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTransferAccept, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := accept.CreateOpts{
+		Key:                   "KJ3K2JY7HKJ3HHLFG",
+		ZoneTransferRequestID: "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+	}
+
+	actual, err := accept.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTransferAccept, actual)
+}
+
+func TestCreateRequiresKey(t *testing.T) {
+	res := accept.Create(client.ServiceClient(), accept.CreateOpts{
+		ZoneTransferRequestID: "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
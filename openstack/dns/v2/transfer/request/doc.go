@@ -0,0 +1,48 @@
+/*
+Package request enables management of zone transfer requests for the OpenStack
+DNS service.
+
+A transfer request offers the ownership of a zone to another project. The
+receiving project accepts it with the transfer/accept package, using the
+request ID and its key.
+
+Example to List Zone Transfer Requests
+
+	allPages, err := request.List(dnsClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTransferRequests, err := request.ExtractTransferRequests(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, transferRequest := range allTransferRequests {
+		fmt.Printf("%+v\n", transferRequest)
+	}
+
+Example to Create a Zone Transfer Request
+
+	zoneID := "99d10f68-5623-4491-91a0-6daafa32b60e"
+	createOpts := request.CreateOpts{
+		TargetProjectID: "f8b8ee8ab9e34ac1a22bd3f6b9e8d0ae",
+		Description:     "This is a zone transfer request.",
+	}
+
+	transferRequest, err := request.Create(dnsClient, zoneID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(transferRequest.ID, transferRequest.Key)
+
+Example to Delete a Zone Transfer Request
+
+	transferRequestID := "99d10f68-5623-4491-91a0-6daafa32b60e"
+	err := request.Delete(dnsClient, transferRequestID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package request
@@ -0,0 +1,123 @@
+package request
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToTransferRequestListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the transfer request attributes you want to see returned.
+// https://developer.openstack.org/api-ref/dns/
+type ListOpts struct {
+	Status string `q:"status"`
+}
+
+// ToTransferRequestListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTransferRequestListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List implements a transfer request List request.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToTransferRequestListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TransferRequestPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns information about a transfer request, given its ID.
+func Get(client *gophercloud.ServiceClient, transferRequestID string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, transferRequestID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional attributes to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToTransferRequestCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes used to create a transfer request.
+type CreateOpts struct {
+	// TargetProjectID is the ID of the project the zone is transferred to.
+	// If it is omitted, any project that knows the key can accept the transfer.
+	TargetProjectID string `json:"target_project_id,omitempty"`
+
+	// Description of the transfer request.
+	Description string `json:"description,omitempty"`
+}
+
+// ToTransferRequestCreateMap formats an CreateOpts structure into a request
+// body.
+func (opts CreateOpts) ToTransferRequestCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create implements a transfer request create request for the given zone.
+func Create(client *gophercloud.ServiceClient, zoneID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTransferRequestCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, zoneID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201, 202},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToTransferRequestUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes to update a transfer request.
+type UpdateOpts struct {
+	// TargetProjectID is the ID of the project the zone is transferred to.
+	TargetProjectID string `json:"target_project_id,omitempty"`
+
+	// Description of the transfer request.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToTransferRequestUpdateMap formats an UpdateOpts structure into a request
+// body.
+func (opts UpdateOpts) ToTransferRequestUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update implements a transfer request update request.
+func Update(client *gophercloud.ServiceClient, transferRequestID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTransferRequestUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(resourceURL(client, transferRequestID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// Delete implements a transfer request delete request.
+func Delete(client *gophercloud.ServiceClient, transferRequestID string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, transferRequestID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
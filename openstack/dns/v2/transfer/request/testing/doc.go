@@ -0,0 +1,2 @@
+// transfer requests unit tests
+package testing
@@ -0,0 +1,204 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/transfer/request"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "links": {
+      "self": "http://example.com:9001/v2/zones/tasks/transfer_requests"
+    },
+    "transfer_requests": [
+        {
+            "id": "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+            "zone_id": "a6a8515c-5d80-48c0-955b-fde631b59791",
+            "zone_name": "example.org.",
+            "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+            "target_project_id": "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+            "key": "KJ3K2JY7HKJ3HHLFG",
+            "description": "This is a first example zone transfer request.",
+            "status": "ACTIVE",
+            "created_at": "2020-10-12T12:15:18.000000",
+            "updated_at": null,
+            "links": {
+              "self": "https://127.0.0.1:9001/v2/zones/tasks/transfer_requests/a86dba58-0043-4cc6-a1bb-69d5e86f3ca3"
+            }
+        },
+        {
+            "id": "34c4561c-9205-4386-9df5-167436f5a222",
+            "zone_id": "572ba08c-d929-4c70-8e42-03824bb24ca2",
+            "zone_name": "example.com.",
+            "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+            "target_project_id": "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+            "key": "AD63K2JY7HKJ3HH7F",
+            "description": "This is second example zone transfer request.",
+            "status": "ACTIVE",
+            "created_at": "2020-10-12T12:15:18.000000",
+            "updated_at": "2020-10-12T12:20:11.000000",
+            "links": {
+              "self": "https://127.0.0.1:9001/v2/zones/tasks/transfer_requests/34c4561c-9205-4386-9df5-167436f5a222"
+            }
+        }
+    ]
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "id": "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+    "zone_id": "a6a8515c-5d80-48c0-955b-fde631b59791",
+    "zone_name": "example.org.",
+    "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+    "target_project_id": "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+    "key": "KJ3K2JY7HKJ3HHLFG",
+    "description": "This is a first example zone transfer request.",
+    "status": "ACTIVE",
+    "created_at": "2020-10-12T12:15:18.000000",
+    "updated_at": null,
+    "links": {
+      "self": "https://127.0.0.1:9001/v2/zones/tasks/transfer_requests/a86dba58-0043-4cc6-a1bb-69d5e86f3ca3"
+    }
+}
+`
+
+// FirstTransferRequest is the first result in ListOutput
+var FirstTransferRequestCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2020-10-12T12:15:18.000000")
+var FirstTransferRequest = request.TransferRequest{
+	ID:              "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+	ZoneID:          "a6a8515c-5d80-48c0-955b-fde631b59791",
+	ZoneName:        "example.org.",
+	ProjectID:       "4335d1f0-f793-11e2-b778-0800200c9a66",
+	TargetProjectID: "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+	Key:             "KJ3K2JY7HKJ3HHLFG",
+	Description:     "This is a first example zone transfer request.",
+	Status:          "ACTIVE",
+	CreatedAt:       FirstTransferRequestCreatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/zones/tasks/transfer_requests/a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+	},
+}
+
+var SecondTransferRequestCreatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2020-10-12T12:15:18.000000")
+var SecondTransferRequestUpdatedAt, _ = time.Parse(gophercloud.RFC3339MilliNoZ, "2020-10-12T12:20:11.000000")
+var SecondTransferRequest = request.TransferRequest{
+	ID:              "34c4561c-9205-4386-9df5-167436f5a222",
+	ZoneID:          "572ba08c-d929-4c70-8e42-03824bb24ca2",
+	ZoneName:        "example.com.",
+	ProjectID:       "4335d1f0-f793-11e2-b778-0800200c9a66",
+	TargetProjectID: "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+	Key:             "AD63K2JY7HKJ3HH7F",
+	Description:     "This is second example zone transfer request.",
+	Status:          "ACTIVE",
+	CreatedAt:       SecondTransferRequestCreatedAt,
+	UpdatedAt:       SecondTransferRequestUpdatedAt,
+	Links: map[string]interface{}{
+		"self": "https://127.0.0.1:9001/v2/zones/tasks/transfer_requests/34c4561c-9205-4386-9df5-167436f5a222",
+	},
+}
+
+// ExpectedTransferRequestsSlice is the slice of results that should be parsed
+// from ListOutput, in the expected order.
+var ExpectedTransferRequestsSlice = []request.TransferRequest{FirstTransferRequest, SecondTransferRequest}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/transfer_requests", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/transfer_requests/a86dba58-0043-4cc6-a1bb-69d5e86f3ca3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// CreateTransferRequest is a sample request to create a transfer request.
+const CreateTransferRequest = `
+{
+    "target_project_id": "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+    "description": "This is a first example zone transfer request."
+}
+`
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/a6a8515c-5d80-48c0-955b-fde631b59791/tasks/transfer_requests", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateTransferRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// UpdateTransferRequest is a sample request to update a transfer request.
+const UpdateTransferRequest = `
+{
+    "description": "Updated Description"
+}
+`
+
+// UpdateOutput is a sample response to an Update call.
+const UpdateOutput = `
+{
+    "id": "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+    "zone_id": "a6a8515c-5d80-48c0-955b-fde631b59791",
+    "zone_name": "example.org.",
+    "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+    "target_project_id": "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+    "key": "KJ3K2JY7HKJ3HHLFG",
+    "description": "Updated Description",
+    "status": "ACTIVE",
+    "created_at": "2020-10-12T12:15:18.000000",
+    "updated_at": null,
+    "links": {
+      "self": "https://127.0.0.1:9001/v2/zones/tasks/transfer_requests/a86dba58-0043-4cc6-a1bb-69d5e86f3ca3"
+    }
+}
+`
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/transfer_requests/a86dba58-0043-4cc6-a1bb-69d5e86f3ca3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateTransferRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/tasks/transfer_requests/a86dba58-0043-4cc6-a1bb-69d5e86f3ca3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
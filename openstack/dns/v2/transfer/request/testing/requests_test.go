@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/transfer/request"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := request.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := request.ExtractTransferRequests(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedTransferRequestsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := request.Get(client.ServiceClient(), "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTransferRequest, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := request.CreateOpts{
+		TargetProjectID: "05d98711-b3e1-4264-a395-f4d1a9b0b7e6",
+		Description:     "This is a first example zone transfer request.",
+	}
+
+	actual, err := request.Create(client.ServiceClient(), "a6a8515c-5d80-48c0-955b-fde631b59791", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstTransferRequest, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	var description = "Updated Description"
+	updateOpts := request.UpdateOpts{
+		Description: &description,
+	}
+
+	updatedTransferRequest := FirstTransferRequest
+	updatedTransferRequest.Description = "Updated Description"
+
+	actual, err := request.Update(client.ServiceClient(), "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &updatedTransferRequest, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := request.Delete(client.ServiceClient(), "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3").ExtractErr()
+	th.AssertNoErr(t, err)
+}
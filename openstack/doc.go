@@ -10,5 +10,14 @@ Example of Creating a Service Client
 	client, err := openstack.NewNetworkV2(client, gophercloud.EndpointOpts{
 		Region: os.Getenv("OS_REGION_NAME"),
 	})
+
+Example of Managing Several Clouds
+
+	manager := openstack.NewManager(map[string]openstack.Cloud{
+		"primary":   {AuthOptions: primaryOpts, Region: "RegionOne"},
+		"secondary": {AuthOptions: secondaryOpts, Region: "RegionTwo"},
+	})
+
+	client, err := manager.ServiceClient("secondary", "", openstack.NewComputeV2)
 */
 package openstack
@@ -88,8 +88,9 @@ func Create(client *gophercloud.ServiceClient, auth AuthOptionsBuilder) (r Creat
 		return
 	}
 	_, r.Err = client.Post(CreateURL(client), b, &r.Body, &gophercloud.RequestOpts{
-		OkCodes:     []int{200, 203},
-		MoreHeaders: map[string]string{"X-Auth-Token": ""},
+		OkCodes:      []int{200, 203},
+		MoreHeaders:  map[string]string{"X-Auth-Token": ""},
+		IgnoreDryRun: true,
 	})
 	return
 }
@@ -47,6 +47,26 @@ Example to Create a Trust
 
     fmt.Printf("Trust: %+v\n", trust)
 
+Example to List Trusts of a Trustor
+
+    listOpts := trusts.ListOpts{
+        TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+    }
+
+    allPages, err := trusts.List(identityClient, listOpts).AllPages()
+    if err != nil {
+        panic(err)
+    }
+
+    allTrusts, err := trusts.ExtractTrusts(allPages)
+    if err != nil {
+        panic(err)
+    }
+
+    for _, trust := range allTrusts {
+        fmt.Printf("%+v\n", trust)
+    }
+
 Example to Delete a Trust
 
     trustID := "3422b7c113894f5d90665e1a79655e23"
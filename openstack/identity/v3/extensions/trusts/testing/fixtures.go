@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/trusts"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	"github.com/gophercloud/gophercloud/testhelper"
 	"github.com/gophercloud/gophercloud/testhelper/client"
@@ -133,3 +135,92 @@ func HandleDeleteTrust(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+const ListResponse = `
+{
+    "links": {
+        "self": "http://example.com/identity/v3/OS-TRUST/trusts",
+        "previous": null,
+        "next": null
+    },
+    "trusts": [
+        {
+            "id": "3422b7c113894f5d90665e1a79655e23",
+            "impersonation": false,
+            "allow_redelegation": true,
+            "expires_at": "2019-12-01T14:00:00.999999Z",
+            "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+            "redelegation_count": 10,
+            "remaining_uses": null,
+            "trustee_user_id": "ecb37e88cc86431c99d0332208cb6fbf",
+            "trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3",
+            "links": {
+                "self": "http://example.com/identity/v3/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23"
+            }
+        },
+        {
+            "id": "f30e7f3c0c1c4c0a8c7b8b9e5f1d2a3b",
+            "impersonation": true,
+            "allow_redelegation": false,
+            "expires_at": null,
+            "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+            "redelegation_count": 0,
+            "remaining_uses": 3,
+            "trustee_user_id": "ecb37e88cc86431c99d0332208cb6fbf",
+            "trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3",
+            "links": {
+                "self": "http://example.com/identity/v3/OS-TRUST/trusts/f30e7f3c0c1c4c0a8c7b8b9e5f1d2a3b"
+            }
+        }
+    ]
+}
+`
+
+var FirstTrust = trusts.Trust{
+	ID:                "3422b7c113894f5d90665e1a79655e23",
+	AllowRedelegation: true,
+	ExpiresAt:         time.Date(2019, 12, 1, 14, 0, 0, 999999000, time.UTC),
+	ProjectID:         "9b71012f5a4a4aef9193f1995fe159b2",
+	RedelegationCount: 10,
+	TrusteeUserID:     "ecb37e88cc86431c99d0332208cb6fbf",
+	TrustorUserID:     "959ed913a32c4ec88c041c98e61cbbc3",
+}
+
+var SecondTrust = trusts.Trust{
+	ID:            "f30e7f3c0c1c4c0a8c7b8b9e5f1d2a3b",
+	Impersonation: true,
+	ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+	RemainingUses: 3,
+	TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+	TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+}
+
+// HandleListTrustsSuccessfully creates an HTTP handler at `/OS-TRUST/trusts`
+// on the test handler mux that responds with a list of two trusts.
+func HandleListTrustsSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/OS-TRUST/trusts", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		testhelper.TestFormValues(t, r, map[string]string{
+			"trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetTrustSuccessfully creates an HTTP handler at
+// `/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23` on the test handler
+// mux that responds with a single trust.
+func HandleGetTrustSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/trusts"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
 	"github.com/gophercloud/gophercloud/testhelper/client"
 )
@@ -106,3 +107,36 @@ func TestDeleteTrust(t *testing.T) {
 	res := trusts.Delete(client.ServiceClient(), "3422b7c113894f5d90665e1a79655e23")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestListTrusts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListTrustsSuccessfully(t)
+
+	count := 0
+	err := trusts.List(client.ServiceClient(), trusts.ListOpts{
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := trusts.ExtractTrusts(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []trusts.Trust{FirstTrust, SecondTrust}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTrustSuccessfully(t)
+
+	actual, err := trusts.Get(client.ServiceClient(), "3422b7c113894f5d90665e1a79655e23").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "3422b7c113894f5d90665e1a79655e23", actual.ID)
+	th.AssertEquals(t, "959ed913a32c4ec88c041c98e61cbbc3", actual.TrustorUserID)
+	th.AssertEquals(t, "member", actual.Roles[0].Name)
+}
@@ -118,7 +118,8 @@ func Create(c *gophercloud.ServiceClient, opts AuthOptionsBuilder) (r CreateResu
 	}
 
 	resp, err := c.Post(tokenURL(c), b, &r.Body, &gophercloud.RequestOpts{
-		MoreHeaders: map[string]string{"X-Auth-Token": ""},
+		MoreHeaders:  map[string]string{"X-Auth-Token": ""},
+		IgnoreDryRun: true,
 	})
 	r.Err = err
 	if resp != nil {
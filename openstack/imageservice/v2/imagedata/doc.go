@@ -16,6 +16,23 @@ Example to Upload Image Data
 		panic(err)
 	}
 
+Example to Upload Image Data With Progress Reporting
+
+	imageData, err := os.Open("/path/to/image/file")
+	if err != nil {
+		panic(err)
+	}
+	defer imageData.Close()
+
+	data := imagedata.NewProgressReader(imageData, func(transferred int64) {
+		fmt.Printf("uploaded %d bytes\n", transferred)
+	})
+
+	err = imagedata.Upload(imageClient, imageID, data).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Stage Image Data
 
   imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
@@ -44,5 +61,21 @@ Example to Download Image Data
 	if err != nil {
 		panic(err)
 	}
+
+Example to Download Image Data to a File
+
+The data is streamed to the file and verified against the checksum returned
+by the Image service.
+
+	imageFile, err := os.Create("/path/to/image/file")
+	if err != nil {
+		panic(err)
+	}
+	defer imageFile.Close()
+
+	_, err = imagedata.Download(imageClient, imageID).ExtractTo(imageFile)
+	if err != nil {
+		panic(err)
+	}
 */
 package imagedata
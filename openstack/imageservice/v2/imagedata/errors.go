@@ -0,0 +1,19 @@
+package imagedata
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrChecksumMismatch is returned when the checksum of downloaded image data
+// does not match the one reported by the Image service.
+type ErrChecksumMismatch struct {
+	gophercloud.BaseError
+	Expected string
+	Actual   string
+}
+
+func (e ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf("Image data checksum mismatch: expected %s but got %s", e.Expected, e.Actual)
+}
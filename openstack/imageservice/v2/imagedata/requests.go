@@ -37,3 +37,60 @@ func Download(client *gophercloud.ServiceClient, id string) (r DownloadResult) {
 	}
 	return
 }
+
+// ProgressFunc is called with the total number of bytes transferred so far.
+type ProgressFunc func(transferred int64)
+
+// ProgressReader wraps an io.Reader and reports the number of bytes read from
+// it to Progress. It can be passed to Upload or Stage to track how much of an
+// image file has been sent without buffering it in memory.
+//
+// A ProgressReader is not an io.Seeker, so the upload cannot be rewound and
+// is not retried, e.g. after a reauthentication. Use NewProgressReader to keep
+// the wrapped reader seekable.
+type ProgressReader struct {
+	Reader   io.Reader
+	Progress ProgressFunc
+
+	transferred int64
+}
+
+// NewProgressReader wraps r in a ProgressReader. If r is an io.Seeker, the
+// returned reader is a *SeekableProgressReader, so that the upload can be
+// rewound and resent; otherwise it is a *ProgressReader.
+func NewProgressReader(r io.Reader, progress ProgressFunc) io.Reader {
+	p := &ProgressReader{Reader: r, Progress: progress}
+	if seeker, ok := r.(io.Seeker); ok {
+		return &SeekableProgressReader{ProgressReader: p, seeker: seeker}
+	}
+	return p
+}
+
+// Read implements io.Reader.
+func (p *ProgressReader) Read(b []byte) (int, error) {
+	n, err := p.Reader.Read(b)
+	if n > 0 {
+		p.transferred += int64(n)
+		if p.Progress != nil {
+			p.Progress(p.transferred)
+		}
+	}
+	return n, err
+}
+
+// SeekableProgressReader is a ProgressReader for a reader which implements
+// io.Seeker. It is returned by NewProgressReader.
+type SeekableProgressReader struct {
+	*ProgressReader
+
+	seeker io.Seeker
+}
+
+// Seek implements io.Seeker. The progress is reset to the new offset.
+func (p *SeekableProgressReader) Seek(offset int64, whence int) (int64, error) {
+	pos, err := p.seeker.Seek(offset, whence)
+	if err == nil {
+		p.transferred = pos
+	}
+	return pos, err
+}
@@ -1,6 +1,8 @@
 package imagedata
 
 import (
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"io"
 
@@ -32,3 +34,59 @@ func (r DownloadResult) Extract() (io.Reader, error) {
 	}
 	return nil, fmt.Errorf("Expected io.Reader but got: %T(%#v)", r.Body, r.Body)
 }
+
+// ExtractTo streams the image data into w and closes the response body. If
+// the Image service returned a Content-MD5 header, the checksum of the data
+// written is verified against it and ErrChecksumMismatch is returned when
+// they differ. It returns the number of bytes written.
+func (r DownloadResult) ExtractTo(w io.Writer) (int64, error) {
+	if r.Err != nil {
+		return 0, r.Err
+	}
+
+	body, ok := r.Body.(io.ReadCloser)
+	if !ok {
+		return 0, fmt.Errorf("Expected io.ReadCloser but got: %T(%#v)", r.Body, r.Body)
+	}
+	defer body.Close()
+
+	hash := md5.New()
+	n, err := io.Copy(io.MultiWriter(w, hash), body)
+	if err != nil {
+		return n, err
+	}
+
+	expected := r.Header.Get("Content-MD5")
+	if expected == "" {
+		return n, nil
+	}
+
+	actual := hex.EncodeToString(hash.Sum(nil))
+	if actual != expected {
+		return n, ErrChecksumMismatch{Expected: expected, Actual: actual}
+	}
+
+	return n, nil
+}
+
+// ProgressWriter wraps an io.Writer and reports the number of bytes written
+// to it to Progress. It can be passed to DownloadResult.ExtractTo to track
+// how much of an image file has been received.
+type ProgressWriter struct {
+	Writer   io.Writer
+	Progress ProgressFunc
+
+	transferred int64
+}
+
+// Write implements io.Writer.
+func (p *ProgressWriter) Write(b []byte) (int, error) {
+	n, err := p.Writer.Write(b)
+	if n > 0 {
+		p.transferred += int64(n)
+		if p.Progress != nil {
+			p.Progress(p.transferred)
+		}
+	}
+	return n, err
+}
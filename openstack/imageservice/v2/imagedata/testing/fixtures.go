@@ -55,3 +55,18 @@ func HandleGetImageDataSuccessfully(t *testing.T) {
 		th.AssertNoErr(t, err)
 	})
 }
+
+// HandleGetImageDataWithChecksum sets up the test server to respond to a
+// download request with image data and the given Content-MD5 header.
+func HandleGetImageDataWithChecksum(t *testing.T, checksum string) {
+	th.Mux.HandleFunc("/images/da3b75d9-3f4a-40e7-8a2c-bfab23927dea/file", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Set("Content-MD5", checksum)
+		w.WriteHeader(http.StatusOK)
+
+		_, err := w.Write([]byte{34, 87, 0, 23, 23, 23, 56, 255, 254, 0})
+		th.AssertNoErr(t, err)
+	})
+}
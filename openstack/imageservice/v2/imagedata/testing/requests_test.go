@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -25,6 +26,45 @@ func TestUpload(t *testing.T) {
 	th.AssertNoErr(t, err)
 }
 
+func TestUploadWithProgress(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandlePutImageDataSuccessfully(t)
+
+	var transferred int64
+	data := imagedata.NewProgressReader(readSeekerOfBytes([]byte{5, 3, 7, 24}), func(n int64) {
+		transferred = n
+	})
+	if _, ok := data.(io.Seeker); !ok {
+		t.Fatalf("Expected a seekable reader, got %T", data)
+	}
+
+	err := imagedata.Upload(
+		fakeclient.ServiceClient(),
+		"da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+		data).ExtractErr()
+
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(4), transferred)
+}
+
+func TestProgressReaderNotSeekable(t *testing.T) {
+	r, w := io.Pipe()
+	defer r.Close()
+	defer w.Close()
+
+	data := imagedata.NewProgressReader(r, nil)
+	if _, ok := data.(io.Seeker); ok {
+		t.Fatalf("Expected a reader which is not an io.Seeker, got %T", data)
+	}
+
+	var p interface{} = &imagedata.ProgressReader{Reader: r}
+	if _, ok := p.(io.Seeker); ok {
+		t.Fatalf("Expected ProgressReader not to be an io.Seeker")
+	}
+}
+
 func TestStage(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -99,3 +139,38 @@ func TestDownload(t *testing.T) {
 
 	th.AssertByteArrayEquals(t, []byte{34, 87, 0, 23, 23, 23, 56, 255, 254, 0}, bs)
 }
+
+func TestDownloadTo(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetImageDataWithChecksum(t, "f1bcbf6689fd908c2c3b06a79f4a9d96")
+
+	var transferred int64
+	var buf bytes.Buffer
+	w := &imagedata.ProgressWriter{
+		Writer: &buf,
+		Progress: func(n int64) {
+			transferred = n
+		},
+	}
+
+	n, err := imagedata.Download(fakeclient.ServiceClient(), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").ExtractTo(w)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(10), n)
+	th.AssertEquals(t, int64(10), transferred)
+	th.AssertByteArrayEquals(t, []byte{34, 87, 0, 23, 23, 23, 56, 255, 254, 0}, buf.Bytes())
+}
+
+func TestDownloadToChecksumMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetImageDataWithChecksum(t, "00000000000000000000000000000000")
+
+	var buf bytes.Buffer
+	_, err := imagedata.Download(fakeclient.ServiceClient(), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea").ExtractTo(&buf)
+	if _, ok := err.(imagedata.ErrChecksumMismatch); !ok {
+		t.Fatalf("Expected ErrChecksumMismatch but got: %v", err)
+	}
+}
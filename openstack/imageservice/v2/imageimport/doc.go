@@ -23,5 +23,30 @@ Example to Create a new image import
   if err != nil {
     panic(err)
   }
+
+Example to Import Staged Image Data into Specific Stores
+
+  imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+  imageData, err := os.Open("/path/to/image/file")
+  if err != nil {
+    panic(err)
+  }
+  defer imageData.Close()
+
+  err = imagedata.Stage(imagesClient, imageID, imageData).ExtractErr()
+  if err != nil {
+    panic(err)
+  }
+
+  opts := imageimport.CreateOpts{
+    Name:   imageimport.GlanceDirectMethod,
+    Stores: []string{"ceph", "file"},
+  }
+
+  err = imageimport.Create(imagesClient, imageID, opts).ExtractErr()
+  if err != nil {
+    panic(err)
+  }
 */
 package imageimport
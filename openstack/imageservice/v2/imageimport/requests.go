@@ -26,8 +26,23 @@ type CreateOptsBuilder interface {
 
 // CreateOpts specifies parameters of a new image import.
 type CreateOpts struct {
+	// Name is the import method to use.
 	Name ImportMethod `json:"name"`
-	URI  string       `json:"uri"`
+
+	// URI is the location of the image data. It is required by the
+	// web-download method.
+	URI string `json:"uri,omitempty"`
+
+	// Stores is a list of store identifiers the image should be imported into.
+	// It is only supported on multi-store deployments.
+	Stores []string `json:"-"`
+
+	// AllStores requests that the image be imported into all available stores.
+	AllStores *bool `json:"-"`
+
+	// AllStoresMustSucceed specifies whether the import should fail if the
+	// image can not be imported into one of the requested stores.
+	AllStoresMustSucceed *bool `json:"-"`
 }
 
 // ToImportCreateMap constructs a request body from CreateOpts.
@@ -36,7 +51,22 @@ func (opts CreateOpts) ToImportCreateMap() (map[string]interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	return map[string]interface{}{"method": b}, nil
+
+	body := map[string]interface{}{"method": b}
+
+	if len(opts.Stores) > 0 {
+		body["stores"] = opts.Stores
+	}
+
+	if opts.AllStores != nil {
+		body["all_stores"] = *opts.AllStores
+	}
+
+	if opts.AllStoresMustSucceed != nil {
+		body["all_stores_must_succeed"] = *opts.AllStoresMustSucceed
+	}
+
+	return body, nil
 }
 
 // Create requests the creation of a new image import on the server.
@@ -23,3 +23,18 @@ const ImportCreateRequest = `
     }
 }
 `
+
+// ImportCreateToStoresRequest represents a request to create image import
+// into specific stores.
+const ImportCreateToStoresRequest = `
+{
+    "method": {
+        "name": "glance-direct"
+    },
+    "stores": [
+        "ceph",
+        "file"
+    ],
+    "all_stores_must_succeed": false
+}
+`
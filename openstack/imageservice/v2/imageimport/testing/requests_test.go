@@ -58,3 +58,27 @@ func TestCreate(t *testing.T) {
 	err := imageimport.Create(fakeclient.ServiceClient(), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", opts).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestCreateToStores(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images/da3b75d9-3f4a-40e7-8a2c-bfab23927dea/import", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, ImportCreateToStoresRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{}`)
+	})
+
+	allStoresMustSucceed := false
+	opts := imageimport.CreateOpts{
+		Name:                 imageimport.GlanceDirectMethod,
+		Stores:               []string{"ceph", "file"},
+		AllStoresMustSucceed: &allStoresMustSucceed,
+	}
+	err := imageimport.Create(fakeclient.ServiceClient(), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -42,6 +42,12 @@ Example to Update an Image
 		images.ReplaceImageName{
 			NewName: "new_name",
 		},
+		images.ReplaceImageMinRam{
+			NewMinRam: 1024,
+		},
+		images.ReplaceImageProtected{
+			NewProtected: true,
+		},
 	}
 
 	image, err := images.Update(imageClient, imageID, updateOpts).Extract()
@@ -298,7 +298,7 @@ type ReplaceImageChecksum struct {
 	Checksum string
 }
 
-// ReplaceImageChecksum assembles a request body based on ReplaceImageChecksum.
+// ToImagePatchMap assembles a request body based on ReplaceImageChecksum.
 func (r ReplaceImageChecksum) ToImagePatchMap() map[string]interface{} {
 	return map[string]interface{}{
 		"op":    "replace",
@@ -326,7 +326,7 @@ type ReplaceImageMinDisk struct {
 	NewMinDisk int
 }
 
-// ToImagePatchMap assembles a request body based on ReplaceImageTags.
+// ToImagePatchMap assembles a request body based on ReplaceImageMinDisk.
 func (r ReplaceImageMinDisk) ToImagePatchMap() map[string]interface{} {
 	return map[string]interface{}{
 		"op":    "replace",
@@ -335,6 +335,34 @@ func (r ReplaceImageMinDisk) ToImagePatchMap() map[string]interface{} {
 	}
 }
 
+// ReplaceImageMinRam represents an updated min_ram property request.
+type ReplaceImageMinRam struct {
+	NewMinRam int
+}
+
+// ToImagePatchMap assembles a request body based on ReplaceImageMinRam.
+func (r ReplaceImageMinRam) ToImagePatchMap() map[string]interface{} {
+	return map[string]interface{}{
+		"op":    "replace",
+		"path":  "/min_ram",
+		"value": r.NewMinRam,
+	}
+}
+
+// ReplaceImageProtected represents an updated protected property request.
+type ReplaceImageProtected struct {
+	NewProtected bool
+}
+
+// ToImagePatchMap assembles a request body based on ReplaceImageProtected.
+func (r ReplaceImageProtected) ToImagePatchMap() map[string]interface{} {
+	return map[string]interface{}{
+		"op":    "replace",
+		"path":  "/protected",
+		"value": r.NewProtected,
+	}
+}
+
 // UpdateOp represents a valid update operation.
 type UpdateOp string
 
@@ -255,6 +255,8 @@ func TestUpdateImage(t *testing.T) {
 		images.ReplaceImageName{NewName: "Fedora 17"},
 		images.ReplaceImageTags{NewTags: []string{"fedora", "beefy"}},
 		images.ReplaceImageMinDisk{NewMinDisk: 21},
+		images.ReplaceImageMinRam{NewMinRam: 1024},
+		images.ReplaceImageProtected{NewProtected: true},
 	}).Extract()
 
 	th.AssertNoErr(t, err)
@@ -281,8 +283,9 @@ func TestUpdateImage(t *testing.T) {
 		},
 
 		Owner:            "",
-		MinRAMMegabytes:  0,
+		MinRAMMegabytes:  1024,
 		MinDiskGigabytes: 21,
+		Protected:        true,
 
 		DiskFormat:      "",
 		ContainerFormat: "",
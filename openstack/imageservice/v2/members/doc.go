@@ -7,7 +7,7 @@ Example to List Members of an Image
 
 	imageID := "2b6cacd4-cfd6-4b95-8302-4c04ccf0be3f"
 
-	allPages, err := members.List(imageID).AllPages()
+	allPages, err := members.List(imageClient, imageID).AllPages()
 	if err != nil {
 		panic(err)
 	}
@@ -37,7 +37,7 @@ Example to Update the Status of a Member
 	projectID := "fc404778935a4cebaddcb4788fb3ff2c"
 
 	updateOpts := members.UpdateOpts{
-		Status: "accepted",
+		Status: members.StatusAccepted,
 	}
 
 	member, err := members.Update(imageClient, imageID, projectID, updateOpts).Extract()
@@ -58,14 +58,14 @@ type UpdateOptsBuilder interface {
 
 // UpdateOpts represents options to an Update request.
 type UpdateOpts struct {
-	Status string
+	// Status is the new status of the member. Valid values are StatusPending,
+	// StatusAccepted and StatusRejected.
+	Status string `json:"status" required:"true"`
 }
 
-// ToMemberUpdateMap formats an UpdateOpts structure into a request body.
+// ToImageMemberUpdateMap formats an UpdateOpts structure into a request body.
 func (opts UpdateOpts) ToImageMemberUpdateMap() (map[string]interface{}, error) {
-	return map[string]interface{}{
-		"status": opts.Status,
-	}, nil
+	return gophercloud.BuildRequestBody(opts, "")
 }
 
 // Update function updates member.
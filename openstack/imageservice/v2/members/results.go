@@ -7,6 +7,18 @@ import (
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
+const (
+	// StatusPending is the status of a member which has not yet accepted or
+	// rejected the image.
+	StatusPending = "pending"
+
+	// StatusAccepted is the status of a member which has accepted the image.
+	StatusAccepted = "accepted"
+
+	// StatusRejected is the status of a member which has rejected the image.
+	StatusRejected = "rejected"
+)
+
 // Member represents a member of an Image.
 type Member struct {
 	CreatedAt time.Time `json:"created_at"`
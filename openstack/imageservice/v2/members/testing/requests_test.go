@@ -149,7 +149,7 @@ func TestMemberUpdateSuccessfully(t *testing.T) {
 	im, err := members.Update(fakeclient.ServiceClient(), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
 		"8989447062e04a818baf9e073fd04fa7",
 		members.UpdateOpts{
-			Status: "accepted",
+			Status: members.StatusAccepted,
 		}).Extract()
 	th.AssertEquals(t, 1, counter.Counter)
 	th.AssertNoErr(t, err)
@@ -170,3 +170,11 @@ func TestMemberUpdateSuccessfully(t *testing.T) {
 	}, *im)
 
 }
+
+func TestMemberUpdateRequiresStatus(t *testing.T) {
+	res := members.Update(fakeclient.ServiceClient(), "da3b75d9-3f4a-40e7-8a2c-bfab23927dea",
+		"8989447062e04a818baf9e073fd04fa7", members.UpdateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
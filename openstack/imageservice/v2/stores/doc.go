@@ -0,0 +1,31 @@
+/*
+Package stores enables retrieval of the backend stores of a multi-store
+Imageservice deployment and removal of image data from a single store.
+
+Example to List Stores
+
+	allPages, err := stores.List(imageClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allStores, err := stores.ExtractStores(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, store := range allStores {
+		fmt.Printf("%+v\n", store)
+	}
+
+Example to Delete Image Data from a Store
+
+	storeID := "ceph"
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	err := stores.Delete(imageClient, storeID, imageID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package stores
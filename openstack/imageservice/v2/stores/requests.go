@@ -0,0 +1,23 @@
+package stores
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List returns a Pager which allows you to iterate over the stores available
+// in a multi-store deployment.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return StorePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Delete removes the data of an image from the specified store. The image
+// itself is not deleted and its data remains available in other stores.
+func Delete(c *gophercloud.ServiceClient, storeID, imageID string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, storeID, imageID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
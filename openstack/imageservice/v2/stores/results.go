@@ -0,0 +1,48 @@
+package stores
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Store represents a backend store of the OpenStack Image service.
+type Store struct {
+	// ID is the identifier of the store.
+	ID string `json:"id"`
+
+	// Description is a human-readable description of the store.
+	Description string `json:"description"`
+
+	// Default indicates whether this is the default store.
+	Default bool `json:"default"`
+
+	// ReadOnly indicates whether the store is read-only.
+	ReadOnly bool `json:"read-only"`
+}
+
+// StorePage is a single page of Store results.
+type StorePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a StorePage contains any results.
+func (r StorePage) IsEmpty() (bool, error) {
+	stores, err := ExtractStores(r)
+	return len(stores) == 0, err
+}
+
+// ExtractStores returns a slice of Stores contained in a single page of
+// results.
+func ExtractStores(r pagination.Page) ([]Store, error) {
+	var s struct {
+		Stores []Store `json:"stores"`
+	}
+	err := (r.(StorePage)).ExtractInto(&s)
+	return s.Stores, err
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// stores unit tests
+package testing
@@ -0,0 +1,72 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/stores"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Store results.
+const ListOutput = `
+{
+    "stores": [
+        {
+            "id": "ceph",
+            "description": "Fast access to rbd store",
+            "default": true
+        },
+        {
+            "id": "cdn",
+            "description": "Read only CDN store",
+            "read-only": true
+        }
+    ]
+}
+`
+
+// FirstStore is the first store in the List request.
+var FirstStore = stores.Store{
+	ID:          "ceph",
+	Description: "Fast access to rbd store",
+	Default:     true,
+}
+
+// SecondStore is the second store in the List request.
+var SecondStore = stores.Store{
+	ID:          "cdn",
+	Description: "Read only CDN store",
+	ReadOnly:    true,
+}
+
+// ExpectedStoresSlice is the slice of stores expected to be returned from
+// ListOutput.
+var ExpectedStoresSlice = []stores.Store{FirstStore, SecondStore}
+
+// HandleListSuccessfully creates an HTTP handler at `/info/stores` on the
+// test handler mux that responds with a list of two stores.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/info/stores", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at
+// `/stores/ceph/da3b75d9-3f4a-40e7-8a2c-bfab23927dea` on the test handler
+// mux that responds with a 204 status.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stores/ceph/da3b75d9-3f4a-40e7-8a2c-bfab23927dea", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
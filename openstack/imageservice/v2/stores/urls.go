@@ -0,0 +1,16 @@
+package stores
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	infoPath     = "info"
+	resourcePath = "stores"
+)
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(infoPath, resourcePath)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, storeID, imageID string) string {
+	return c.ServiceURL(resourcePath, storeID, imageID)
+}
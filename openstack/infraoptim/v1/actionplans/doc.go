@@ -0,0 +1,55 @@
+/*
+Package actionplans manages the action plans of the OpenStack Infrastructure
+Optimization service (Watcher). An action plan is recommended by a succeeded
+audit and applies its actions once started.
+
+Example to List the Action Plans of an Audit
+
+	listOpts := actionplans.ListOpts{
+		AuditUUID: auditUUID,
+	}
+
+	allPages, err := actionplans.ListDetail(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allActionPlans, err := actionplans.ExtractActionPlans(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, actionPlan := range allActionPlans {
+		fmt.Printf("%+v\n", actionPlan)
+	}
+
+Example to Start an Action Plan
+
+	actionPlan, err := actionplans.Start(client, actionPlanUUID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Cancel an Action Plan
+
+	updateOpts := actionplans.UpdateOpts{
+		actionplans.UpdateOperation{
+			Op:    actionplans.ReplaceOp,
+			Path:  "/state",
+			Value: actionplans.StateCancelled,
+		},
+	}
+
+	actionPlan, err := actionplans.Update(client, actionPlanUUID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Action Plan
+
+	err := actionplans.Delete(client, actionPlanUUID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package actionplans
@@ -0,0 +1,147 @@
+package actionplans
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Action plan states.
+const (
+	StateRecommended = "RECOMMENDED"
+	StatePending     = "PENDING"
+	StateOngoing     = "ONGOING"
+	StateSucceeded   = "SUCCEEDED"
+	StateSuperseded  = "SUPERSEDED"
+	StateFailed      = "FAILED"
+	StateCancelling  = "CANCELLING"
+	StateCancelled   = "CANCELLED"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List and ListDetail requests.
+type ListOptsBuilder interface {
+	ToActionPlanListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// AuditUUID filters the action plans by audit.
+	AuditUUID string `q:"audit_uuid"`
+
+	// Strategy filters the action plans by strategy UUID or name.
+	Strategy string `q:"strategy"`
+
+	// Limit limits the number of action plans to return.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last action plan of the previous page.
+	Marker string `q:"marker"`
+
+	// SortKey sorts the action plans by the given attribute.
+	SortKey string `q:"sort_key"`
+
+	// SortDir is the sort direction, "asc" or "desc".
+	SortDir string `q:"sort_dir"`
+}
+
+// ToActionPlanListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToActionPlanListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToActionPlanListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ActionPlanPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// action plans. Only the main attributes of each plan are returned.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns a Pager which allows you to iterate over a collection
+// of action plans with all their attributes.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+// Get retrieves a specific action plan based on its UUID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// Patch represents a single JSON patch operation of an Update request.
+type Patch interface {
+	ToActionPlanUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a slice of Patches used to update an action plan.
+type UpdateOpts []Patch
+
+// UpdateOp is the type of a JSON patch operation.
+type UpdateOp string
+
+const (
+	ReplaceOp UpdateOp = "replace"
+	AddOp     UpdateOp = "add"
+	RemoveOp  UpdateOp = "remove"
+)
+
+// UpdateOperation changes the attribute at Path of an action plan, e.g.
+// "/state" to cancel it.
+type UpdateOperation struct {
+	Op    UpdateOp    `json:"op" required:"true"`
+	Path  string      `json:"path" required:"true"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ToActionPlanUpdateMap constructs a JSON patch operation from an
+// UpdateOperation.
+func (opts UpdateOperation) ToActionPlanUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of an action plan.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOpts) (r UpdateResult) {
+	body := make([]map[string]interface{}, len(opts))
+	for i, patch := range opts {
+		result, err := patch.ToActionPlanUpdateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+
+		body[i] = result
+	}
+	_, r.Err = client.Patch(updateURL(client, id), body, &r.Body, &gophercloud.RequestOpts{
+		JSONBody: &body,
+		OkCodes:  []int{200},
+	})
+	return
+}
+
+// Start requests the execution of a recommended action plan.
+func Start(client *gophercloud.ServiceClient, id string) (r StartResult) {
+	_, r.Err = client.Post(startURL(client, id), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete requests the deletion of an action plan.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
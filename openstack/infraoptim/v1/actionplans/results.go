@@ -0,0 +1,132 @@
+package actionplans
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ActionPlan represents the actions recommended by an audit to achieve its
+// goal.
+type ActionPlan struct {
+	// UUID is the unique ID of the action plan.
+	UUID string `json:"uuid"`
+
+	// AuditUUID is the UUID of the audit which recommended the plan.
+	AuditUUID string `json:"audit_uuid"`
+
+	// StrategyUUID is the UUID of the strategy which built the plan.
+	StrategyUUID string `json:"strategy_uuid"`
+
+	// StrategyName is the name of the strategy which built the plan.
+	StrategyName string `json:"strategy_name"`
+
+	// State is the state of the action plan, e.g. StateRecommended or
+	// StateSucceeded.
+	State string `json:"state"`
+
+	// GlobalEfficacy describes the expected benefits of the whole plan.
+	GlobalEfficacy []EfficacyIndicator `json:"global_efficacy"`
+
+	// EfficacyIndicators describe the expected benefits of the plan for
+	// the goal of the audit.
+	EfficacyIndicators []EfficacyIndicator `json:"efficacy_indicators"`
+
+	// Hostname is the host running the action plan.
+	Hostname string `json:"hostname"`
+
+	// Links contains referencing links to the action plan.
+	Links []gophercloud.Link `json:"links"`
+
+	// CreatedAt is the date the action plan was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date the action plan was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// EfficacyIndicator is a measure of the expected benefits of an action
+// plan.
+type EfficacyIndicator struct {
+	// Name is the name of the indicator.
+	Name string `json:"name"`
+
+	// Description is a description of the indicator.
+	Description string `json:"description"`
+
+	// Unit is the unit of the indicator.
+	Unit string `json:"unit"`
+
+	// Value is the value of the indicator.
+	Value float64 `json:"value"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as an ActionPlan.
+func (r commonResult) Extract() (*ActionPlan, error) {
+	var s *ActionPlan
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as an ActionPlan.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as an ActionPlan.
+type UpdateResult struct {
+	commonResult
+}
+
+// StartResult is the response from a Start operation. Call its Extract
+// method to interpret it as an ActionPlan.
+type StartResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ActionPlanPage is a single page of ActionPlan results.
+type ActionPlanPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of action plans has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (r ActionPlanPage) NextPageURL() (string, error) {
+	var s struct {
+		Next string `json:"next"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Next, nil
+}
+
+// IsEmpty determines whether or not an ActionPlanPage contains any results.
+func (r ActionPlanPage) IsEmpty() (bool, error) {
+	actionPlans, err := ExtractActionPlans(r)
+	return len(actionPlans) == 0, err
+}
+
+// ExtractActionPlans returns a slice of ActionPlans contained in a single
+// page of results.
+func ExtractActionPlans(r pagination.Page) ([]ActionPlan, error) {
+	var s struct {
+		ActionPlans []ActionPlan `json:"action_plans"`
+	}
+	err := (r.(ActionPlanPage)).ExtractInto(&s)
+	return s.ActionPlans, err
+}
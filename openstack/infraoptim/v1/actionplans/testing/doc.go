@@ -0,0 +1,2 @@
+// infraoptim_actionplans_v1
+package testing
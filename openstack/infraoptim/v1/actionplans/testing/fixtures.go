@@ -0,0 +1,173 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/actionplans"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const ActionPlanUUID = "4cbc4ede-0d25-481b-b86e-998dbbd4f8bf"
+
+// ActionPlanBody is the JSON representation of a single action plan.
+const ActionPlanBody = `
+{
+  "uuid": "4cbc4ede-0d25-481b-b86e-998dbbd4f8bf",
+  "audit_uuid": "867c6226-26ed-4a56-a9e3-2e8ce6f1b1cb",
+  "strategy_uuid": "c5b2c0c5-0d3c-4fa1-a1b6-2c4b9e2b5f63",
+  "strategy_name": "vm_workload_consolidation",
+  "state": "RECOMMENDED",
+  "global_efficacy": [
+    {
+      "name": "released_nodes_ratio",
+      "description": "Ratio of released compute nodes divided by the total number of enabled compute nodes.",
+      "unit": "%",
+      "value": 50
+    }
+  ],
+  "efficacy_indicators": [
+    {
+      "name": "compute_nodes_count",
+      "description": "The total number of enabled compute nodes.",
+      "unit": null,
+      "value": 2
+    }
+  ],
+  "hostname": "watcher-01",
+  "links": [],
+  "created_at": "2018-04-10T12:03:17.214112+00:00",
+  "updated_at": null,
+  "deleted_at": null
+}
+`
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`
+{
+  "action_plans": [%s],
+  "next": ""
+}
+`, ActionPlanBody)
+
+// UpdateRequest is a sample request to update an action plan.
+const UpdateRequest = `
+[
+  {
+    "op": "replace",
+    "path": "/state",
+    "value": "CANCELLED"
+  }
+]
+`
+
+var createdAt, _ = time.Parse(time.RFC3339, "2018-04-10T12:03:17.214112+00:00")
+
+// FirstActionPlan is the action plan described by ActionPlanBody.
+var FirstActionPlan = actionplans.ActionPlan{
+	UUID:         ActionPlanUUID,
+	AuditUUID:    "867c6226-26ed-4a56-a9e3-2e8ce6f1b1cb",
+	StrategyUUID: "c5b2c0c5-0d3c-4fa1-a1b6-2c4b9e2b5f63",
+	StrategyName: "vm_workload_consolidation",
+	State:        actionplans.StateRecommended,
+	GlobalEfficacy: []actionplans.EfficacyIndicator{
+		{
+			Name:        "released_nodes_ratio",
+			Description: "Ratio of released compute nodes divided by the total number of enabled compute nodes.",
+			Unit:        "%",
+			Value:       50,
+		},
+	},
+	EfficacyIndicators: []actionplans.EfficacyIndicator{
+		{
+			Name:        "compute_nodes_count",
+			Description: "The total number of enabled compute nodes.",
+			Value:       2,
+		},
+	},
+	Hostname:  "watcher-01",
+	Links:     []gophercloud.Link{},
+	CreatedAt: createdAt,
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/action_plans", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"audit_uuid": "867c6226-26ed-4a56-a9e3-2e8ce6f1b1cb"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleListDetailSuccessfully configures the test server to respond to a
+// ListDetail request.
+func HandleListDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/action_plans/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/action_plans/"+ActionPlanUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ActionPlanBody)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/action_plans/"+ActionPlanUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ActionPlanBody)
+	})
+}
+
+// HandleStartSuccessfully configures the test server to respond to a Start
+// request.
+func HandleStartSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/action_plans/"+ActionPlanUUID+"/start", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ActionPlanBody)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/action_plans/"+ActionPlanUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
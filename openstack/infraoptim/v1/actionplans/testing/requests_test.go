@@ -0,0 +1,92 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/actionplans"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListActionPlans(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := actionplans.ListOpts{
+		AuditUUID: "867c6226-26ed-4a56-a9e3-2e8ce6f1b1cb",
+	}
+
+	count := 0
+	err := actionplans.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := actionplans.ExtractActionPlans(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []actionplans.ActionPlan{FirstActionPlan}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListActionPlansDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDetailSuccessfully(t)
+
+	allPages, err := actionplans.ListDetail(fake.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := actionplans.ExtractActionPlans(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []actionplans.ActionPlan{FirstActionPlan}, actual)
+}
+
+func TestGetActionPlan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := actionplans.Get(fake.ServiceClient(), ActionPlanUUID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstActionPlan, actual)
+}
+
+func TestUpdateActionPlan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := actionplans.UpdateOpts{
+		actionplans.UpdateOperation{
+			Op:    actionplans.ReplaceOp,
+			Path:  "/state",
+			Value: actionplans.StateCancelled,
+		},
+	}
+
+	actual, err := actionplans.Update(fake.ServiceClient(), ActionPlanUUID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstActionPlan, actual)
+}
+
+func TestStartActionPlan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStartSuccessfully(t)
+
+	actual, err := actionplans.Start(fake.ServiceClient(), ActionPlanUUID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstActionPlan, actual)
+}
+
+func TestDeleteActionPlan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := actionplans.Delete(fake.ServiceClient(), ActionPlanUUID)
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,33 @@
+package actionplans
+
+import "github.com/gophercloud/gophercloud"
+
+const actionPlansPath = "action_plans"
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(actionPlansPath, id)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(actionPlansPath)
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(actionPlansPath, "detail")
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func startURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(actionPlansPath, id, "start")
+}
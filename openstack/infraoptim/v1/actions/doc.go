@@ -0,0 +1,33 @@
+/*
+Package actions lists the actions of the OpenStack Infrastructure
+Optimization service (Watcher). Actions are the steps of an action plan and
+are created by Watcher when an audit succeeds.
+
+Example to List the Actions of an Action Plan
+
+	listOpts := actions.ListOpts{
+		ActionPlanUUID: actionPlanUUID,
+	}
+
+	allPages, err := actions.ListDetail(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allActions, err := actions.ExtractActions(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, action := range allActions {
+		fmt.Printf("%s: %s\n", action.ActionType, action.State)
+	}
+
+Example to Get an Action
+
+	action, err := actions.Get(client, actionUUID).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package actions
@@ -0,0 +1,82 @@
+package actions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Action states.
+const (
+	StatePending    = "PENDING"
+	StateOngoing    = "ONGOING"
+	StateSucceeded  = "SUCCEEDED"
+	StateFailed     = "FAILED"
+	StateCancelling = "CANCELLING"
+	StateCancelled  = "CANCELLED"
+	StateSkipped    = "SKIPPED"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List and ListDetail requests.
+type ListOptsBuilder interface {
+	ToActionListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// ActionPlanUUID filters the actions by action plan.
+	ActionPlanUUID string `q:"action_plan_uuid"`
+
+	// AuditUUID filters the actions by audit.
+	AuditUUID string `q:"audit_uuid"`
+
+	// Limit limits the number of actions to return.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last action of the previous page.
+	Marker string `q:"marker"`
+
+	// SortKey sorts the actions by the given attribute.
+	SortKey string `q:"sort_key"`
+
+	// SortDir is the sort direction, "asc" or "desc".
+	SortDir string `q:"sort_dir"`
+}
+
+// ToActionListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToActionListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToActionListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ActionPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// actions. Only the main attributes of each action are returned.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns a Pager which allows you to iterate over a collection
+// of actions with all their attributes.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+// Get retrieves a specific action based on its UUID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
@@ -0,0 +1,91 @@
+package actions
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Action represents a single step of an action plan, such as a live
+// migration or a change of the state of a compute service.
+type Action struct {
+	// UUID is the unique ID of the action.
+	UUID string `json:"uuid"`
+
+	// ActionPlanUUID is the UUID of the action plan of the action.
+	ActionPlanUUID string `json:"action_plan_uuid"`
+
+	// ActionType is the type of the action, e.g. "migrate" or
+	// "change_nova_service_state".
+	ActionType string `json:"action_type"`
+
+	// InputParameters are the parameters of the action.
+	InputParameters map[string]interface{} `json:"input_parameters"`
+
+	// State is the state of the action, e.g. StatePending or
+	// StateSucceeded.
+	State string `json:"state"`
+
+	// Parents are the UUIDs of the actions run before this one.
+	Parents []string `json:"parents"`
+
+	// Description is a description of the action.
+	Description string `json:"description"`
+
+	// Links contains referencing links to the action.
+	Links []gophercloud.Link `json:"links"`
+
+	// CreatedAt is the date the action was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date the action was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as an Action.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as an Action.
+func (r GetResult) Extract() (*Action, error) {
+	var s *Action
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ActionPage is a single page of Action results.
+type ActionPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of actions has reached
+// the end of a page and the pager seeks to traverse over a new one.
+func (r ActionPage) NextPageURL() (string, error) {
+	var s struct {
+		Next string `json:"next"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Next, nil
+}
+
+// IsEmpty determines whether or not an ActionPage contains any results.
+func (r ActionPage) IsEmpty() (bool, error) {
+	actions, err := ExtractActions(r)
+	return len(actions) == 0, err
+}
+
+// ExtractActions returns a slice of Actions contained in a single page of
+// results.
+func ExtractActions(r pagination.Page) ([]Action, error) {
+	var s struct {
+		Actions []Action `json:"actions"`
+	}
+	err := (r.(ActionPage)).ExtractInto(&s)
+	return s.Actions, err
+}
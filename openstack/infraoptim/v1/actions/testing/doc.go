@@ -0,0 +1,2 @@
+// infraoptim_actions_v1
+package testing
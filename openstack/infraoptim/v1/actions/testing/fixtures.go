@@ -0,0 +1,107 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/actions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const ActionUUID = "f3a1b6e8-2a4c-4c1c-9a3e-0c8f6f1d2b7a"
+
+// ActionBody is the JSON representation of a single action.
+const ActionBody = `
+{
+  "uuid": "f3a1b6e8-2a4c-4c1c-9a3e-0c8f6f1d2b7a",
+  "action_plan_uuid": "4cbc4ede-0d25-481b-b86e-998dbbd4f8bf",
+  "action_type": "migrate",
+  "input_parameters": {
+    "migration_type": "live",
+    "source_node": "compute-1",
+    "destination_node": "compute-2",
+    "resource_id": "9c2ad5b5-2ea7-4f3c-8f9d-5c7b3e2f1a0b"
+  },
+  "state": "PENDING",
+  "parents": [
+    "d9f1c6f2-1b7e-4f5c-8e6a-2f3b4c5d6e7f"
+  ],
+  "description": "Moving a VM instance from source_node to destination_node",
+  "links": [],
+  "created_at": "2018-04-10T12:03:17.285214+00:00",
+  "updated_at": null,
+  "deleted_at": null
+}
+`
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`
+{
+  "actions": [%s],
+  "next": ""
+}
+`, ActionBody)
+
+var createdAt, _ = time.Parse(time.RFC3339, "2018-04-10T12:03:17.285214+00:00")
+
+// FirstAction is the action described by ActionBody.
+var FirstAction = actions.Action{
+	UUID:           ActionUUID,
+	ActionPlanUUID: "4cbc4ede-0d25-481b-b86e-998dbbd4f8bf",
+	ActionType:     "migrate",
+	InputParameters: map[string]interface{}{
+		"migration_type":   "live",
+		"source_node":      "compute-1",
+		"destination_node": "compute-2",
+		"resource_id":      "9c2ad5b5-2ea7-4f3c-8f9d-5c7b3e2f1a0b",
+	},
+	State:       actions.StatePending,
+	Parents:     []string{"d9f1c6f2-1b7e-4f5c-8e6a-2f3b4c5d6e7f"},
+	Description: "Moving a VM instance from source_node to destination_node",
+	Links:       []gophercloud.Link{},
+	CreatedAt:   createdAt,
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/actions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"action_plan_uuid": "4cbc4ede-0d25-481b-b86e-998dbbd4f8bf"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleListDetailSuccessfully configures the test server to respond to a
+// ListDetail request.
+func HandleListDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/actions/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/actions/"+ActionUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ActionBody)
+	})
+}
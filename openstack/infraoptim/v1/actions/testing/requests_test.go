@@ -0,0 +1,55 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/actions"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListActions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := actions.ListOpts{
+		ActionPlanUUID: "4cbc4ede-0d25-481b-b86e-998dbbd4f8bf",
+	}
+
+	count := 0
+	err := actions.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := actions.ExtractActions(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []actions.Action{FirstAction}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListActionsDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDetailSuccessfully(t)
+
+	allPages, err := actions.ListDetail(fake.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := actions.ExtractActions(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []actions.Action{FirstAction}, actual)
+}
+
+func TestGetAction(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := actions.Get(fake.ServiceClient(), ActionUUID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAction, actual)
+}
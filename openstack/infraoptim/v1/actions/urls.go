@@ -0,0 +1,17 @@
+package actions
+
+import "github.com/gophercloud/gophercloud"
+
+const actionsPath = "actions"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(actionsPath)
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(actionsPath, "detail")
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(actionsPath, id)
+}
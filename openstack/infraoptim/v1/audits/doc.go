@@ -0,0 +1,56 @@
+/*
+Package audits manages the audits of the OpenStack Infrastructure
+Optimization service (Watcher). Creating an audit triggers an optimization
+run which, once succeeded, recommends an action plan.
+
+Example to List Audits
+
+	allPages, err := audits.ListDetail(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAudits, err := audits.ExtractAudits(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, audit := range allAudits {
+		fmt.Printf("%+v\n", audit)
+	}
+
+Example to Create an Audit
+
+	createOpts := audits.CreateOpts{
+		AuditType:         audits.AuditTypeOneShot,
+		AuditTemplateUUID: auditTemplateUUID,
+	}
+
+	audit, err := audits.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Cancel a Continuous Audit
+
+	updateOpts := audits.UpdateOpts{
+		audits.UpdateOperation{
+			Op:    audits.ReplaceOp,
+			Path:  "/state",
+			Value: audits.StateCancelled,
+		},
+	}
+
+	audit, err := audits.Update(client, auditUUID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Audit
+
+	err := audits.Delete(client, auditUUID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package audits
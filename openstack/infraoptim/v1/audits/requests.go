@@ -0,0 +1,211 @@
+package audits
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Audit types.
+const (
+	AuditTypeOneShot    = "ONESHOT"
+	AuditTypeContinuous = "CONTINUOUS"
+	AuditTypeEvent      = "EVENT"
+)
+
+// Audit states.
+const (
+	StatePending   = "PENDING"
+	StateOngoing   = "ONGOING"
+	StateSucceeded = "SUCCEEDED"
+	StateFailed    = "FAILED"
+	StateCancelled = "CANCELLED"
+	StateSuspended = "SUSPENDED"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List and ListDetail requests.
+type ListOptsBuilder interface {
+	ToAuditListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// Goal filters the audits by goal UUID or name.
+	Goal string `q:"goal"`
+
+	// Strategy filters the audits by strategy UUID or name.
+	Strategy string `q:"strategy"`
+
+	// Limit limits the number of audits to return.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last audit of the previous page.
+	Marker string `q:"marker"`
+
+	// SortKey sorts the audits by the given attribute.
+	SortKey string `q:"sort_key"`
+
+	// SortDir is the sort direction, "asc" or "desc".
+	SortDir string `q:"sort_dir"`
+}
+
+// ToAuditListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAuditListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToAuditListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return AuditPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// audits. Only the main attributes of each audit are returned.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns a Pager which allows you to iterate over a collection
+// of audits with all their attributes.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+// Get retrieves a specific audit based on its UUID or name.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAuditCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new audit.
+type CreateOpts struct {
+	// Name is the name of the audit.
+	Name string `json:"name,omitempty"`
+
+	// AuditType is the type of the audit, e.g. AuditTypeOneShot or
+	// AuditTypeContinuous.
+	AuditType string `json:"audit_type" required:"true"`
+
+	// AuditTemplateUUID is the UUID of the audit template providing the
+	// goal and strategy of the audit.
+	AuditTemplateUUID string `json:"audit_template_uuid,omitempty"`
+
+	// Goal is the UUID or name of the optimization goal, if no audit
+	// template is used.
+	Goal string `json:"goal,omitempty"`
+
+	// Strategy is the UUID or name of the strategy achieving the goal.
+	Strategy string `json:"strategy,omitempty"`
+
+	// Parameters are the parameters of the strategy.
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+
+	// Interval is the time between two runs of a continuous audit, either
+	// a number of seconds or a cron expression.
+	Interval string `json:"interval,omitempty"`
+
+	// AutoTrigger starts the action plan of the audit as soon as it is
+	// recommended.
+	AutoTrigger bool `json:"auto_trigger,omitempty"`
+
+	// StartTime is the date a continuous audit starts at.
+	StartTime string `json:"start_time,omitempty"`
+
+	// EndTime is the date a continuous audit ends at.
+	EndTime string `json:"end_time,omitempty"`
+
+	// Force launches the audit even if the cluster is being changed by
+	// another action plan.
+	Force bool `json:"force,omitempty"`
+}
+
+// ToAuditCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToAuditCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new audit, which triggers an
+// optimization run.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAuditCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Patch represents a single JSON patch operation of an Update request.
+type Patch interface {
+	ToAuditUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a slice of Patches used to update an audit.
+type UpdateOpts []Patch
+
+// UpdateOp is the type of a JSON patch operation.
+type UpdateOp string
+
+const (
+	ReplaceOp UpdateOp = "replace"
+	AddOp     UpdateOp = "add"
+	RemoveOp  UpdateOp = "remove"
+)
+
+// UpdateOperation changes the attribute at Path of an audit, e.g. "/state"
+// to cancel or suspend a continuous audit.
+type UpdateOperation struct {
+	Op    UpdateOp    `json:"op" required:"true"`
+	Path  string      `json:"path" required:"true"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ToAuditUpdateMap constructs a JSON patch operation from an
+// UpdateOperation.
+func (opts UpdateOperation) ToAuditUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of an audit.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOpts) (r UpdateResult) {
+	body := make([]map[string]interface{}, len(opts))
+	for i, patch := range opts {
+		result, err := patch.ToAuditUpdateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+
+		body[i] = result
+	}
+	_, r.Err = client.Patch(updateURL(client, id), body, &r.Body, &gophercloud.RequestOpts{
+		JSONBody: &body,
+		OkCodes:  []int{200},
+	})
+	return
+}
+
+// Delete requests the deletion of an audit.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
@@ -0,0 +1,143 @@
+package audits
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Audit represents an optimization run of Watcher. A successful audit
+// recommends an action plan.
+type Audit struct {
+	// UUID is the unique ID of the audit.
+	UUID string `json:"uuid"`
+
+	// Name is the name of the audit.
+	Name string `json:"name"`
+
+	// AuditType is the type of the audit.
+	AuditType string `json:"audit_type"`
+
+	// State is the state of the audit, e.g. StateOngoing or StateSucceeded.
+	State string `json:"state"`
+
+	// GoalUUID is the UUID of the optimization goal.
+	GoalUUID string `json:"goal_uuid"`
+
+	// GoalName is the name of the optimization goal.
+	GoalName string `json:"goal_name"`
+
+	// StrategyUUID is the UUID of the strategy achieving the goal.
+	StrategyUUID string `json:"strategy_uuid"`
+
+	// StrategyName is the name of the strategy achieving the goal.
+	StrategyName string `json:"strategy_name"`
+
+	// Parameters are the parameters of the strategy.
+	Parameters map[string]interface{} `json:"parameters"`
+
+	// Interval is the time between two runs of a continuous audit.
+	Interval string `json:"interval"`
+
+	// Scope restricts the resources audited.
+	Scope []map[string]interface{} `json:"scope"`
+
+	// AutoTrigger indicates whether the action plan of the audit is started
+	// as soon as it is recommended.
+	AutoTrigger bool `json:"auto_trigger"`
+
+	// Force indicates whether the audit was launched even if the cluster
+	// was being changed.
+	Force bool `json:"force"`
+
+	// Hostname is the host running the audit.
+	Hostname string `json:"hostname"`
+
+	// Links contains referencing links to the audit.
+	Links []gophercloud.Link `json:"links"`
+
+	// NextRunTime is the date of the next run of a continuous audit.
+	NextRunTime time.Time `json:"next_run_time"`
+
+	// StartTime is the date a continuous audit starts at.
+	StartTime time.Time `json:"start_time"`
+
+	// EndTime is the date a continuous audit ends at.
+	EndTime time.Time `json:"end_time"`
+
+	// CreatedAt is the date the audit was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date the audit was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as an Audit.
+func (r commonResult) Extract() (*Audit, error) {
+	var s *Audit
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as an Audit.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as an Audit.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as an Audit.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AuditPage is a single page of Audit results.
+type AuditPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of audits has reached
+// the end of a page and the pager seeks to traverse over a new one.
+func (r AuditPage) NextPageURL() (string, error) {
+	var s struct {
+		Next string `json:"next"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Next, nil
+}
+
+// IsEmpty determines whether or not an AuditPage contains any results.
+func (r AuditPage) IsEmpty() (bool, error) {
+	audits, err := ExtractAudits(r)
+	return len(audits) == 0, err
+}
+
+// ExtractAudits returns a slice of Audits contained in a single page of
+// results.
+func ExtractAudits(r pagination.Page) ([]Audit, error) {
+	var s struct {
+		Audits []Audit `json:"audits"`
+	}
+	err := (r.(AuditPage)).ExtractInto(&s)
+	return s.Audits, err
+}
@@ -0,0 +1,2 @@
+// infraoptim_audits_v1
+package testing
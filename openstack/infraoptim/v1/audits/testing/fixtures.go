@@ -0,0 +1,189 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/audits"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const AuditUUID = "867c6226-26ed-4a56-a9e3-2e8ce6f1b1cb"
+
+// AuditBody is the JSON representation of a single audit.
+const AuditBody = `
+{
+  "uuid": "867c6226-26ed-4a56-a9e3-2e8ce6f1b1cb",
+  "name": "consolidation-2018-04-10",
+  "audit_type": "ONESHOT",
+  "state": "SUCCEEDED",
+  "goal_uuid": "4690f0b9-95c0-4a6f-8f3a-36d3e0a7c5d2",
+  "goal_name": "server_consolidation",
+  "strategy_uuid": "c5b2c0c5-0d3c-4fa1-a1b6-2c4b9e2b5f63",
+  "strategy_name": "vm_workload_consolidation",
+  "parameters": {
+    "period": 3600
+  },
+  "interval": null,
+  "scope": [],
+  "auto_trigger": false,
+  "force": false,
+  "hostname": "watcher-01",
+  "links": [
+    {
+      "href": "http://watcher:9322/v1/audits/867c6226-26ed-4a56-a9e3-2e8ce6f1b1cb",
+      "rel": "self"
+    }
+  ],
+  "next_run_time": null,
+  "start_time": null,
+  "end_time": null,
+  "created_at": "2018-04-10T12:03:11.452311+00:00",
+  "updated_at": "2018-04-10T12:03:17.129546+00:00",
+  "deleted_at": null
+}
+`
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`
+{
+  "audits": [%s],
+  "next": ""
+}
+`, AuditBody)
+
+// CreateRequest is a sample request to create an audit.
+const CreateRequest = `
+{
+  "name": "consolidation-2018-04-10",
+  "audit_type": "ONESHOT",
+  "audit_template_uuid": "cd9b9f8a-2bc6-4ed5-9b4d-1d1c7e3e8d5a",
+  "parameters": {
+    "period": 3600
+  }
+}
+`
+
+// UpdateRequest is a sample request to update an audit.
+const UpdateRequest = `
+[
+  {
+    "op": "replace",
+    "path": "/state",
+    "value": "CANCELLED"
+  }
+]
+`
+
+var (
+	createdAt, _ = time.Parse(time.RFC3339, "2018-04-10T12:03:11.452311+00:00")
+	updatedAt, _ = time.Parse(time.RFC3339, "2018-04-10T12:03:17.129546+00:00")
+)
+
+// FirstAudit is the audit described by AuditBody.
+var FirstAudit = audits.Audit{
+	UUID:         AuditUUID,
+	Name:         "consolidation-2018-04-10",
+	AuditType:    audits.AuditTypeOneShot,
+	State:        audits.StateSucceeded,
+	GoalUUID:     "4690f0b9-95c0-4a6f-8f3a-36d3e0a7c5d2",
+	GoalName:     "server_consolidation",
+	StrategyUUID: "c5b2c0c5-0d3c-4fa1-a1b6-2c4b9e2b5f63",
+	StrategyName: "vm_workload_consolidation",
+	Parameters: map[string]interface{}{
+		"period": float64(3600),
+	},
+	Scope:    []map[string]interface{}{},
+	Hostname: "watcher-01",
+	Links: []gophercloud.Link{
+		{
+			Href: "http://watcher:9322/v1/audits/867c6226-26ed-4a56-a9e3-2e8ce6f1b1cb",
+			Rel:  "self",
+		},
+	},
+	CreatedAt: createdAt,
+	UpdatedAt: updatedAt,
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleListDetailSuccessfully configures the test server to respond to a
+// ListDetail request.
+func HandleListDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audits/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"sort_key": "created_at", "sort_dir": "desc"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audits/"+AuditUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, AuditBody)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, AuditBody)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audits/"+AuditUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, AuditBody)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audits/"+AuditUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,109 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/audits"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListAudits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := audits.List(fake.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := audits.ExtractAudits(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []audits.Audit{FirstAudit}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListAuditsDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDetailSuccessfully(t)
+
+	listOpts := audits.ListOpts{
+		SortKey: "created_at",
+		SortDir: "desc",
+	}
+
+	allPages, err := audits.ListDetail(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := audits.ExtractAudits(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []audits.Audit{FirstAudit}, actual)
+}
+
+func TestGetAudit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := audits.Get(fake.ServiceClient(), AuditUUID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAudit, actual)
+}
+
+func TestCreateAudit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := audits.CreateOpts{
+		Name:              "consolidation-2018-04-10",
+		AuditType:         audits.AuditTypeOneShot,
+		AuditTemplateUUID: "cd9b9f8a-2bc6-4ed5-9b4d-1d1c7e3e8d5a",
+		Parameters: map[string]interface{}{
+			"period": 3600,
+		},
+	}
+
+	actual, err := audits.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAudit, actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	_, err := audits.CreateOpts{Goal: "server_consolidation"}.ToAuditCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdateAudit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := audits.UpdateOpts{
+		audits.UpdateOperation{
+			Op:    audits.ReplaceOp,
+			Path:  "/state",
+			Value: audits.StateCancelled,
+		},
+	}
+
+	actual, err := audits.Update(fake.ServiceClient(), AuditUUID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAudit, actual)
+}
+
+func TestDeleteAudit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := audits.Delete(fake.ServiceClient(), AuditUUID)
+	th.AssertNoErr(t, res.Err)
+}
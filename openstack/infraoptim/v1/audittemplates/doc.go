@@ -0,0 +1,57 @@
+/*
+Package audittemplates manages the audit templates of the OpenStack
+Infrastructure Optimization service (Watcher). An audit template stores the
+goal, strategy and scope of audits.
+
+Example to List Audit Templates
+
+	allPages, err := audittemplates.ListDetail(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAuditTemplates, err := audittemplates.ExtractAuditTemplates(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, auditTemplate := range allAuditTemplates {
+		fmt.Printf("%+v\n", auditTemplate)
+	}
+
+Example to Create an Audit Template
+
+	createOpts := audittemplates.CreateOpts{
+		Name:     "consolidation",
+		Goal:     "server_consolidation",
+		Strategy: "vm_workload_consolidation",
+	}
+
+	auditTemplate, err := audittemplates.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Audit Template
+
+	updateOpts := audittemplates.UpdateOpts{
+		audittemplates.UpdateOperation{
+			Op:    audittemplates.ReplaceOp,
+			Path:  "/description",
+			Value: "Nightly consolidation",
+		},
+	}
+
+	auditTemplate, err := audittemplates.Update(client, auditTemplateUUID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Audit Template
+
+	err := audittemplates.Delete(client, auditTemplateUUID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package audittemplates
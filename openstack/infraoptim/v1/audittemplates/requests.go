@@ -0,0 +1,172 @@
+package audittemplates
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List and ListDetail requests.
+type ListOptsBuilder interface {
+	ToAuditTemplateListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// Goal filters the audit templates by goal UUID or name.
+	Goal string `q:"goal"`
+
+	// Strategy filters the audit templates by strategy UUID or name.
+	Strategy string `q:"strategy"`
+
+	// Limit limits the number of audit templates to return.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last audit template of the previous page.
+	Marker string `q:"marker"`
+
+	// SortKey sorts the audit templates by the given attribute.
+	SortKey string `q:"sort_key"`
+
+	// SortDir is the sort direction, "asc" or "desc".
+	SortDir string `q:"sort_dir"`
+}
+
+// ToAuditTemplateListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAuditTemplateListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToAuditTemplateListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return AuditTemplatePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// audit templates. Only the main attributes of each template are returned.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns a Pager which allows you to iterate over a collection
+// of audit templates with all their attributes.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+// Get retrieves a specific audit template based on its UUID or name.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAuditTemplateCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new audit template.
+type CreateOpts struct {
+	// Name is the name of the audit template.
+	Name string `json:"name" required:"true"`
+
+	// Description is a description of the audit template.
+	Description string `json:"description,omitempty"`
+
+	// Goal is the UUID or name of the optimization goal, e.g.
+	// "server_consolidation".
+	Goal string `json:"goal" required:"true"`
+
+	// Strategy is the UUID or name of the strategy achieving the goal. If
+	// it is not set, Watcher selects one.
+	Strategy string `json:"strategy,omitempty"`
+
+	// Scope restricts the resources audited, e.g. to availability zones
+	// or host aggregates.
+	Scope []map[string]interface{} `json:"scope,omitempty"`
+}
+
+// ToAuditTemplateCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToAuditTemplateCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new audit template.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAuditTemplateCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Patch represents a single JSON patch operation of an Update request.
+type Patch interface {
+	ToAuditTemplateUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a slice of Patches used to update an audit template.
+type UpdateOpts []Patch
+
+// UpdateOp is the type of a JSON patch operation.
+type UpdateOp string
+
+const (
+	ReplaceOp UpdateOp = "replace"
+	AddOp     UpdateOp = "add"
+	RemoveOp  UpdateOp = "remove"
+)
+
+// UpdateOperation changes the attribute at Path of an audit template, e.g.
+// "/name" or "/description".
+type UpdateOperation struct {
+	Op    UpdateOp    `json:"op" required:"true"`
+	Path  string      `json:"path" required:"true"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ToAuditTemplateUpdateMap constructs a JSON patch operation from an
+// UpdateOperation.
+func (opts UpdateOperation) ToAuditTemplateUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of an audit template.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOpts) (r UpdateResult) {
+	body := make([]map[string]interface{}, len(opts))
+	for i, patch := range opts {
+		result, err := patch.ToAuditTemplateUpdateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+
+		body[i] = result
+	}
+	_, r.Err = client.Patch(updateURL(client, id), body, &r.Body, &gophercloud.RequestOpts{
+		JSONBody: &body,
+		OkCodes:  []int{200},
+	})
+	return
+}
+
+// Delete requests the deletion of an audit template.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
@@ -0,0 +1,114 @@
+package audittemplates
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// AuditTemplate represents a reusable set of audit parameters.
+type AuditTemplate struct {
+	// UUID is the unique ID of the audit template.
+	UUID string `json:"uuid"`
+
+	// Name is the name of the audit template.
+	Name string `json:"name"`
+
+	// Description is a description of the audit template.
+	Description string `json:"description"`
+
+	// GoalUUID is the UUID of the optimization goal.
+	GoalUUID string `json:"goal_uuid"`
+
+	// GoalName is the name of the optimization goal.
+	GoalName string `json:"goal_name"`
+
+	// StrategyUUID is the UUID of the strategy achieving the goal.
+	StrategyUUID string `json:"strategy_uuid"`
+
+	// StrategyName is the name of the strategy achieving the goal.
+	StrategyName string `json:"strategy_name"`
+
+	// Scope restricts the resources audited.
+	Scope []map[string]interface{} `json:"scope"`
+
+	// Links contains referencing links to the audit template.
+	Links []gophercloud.Link `json:"links"`
+
+	// CreatedAt is the date the audit template was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the date the audit template was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as an AuditTemplate.
+func (r commonResult) Extract() (*AuditTemplate, error) {
+	var s *AuditTemplate
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as an AuditTemplate.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as an AuditTemplate.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as an AuditTemplate.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AuditTemplatePage is a single page of AuditTemplate results.
+type AuditTemplatePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of audit templates has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (r AuditTemplatePage) NextPageURL() (string, error) {
+	var s struct {
+		Next string `json:"next"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Next, nil
+}
+
+// IsEmpty determines whether or not an AuditTemplatePage contains any
+// results.
+func (r AuditTemplatePage) IsEmpty() (bool, error) {
+	auditTemplates, err := ExtractAuditTemplates(r)
+	return len(auditTemplates) == 0, err
+}
+
+// ExtractAuditTemplates returns a slice of AuditTemplates contained in a
+// single page of results.
+func ExtractAuditTemplates(r pagination.Page) ([]AuditTemplate, error) {
+	var s struct {
+		AuditTemplates []AuditTemplate `json:"audit_templates"`
+	}
+	err := (r.(AuditTemplatePage)).ExtractInto(&s)
+	return s.AuditTemplates, err
+}
@@ -0,0 +1,2 @@
+// infraoptim_audittemplates_v1
+package testing
@@ -0,0 +1,184 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/audittemplates"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const AuditTemplateUUID = "cd9b9f8a-2bc6-4ed5-9b4d-1d1c7e3e8d5a"
+
+// AuditTemplateBody is the JSON representation of a single audit template.
+const AuditTemplateBody = `
+{
+  "uuid": "cd9b9f8a-2bc6-4ed5-9b4d-1d1c7e3e8d5a",
+  "name": "consolidation",
+  "description": "Nightly consolidation",
+  "goal_uuid": "4690f0b9-95c0-4a6f-8f3a-36d3e0a7c5d2",
+  "goal_name": "server_consolidation",
+  "strategy_uuid": "c5b2c0c5-0d3c-4fa1-a1b6-2c4b9e2b5f63",
+  "strategy_name": "vm_workload_consolidation",
+  "scope": [
+    {
+      "availability_zones": [
+        {"name": "nova"}
+      ]
+    }
+  ],
+  "links": [
+    {
+      "href": "http://watcher:9322/v1/audit_templates/cd9b9f8a-2bc6-4ed5-9b4d-1d1c7e3e8d5a",
+      "rel": "self"
+    }
+  ],
+  "created_at": "2018-04-10T11:59:52.640067+00:00",
+  "updated_at": null,
+  "deleted_at": null
+}
+`
+
+// GetResponse is a sample response to a Get call.
+const GetResponse = AuditTemplateBody
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`{"audit_templates": [%s]}`, AuditTemplateBody)
+
+// CreateRequest is a sample request to create an audit template.
+const CreateRequest = `
+{
+  "name": "consolidation",
+  "description": "Nightly consolidation",
+  "goal": "server_consolidation",
+  "strategy": "vm_workload_consolidation",
+  "scope": [
+    {
+      "availability_zones": [
+        {"name": "nova"}
+      ]
+    }
+  ]
+}
+`
+
+// UpdateRequest is a sample request to update an audit template.
+const UpdateRequest = `
+[
+  {
+    "op": "replace",
+    "path": "/description",
+    "value": "Nightly consolidation"
+  }
+]
+`
+
+var createdAt, _ = time.Parse(time.RFC3339, "2018-04-10T11:59:52.640067+00:00")
+
+// FirstAuditTemplate is the audit template described by AuditTemplateBody.
+var FirstAuditTemplate = audittemplates.AuditTemplate{
+	UUID:         AuditTemplateUUID,
+	Name:         "consolidation",
+	Description:  "Nightly consolidation",
+	GoalUUID:     "4690f0b9-95c0-4a6f-8f3a-36d3e0a7c5d2",
+	GoalName:     "server_consolidation",
+	StrategyUUID: "c5b2c0c5-0d3c-4fa1-a1b6-2c4b9e2b5f63",
+	StrategyName: "vm_workload_consolidation",
+	Scope: []map[string]interface{}{
+		{
+			"availability_zones": []interface{}{
+				map[string]interface{}{"name": "nova"},
+			},
+		},
+	},
+	Links: []gophercloud.Link{
+		{
+			Href: "http://watcher:9322/v1/audit_templates/cd9b9f8a-2bc6-4ed5-9b4d-1d1c7e3e8d5a",
+			Rel:  "self",
+		},
+	},
+	CreatedAt: createdAt,
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audit_templates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"goal": "server_consolidation"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleListDetailSuccessfully configures the test server to respond to a
+// ListDetail request.
+func HandleListDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audit_templates/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audit_templates/"+AuditTemplateUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audit_templates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audit_templates/"+AuditTemplateUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/audit_templates/"+AuditTemplateUUID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
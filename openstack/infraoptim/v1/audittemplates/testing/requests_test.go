@@ -0,0 +1,113 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/infraoptim/v1/audittemplates"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListAuditTemplates(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := audittemplates.ListOpts{
+		Goal: "server_consolidation",
+	}
+
+	count := 0
+	err := audittemplates.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := audittemplates.ExtractAuditTemplates(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []audittemplates.AuditTemplate{FirstAuditTemplate}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListAuditTemplatesDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDetailSuccessfully(t)
+
+	allPages, err := audittemplates.ListDetail(fake.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := audittemplates.ExtractAuditTemplates(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []audittemplates.AuditTemplate{FirstAuditTemplate}, actual)
+}
+
+func TestGetAuditTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := audittemplates.Get(fake.ServiceClient(), AuditTemplateUUID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAuditTemplate, actual)
+}
+
+func TestCreateAuditTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := audittemplates.CreateOpts{
+		Name:        "consolidation",
+		Description: "Nightly consolidation",
+		Goal:        "server_consolidation",
+		Strategy:    "vm_workload_consolidation",
+		Scope: []map[string]interface{}{
+			{
+				"availability_zones": []map[string]string{
+					{"name": "nova"},
+				},
+			},
+		},
+	}
+
+	actual, err := audittemplates.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAuditTemplate, actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	_, err := audittemplates.CreateOpts{Name: "consolidation"}.ToAuditTemplateCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdateAuditTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := audittemplates.UpdateOpts{
+		audittemplates.UpdateOperation{
+			Op:    audittemplates.ReplaceOp,
+			Path:  "/description",
+			Value: "Nightly consolidation",
+		},
+	}
+
+	actual, err := audittemplates.Update(fake.ServiceClient(), AuditTemplateUUID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAuditTemplate, actual)
+}
+
+func TestDeleteAuditTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := audittemplates.Delete(fake.ServiceClient(), AuditTemplateUUID)
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,53 @@
+/*
+Package hosts manages the hosts of failover segments of the OpenStack
+Instance HA service (Masakari).
+
+Example to List the Hosts of a Segment
+
+	allPages, err := hosts.List(client, segmentID, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allHosts, err := hosts.ExtractHosts(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, host := range allHosts {
+		fmt.Printf("%+v\n", host)
+	}
+
+Example to Add a Host to a Segment
+
+	createOpts := hosts.CreateOpts{
+		Name:              "compute-1",
+		Type:              "COMPUTE",
+		ControlAttributes: "SSH",
+	}
+
+	host, err := hosts.Create(client, segmentID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Put a Host in Maintenance
+
+	onMaintenance := true
+	updateOpts := hosts.UpdateOpts{
+		OnMaintenance: &onMaintenance,
+	}
+
+	host, err := hosts.Update(client, segmentID, hostID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove a Host from a Segment
+
+	err := hosts.Delete(client, segmentID, hostID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package hosts
@@ -0,0 +1,159 @@
+package hosts
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToHostListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// Type filters the hosts by type.
+	Type string `q:"type"`
+
+	// ControlAttributes filters the hosts by control attributes.
+	ControlAttributes string `q:"control_attributes"`
+
+	// OnMaintenance filters the hosts by their maintenance state.
+	OnMaintenance *bool `q:"on_maintenance"`
+
+	// Reserved filters the hosts by their reserved state.
+	Reserved *bool `q:"reserved"`
+
+	// Limit limits the number of hosts to return.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last host of the previous page.
+	Marker string `q:"marker"`
+
+	// SortKey sorts the hosts by the given attribute.
+	SortKey string `q:"sort_key"`
+
+	// SortDir sets the sort direction, "asc" or "desc".
+	SortDir string `q:"sort_dir"`
+}
+
+// ToHostListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToHostListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the hosts of a
+// failover segment.
+func List(client *gophercloud.ServiceClient, segmentID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client, segmentID)
+	if opts != nil {
+		query, err := opts.ToHostListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return HostPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific host of a failover segment.
+func Get(client *gophercloud.ServiceClient, segmentID, hostID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, segmentID, hostID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToHostCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new host of a failover segment.
+type CreateOpts struct {
+	// Name is the hostname of the host, as known by the compute service.
+	Name string `json:"name" required:"true"`
+
+	// Type is the type of the host, e.g. "COMPUTE".
+	Type string `json:"type" required:"true"`
+
+	// ControlAttributes are the attributes used to control the host,
+	// e.g. "SSH".
+	ControlAttributes string `json:"control_attributes" required:"true"`
+
+	// Reserved marks the host as a reserved host used for recovery.
+	Reserved *bool `json:"reserved,omitempty"`
+
+	// OnMaintenance puts the host in maintenance.
+	OnMaintenance *bool `json:"on_maintenance,omitempty"`
+}
+
+// ToHostCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToHostCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "host")
+}
+
+// Create adds a host to a failover segment.
+func Create(client *gophercloud.ServiceClient, segmentID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToHostCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, segmentID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToHostUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters to update a host of a failover segment.
+type UpdateOpts struct {
+	// Name is the hostname of the host.
+	Name string `json:"name,omitempty"`
+
+	// Type is the type of the host.
+	Type string `json:"type,omitempty"`
+
+	// ControlAttributes are the attributes used to control the host.
+	ControlAttributes string `json:"control_attributes,omitempty"`
+
+	// Reserved marks the host as a reserved host used for recovery.
+	Reserved *bool `json:"reserved,omitempty"`
+
+	// OnMaintenance puts the host in or out of maintenance.
+	OnMaintenance *bool `json:"on_maintenance,omitempty"`
+}
+
+// ToHostUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToHostUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "host")
+}
+
+// Update requests the update of a host of a failover segment.
+func Update(client *gophercloud.ServiceClient, segmentID, hostID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToHostUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, segmentID, hostID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete removes a host from a failover segment.
+func Delete(client *gophercloud.ServiceClient, segmentID, hostID string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, segmentID, hostID), nil)
+	return
+}
@@ -0,0 +1,152 @@
+package hosts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/instanceha/v1/segments"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Host represents a host of a failover segment.
+type Host struct {
+	// ID is the internal ID of the host.
+	ID int `json:"id"`
+
+	// UUID is the unique ID of the host.
+	UUID string `json:"uuid"`
+
+	// Name is the hostname of the host.
+	Name string `json:"name"`
+
+	// Type is the type of the host.
+	Type string `json:"type"`
+
+	// ControlAttributes are the attributes used to control the host.
+	ControlAttributes string `json:"control_attributes"`
+
+	// Reserved indicates whether the host is a reserved host.
+	Reserved bool `json:"reserved"`
+
+	// OnMaintenance indicates whether the host is in maintenance.
+	OnMaintenance bool `json:"on_maintenance"`
+
+	// FailoverSegmentID is the UUID of the segment of the host.
+	FailoverSegmentID string `json:"failover_segment_id"`
+
+	// FailoverSegment is the segment of the host.
+	FailoverSegment segments.Segment `json:"failover_segment"`
+
+	// Deleted indicates whether the host was deleted.
+	Deleted bool `json:"deleted"`
+
+	// CreatedAt is the date the host was added.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the host was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+func (r *Host) UnmarshalJSON(b []byte) error {
+	type tmp Host
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Host(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Host.
+func (r commonResult) Extract() (*Host, error) {
+	var s struct {
+		Host *Host `json:"host"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Host, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Host.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Host.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Host.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// HostPage is a single page of Host results.
+type HostPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to
+// the next page of results.
+func (r HostPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"hosts_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r HostPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"hosts_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
+// IsEmpty determines whether or not a HostPage contains any results.
+func (r HostPage) IsEmpty() (bool, error) {
+	hosts, err := ExtractHosts(r)
+	return len(hosts) == 0, err
+}
+
+// ExtractHosts returns a slice of Hosts contained in a single page of
+// results.
+func ExtractHosts(r pagination.Page) ([]Host, error) {
+	var s struct {
+		Hosts []Host `json:"hosts"`
+	}
+	err := (r.(HostPage)).ExtractInto(&s)
+	return s.Hosts, err
+}
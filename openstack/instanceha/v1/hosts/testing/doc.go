@@ -0,0 +1,2 @@
+// instanceha_hosts_v1
+package testing
@@ -0,0 +1,159 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/instanceha/v1/hosts"
+	"github.com/gophercloud/gophercloud/openstack/instanceha/v1/segments"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const (
+	SegmentID = "9e800031-6946-4b43-bf09-8b3d1cab792b"
+	HostID    = "083a8474-22c0-407f-b89b-c569134c3bfd"
+)
+
+// HostBody is the JSON representation of a single host.
+const HostBody = `
+{
+  "id": 1,
+  "uuid": "083a8474-22c0-407f-b89b-c569134c3bfd",
+  "name": "compute-1",
+  "type": "COMPUTE",
+  "control_attributes": "SSH",
+  "reserved": false,
+  "on_maintenance": false,
+  "failover_segment_id": "9e800031-6946-4b43-bf09-8b3d1cab792b",
+  "failover_segment": {
+    "id": 1,
+    "uuid": "9e800031-6946-4b43-bf09-8b3d1cab792b",
+    "name": "segment1",
+    "description": null,
+    "recovery_method": "auto",
+    "service_type": "compute",
+    "deleted": false,
+    "created_at": "2018-03-21T09:42:44.000000",
+    "updated_at": null,
+    "deleted_at": null
+  },
+  "deleted": false,
+  "created_at": "2018-03-21T09:43:09.000000",
+  "updated_at": null,
+  "deleted_at": null
+}
+`
+
+// GetResponse is a sample response to a Get call.
+var GetResponse = fmt.Sprintf(`{"host": %s}`, HostBody)
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`{"hosts": [%s]}`, HostBody)
+
+// CreateRequest is a sample request to add a host.
+const CreateRequest = `
+{
+  "host": {
+    "name": "compute-1",
+    "type": "COMPUTE",
+    "control_attributes": "SSH"
+  }
+}
+`
+
+// UpdateRequest is a sample request to update a host.
+const UpdateRequest = `
+{
+  "host": {
+    "on_maintenance": false
+  }
+}
+`
+
+// FirstHost is the host described by HostBody.
+var FirstHost = hosts.Host{
+	ID:                1,
+	UUID:              HostID,
+	Name:              "compute-1",
+	Type:              "COMPUTE",
+	ControlAttributes: "SSH",
+	FailoverSegmentID: SegmentID,
+	FailoverSegment: segments.Segment{
+		ID:             1,
+		UUID:           SegmentID,
+		Name:           "segment1",
+		RecoveryMethod: "auto",
+		ServiceType:    "compute",
+		CreatedAt:      time.Date(2018, 3, 21, 9, 42, 44, 0, time.UTC),
+	},
+	CreatedAt: time.Date(2018, 3, 21, 9, 43, 9, 0, time.UTC),
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/segments/"+SegmentID+"/hosts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"reserved": "false"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/segments/"+SegmentID+"/hosts/"+HostID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/segments/"+SegmentID+"/hosts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/segments/"+SegmentID+"/hosts/"+HostID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/segments/"+SegmentID+"/hosts/"+HostID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
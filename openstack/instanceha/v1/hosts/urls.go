@@ -0,0 +1,31 @@
+package hosts
+
+import "github.com/gophercloud/gophercloud"
+
+func rootURL(c *gophercloud.ServiceClient, segmentID string) string {
+	return c.ServiceURL("segments", segmentID, "hosts")
+}
+
+func resourceURL(c *gophercloud.ServiceClient, segmentID, hostID string) string {
+	return c.ServiceURL("segments", segmentID, "hosts", hostID)
+}
+
+func listURL(c *gophercloud.ServiceClient, segmentID string) string {
+	return rootURL(c, segmentID)
+}
+
+func createURL(c *gophercloud.ServiceClient, segmentID string) string {
+	return rootURL(c, segmentID)
+}
+
+func getURL(c *gophercloud.ServiceClient, segmentID, hostID string) string {
+	return resourceURL(c, segmentID, hostID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, segmentID, hostID string) string {
+	return resourceURL(c, segmentID, hostID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, segmentID, hostID string) string {
+	return resourceURL(c, segmentID, hostID)
+}
@@ -0,0 +1,58 @@
+/*
+Package notifications manages the failure notifications of the OpenStack
+Instance HA service (Masakari). Notifications are sent by monitors when an
+instance, a process or a compute host fails, and trigger its recovery.
+
+Example to List Notifications
+
+	since := time.Now().Add(-24 * time.Hour)
+	listOpts := notifications.ListOpts{
+		GeneratedSince: &since,
+	}
+
+	allPages, err := notifications.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allNotifications, err := notifications.ExtractNotifications(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, notification := range allNotifications {
+		fmt.Printf("%+v\n", notification)
+	}
+
+Example to Get a Notification with its Recovery Workflow Details
+
+	client.Microversion = "1.1"
+
+	notification, err := notifications.Get(client, notificationID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, detail := range notification.RecoveryWorkflowDetails {
+		fmt.Printf("%s: %s\n", detail.Name, detail.State)
+	}
+
+Example to Create a Notification
+
+	createOpts := notifications.CreateOpts{
+		Type:          notifications.TypeComputeHost,
+		Hostname:      "compute-1",
+		GeneratedTime: time.Now().UTC(),
+		Payload: map[string]interface{}{
+			"event":          "STOPPED",
+			"host_status":    "NORMAL",
+			"cluster_status": "OFFLINE",
+		},
+	}
+
+	notification, err := notifications.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package notifications
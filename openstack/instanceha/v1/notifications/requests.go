@@ -0,0 +1,147 @@
+package notifications
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Types of notifications.
+const (
+	TypeVM          = "VM"
+	TypeProcess     = "PROCESS"
+	TypeComputeHost = "COMPUTE_HOST"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToNotificationListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// SourceHostUUID filters the notifications by the UUID of the host
+	// which sent them.
+	SourceHostUUID string `q:"source_host_uuid"`
+
+	// Type filters the notifications by type.
+	Type string `q:"type"`
+
+	// Status filters the notifications by status.
+	Status string `q:"status"`
+
+	// GeneratedSince filters the notifications generated after the given
+	// time.
+	GeneratedSince *time.Time
+
+	// Limit limits the number of notifications to return.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last notification of the previous page.
+	Marker string `q:"marker"`
+
+	// SortKey sorts the notifications by the given attribute.
+	SortKey string `q:"sort_key"`
+
+	// SortDir sets the sort direction, "asc" or "desc".
+	SortDir string `q:"sort_dir"`
+}
+
+// ToNotificationListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToNotificationListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+
+	if opts.GeneratedSince != nil {
+		params.Add("generated-since", opts.GeneratedSince.Format(gophercloud.RFC3339NoZ))
+	}
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// notifications.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToNotificationListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return NotificationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific notification based on its UUID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToNotificationCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new notification.
+type CreateOpts struct {
+	// Type is the type of the notification.
+	Type string `json:"type" required:"true"`
+
+	// Hostname is the name of the host the notification is about.
+	Hostname string `json:"hostname" required:"true"`
+
+	// GeneratedTime is the time the failure was detected.
+	GeneratedTime time.Time `json:"-"`
+
+	// Payload describes the failure.
+	Payload map[string]interface{} `json:"payload" required:"true"`
+}
+
+// ToNotificationCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToNotificationCreateMap() (map[string]interface{}, error) {
+	if opts.GeneratedTime.IsZero() {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "notifications.CreateOpts.GeneratedTime"
+		return nil, err
+	}
+
+	parent := "notification"
+
+	b, err := gophercloud.BuildRequestBody(opts, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := b[parent].(map[string]interface{}); ok {
+		v["generated_time"] = opts.GeneratedTime.Format(gophercloud.RFC3339NoZ)
+	}
+
+	return b, nil
+}
+
+// Create sends a notification about a failure to the service.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToNotificationCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,166 @@
+package notifications
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Notification represents a failure notification handled by the service.
+type Notification struct {
+	// ID is the internal ID of the notification.
+	ID int `json:"id"`
+
+	// NotificationUUID is the unique ID of the notification.
+	NotificationUUID string `json:"notification_uuid"`
+
+	// Type is the type of the notification.
+	Type string `json:"type"`
+
+	// SourceHostUUID is the UUID of the host which sent the notification.
+	SourceHostUUID string `json:"source_host_uuid"`
+
+	// Payload describes the failure.
+	Payload map[string]interface{} `json:"payload"`
+
+	// Status is the recovery status, e.g. "new", "running" or "finished".
+	Status string `json:"status"`
+
+	// GeneratedTime is the time the failure was detected.
+	GeneratedTime time.Time `json:"-"`
+
+	// RecoveryWorkflowDetails describes the progress of the recovery.
+	// Only returned by Get with microversion 1.1 or above.
+	RecoveryWorkflowDetails []RecoveryWorkflowDetail `json:"recovery_workflow_details"`
+
+	// Deleted indicates whether the notification was deleted.
+	Deleted bool `json:"deleted"`
+
+	// CreatedAt is the date the notification was received.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the notification was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// RecoveryWorkflowDetail describes a task of the recovery workflow.
+type RecoveryWorkflowDetail struct {
+	// Name is the name of the task.
+	Name string `json:"name"`
+
+	// State is the state of the task.
+	State string `json:"state"`
+
+	// Progress is the progress of the task, between 0 and 1.
+	Progress float64 `json:"progress"`
+
+	// ProgressDetails are the messages logged by the task.
+	ProgressDetails []ProgressDetail `json:"progress_details"`
+}
+
+// ProgressDetail is a message logged by a recovery task.
+type ProgressDetail struct {
+	// Timestamp is the time the message was logged.
+	Timestamp string `json:"timestamp"`
+
+	// Message is the logged message.
+	Message string `json:"message"`
+
+	// Progress is the progress of the task when the message was logged.
+	Progress float64 `json:"progress"`
+}
+
+func (r *Notification) UnmarshalJSON(b []byte) error {
+	type tmp Notification
+	var s struct {
+		tmp
+		GeneratedTime gophercloud.JSONRFC3339MilliNoZ `json:"generated_time"`
+		CreatedAt     gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt     gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Notification(s.tmp)
+
+	r.GeneratedTime = time.Time(s.GeneratedTime)
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Notification.
+func (r commonResult) Extract() (*Notification, error) {
+	var s struct {
+		Notification *Notification `json:"notification"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Notification, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Notification.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Notification.
+type CreateResult struct {
+	commonResult
+}
+
+// NotificationPage is a single page of Notification results.
+type NotificationPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to
+// the next page of results.
+func (r NotificationPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"notifications_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r NotificationPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"notifications_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
+// IsEmpty determines whether or not a NotificationPage contains any results.
+func (r NotificationPage) IsEmpty() (bool, error) {
+	notifications, err := ExtractNotifications(r)
+	return len(notifications) == 0, err
+}
+
+// ExtractNotifications returns a slice of Notifications contained in a
+// single page of results.
+func ExtractNotifications(r pagination.Page) ([]Notification, error) {
+	var s struct {
+		Notifications []Notification `json:"notifications"`
+	}
+	err := (r.(NotificationPage)).ExtractInto(&s)
+	return s.Notifications, err
+}
@@ -0,0 +1,2 @@
+// instanceha_notifications_v1
+package testing
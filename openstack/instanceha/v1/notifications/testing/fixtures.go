@@ -0,0 +1,174 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/instanceha/v1/notifications"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const NotificationID = "07a331b8-df15-4582-b121-73ed3541a408"
+
+// NotificationBody is the JSON representation of a single notification.
+const NotificationBody = `
+{
+  "id": 13,
+  "notification_uuid": "07a331b8-df15-4582-b121-73ed3541a408",
+  "type": "VM",
+  "source_host_uuid": "b5bc49be-ea6f-472d-9240-968f75d7a16a",
+  "payload": {
+    "event": "LIFECYCLE",
+    "instance_uuid": "99b99a6b-1fe1-4ad1-8f8b-7b4a7ca7ea4b",
+    "vir_domain_event": "STOPPED_FAILED"
+  },
+  "status": "running",
+  "generated_time": "2017-06-13T15:34:55.000000",
+  "deleted": false,
+  "created_at": "2017-06-13T15:34:55.000000",
+  "updated_at": "2017-06-13T15:34:56.000000",
+  "deleted_at": null
+}
+`
+
+// NotificationDetailsBody is the JSON representation of a single
+// notification with its recovery workflow details.
+const NotificationDetailsBody = `
+{
+  "id": 13,
+  "notification_uuid": "07a331b8-df15-4582-b121-73ed3541a408",
+  "type": "VM",
+  "source_host_uuid": "b5bc49be-ea6f-472d-9240-968f75d7a16a",
+  "payload": {
+    "event": "LIFECYCLE",
+    "instance_uuid": "99b99a6b-1fe1-4ad1-8f8b-7b4a7ca7ea4b",
+    "vir_domain_event": "STOPPED_FAILED"
+  },
+  "status": "running",
+  "generated_time": "2017-06-13T15:34:55.000000",
+  "deleted": false,
+  "created_at": "2017-06-13T15:34:55.000000",
+  "updated_at": "2017-06-13T15:34:56.000000",
+  "deleted_at": null,
+  "recovery_workflow_details": [
+    {
+      "name": "StopInstanceTask",
+      "state": "SUCCESS",
+      "progress": 1.0,
+      "progress_details": [
+        {
+          "timestamp": "2019-03-07 13:54:28.842031",
+          "message": "Stopping instance: 99b99a6b-1fe1-4ad1-8f8b-7b4a7ca7ea4b",
+          "progress": 0.0
+        }
+      ]
+    }
+  ]
+}
+`
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`{"notifications": [%s]}`, NotificationBody)
+
+// CreateResponse is a sample response to a Create call.
+var CreateResponse = fmt.Sprintf(`{"notification": %s}`, NotificationBody)
+
+// GetResponse is a sample response to a Get call.
+var GetResponse = fmt.Sprintf(`{"notification": %s}`, NotificationDetailsBody)
+
+// CreateRequest is a sample request to create a notification.
+const CreateRequest = `
+{
+  "notification": {
+    "type": "VM",
+    "hostname": "compute-1",
+    "generated_time": "2017-06-13T15:34:55",
+    "payload": {
+      "event": "LIFECYCLE",
+      "instance_uuid": "99b99a6b-1fe1-4ad1-8f8b-7b4a7ca7ea4b",
+      "vir_domain_event": "STOPPED_FAILED"
+    }
+  }
+}
+`
+
+// FirstNotification is the notification described by NotificationBody.
+var FirstNotification = notifications.Notification{
+	ID:               13,
+	NotificationUUID: NotificationID,
+	Type:             "VM",
+	SourceHostUUID:   "b5bc49be-ea6f-472d-9240-968f75d7a16a",
+	Payload: map[string]interface{}{
+		"event":            "LIFECYCLE",
+		"instance_uuid":    "99b99a6b-1fe1-4ad1-8f8b-7b4a7ca7ea4b",
+		"vir_domain_event": "STOPPED_FAILED",
+	},
+	Status:        "running",
+	GeneratedTime: time.Date(2017, 6, 13, 15, 34, 55, 0, time.UTC),
+	CreatedAt:     time.Date(2017, 6, 13, 15, 34, 55, 0, time.UTC),
+	UpdatedAt:     time.Date(2017, 6, 13, 15, 34, 56, 0, time.UTC),
+}
+
+// ExpectedRecoveryWorkflowDetails are the recovery workflow details of
+// NotificationDetailsBody.
+var ExpectedRecoveryWorkflowDetails = []notifications.RecoveryWorkflowDetail{
+	{
+		Name:     "StopInstanceTask",
+		State:    "SUCCESS",
+		Progress: 1.0,
+		ProgressDetails: []notifications.ProgressDetail{
+			{
+				Timestamp: "2019-03-07 13:54:28.842031",
+				Message:   "Stopping instance: 99b99a6b-1fe1-4ad1-8f8b-7b4a7ca7ea4b",
+				Progress:  0.0,
+			},
+		},
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"type":            "VM",
+			"generated-since": "2017-06-13T00:00:00",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/notifications/"+NotificationID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
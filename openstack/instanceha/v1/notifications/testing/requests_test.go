@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/instanceha/v1/notifications"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListNotifications(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	since := time.Date(2017, 6, 13, 0, 0, 0, 0, time.UTC)
+	listOpts := notifications.ListOpts{
+		Type:           notifications.TypeVM,
+		GeneratedSince: &since,
+	}
+
+	count := 0
+	err := notifications.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := notifications.ExtractNotifications(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []notifications.Notification{FirstNotification}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetNotification(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	expected := FirstNotification
+	expected.RecoveryWorkflowDetails = ExpectedRecoveryWorkflowDetails
+
+	actual, err := notifications.Get(fake.ServiceClient(), NotificationID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
+func TestCreateNotification(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := notifications.CreateOpts{
+		Type:          notifications.TypeVM,
+		Hostname:      "compute-1",
+		GeneratedTime: time.Date(2017, 6, 13, 15, 34, 55, 0, time.UTC),
+		Payload: map[string]interface{}{
+			"event":            "LIFECYCLE",
+			"instance_uuid":    "99b99a6b-1fe1-4ad1-8f8b-7b4a7ca7ea4b",
+			"vir_domain_event": "STOPPED_FAILED",
+		},
+	}
+
+	actual, err := notifications.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstNotification, actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	createOpts := notifications.CreateOpts{
+		Type:     notifications.TypeVM,
+		Hostname: "compute-1",
+		Payload:  map[string]interface{}{},
+	}
+	_, err := createOpts.ToNotificationCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
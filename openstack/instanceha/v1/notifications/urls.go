@@ -0,0 +1,25 @@
+package notifications
+
+import "github.com/gophercloud/gophercloud"
+
+const notificationsPath = "notifications"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(notificationsPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, notificationID string) string {
+	return c.ServiceURL(notificationsPath, notificationID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, notificationID string) string {
+	return resourceURL(c, notificationID)
+}
@@ -0,0 +1,57 @@
+/*
+Package segments manages failover segments of the OpenStack Instance HA
+service (Masakari). A failover segment is a group of hosts whose instances
+are evacuated to each other when one of them fails.
+
+Example to List Segments
+
+	listOpts := segments.ListOpts{
+		RecoveryMethod: segments.RecoveryMethodAuto,
+	}
+
+	allPages, err := segments.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allSegments, err := segments.ExtractSegments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, segment := range allSegments {
+		fmt.Printf("%+v\n", segment)
+	}
+
+Example to Create a Segment
+
+	createOpts := segments.CreateOpts{
+		Name:           "segment1",
+		RecoveryMethod: segments.RecoveryMethodAuto,
+		ServiceType:    "compute",
+	}
+
+	segment, err := segments.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Segment
+
+	updateOpts := segments.UpdateOpts{
+		RecoveryMethod: segments.RecoveryMethodReservedHost,
+	}
+
+	segment, err := segments.Update(client, segmentID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Segment
+
+	err := segments.Delete(client, segmentID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package segments
@@ -0,0 +1,169 @@
+package segments
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Recovery methods of a segment.
+const (
+	RecoveryMethodAuto         = "auto"
+	RecoveryMethodReservedHost = "reserved_host"
+	RecoveryMethodAutoPriority = "auto_priority"
+	RecoveryMethodRHPriority   = "rh_priority"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSegmentListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// RecoveryMethod filters the segments by recovery method.
+	RecoveryMethod string `q:"recovery_method"`
+
+	// ServiceType filters the segments by service type.
+	ServiceType string `q:"service_type"`
+
+	// Enabled filters the segments by their enabled state.
+	// Requires microversion 1.2 or above.
+	Enabled *bool `q:"enabled"`
+
+	// Limit limits the number of segments to return.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last segment of the previous page.
+	Marker string `q:"marker"`
+
+	// SortKey sorts the segments by the given attribute.
+	SortKey string `q:"sort_key"`
+
+	// SortDir sets the sort direction, "asc" or "desc".
+	SortDir string `q:"sort_dir"`
+}
+
+// ToSegmentListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSegmentListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// failover segments.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToSegmentListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return SegmentPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific failover segment based on its UUID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSegmentCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new failover segment.
+type CreateOpts struct {
+	// Name is the name of the segment.
+	Name string `json:"name" required:"true"`
+
+	// Description is a description of the segment.
+	Description string `json:"description,omitempty"`
+
+	// RecoveryMethod is the method used to recover the instances of a
+	// failed host of the segment.
+	RecoveryMethod string `json:"recovery_method" required:"true"`
+
+	// ServiceType is the type of the service of the segment hosts,
+	// e.g. "compute".
+	ServiceType string `json:"service_type" required:"true"`
+
+	// Enabled enables or disables the segment.
+	// Requires microversion 1.2 or above.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToSegmentCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToSegmentCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "segment")
+}
+
+// Create requests the creation of a new failover segment.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSegmentCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToSegmentUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters to update a failover segment.
+type UpdateOpts struct {
+	// Name is the name of the segment.
+	Name string `json:"name,omitempty"`
+
+	// Description is a description of the segment.
+	Description *string `json:"description,omitempty"`
+
+	// RecoveryMethod is the method used to recover the instances of a
+	// failed host of the segment.
+	RecoveryMethod string `json:"recovery_method,omitempty"`
+
+	// ServiceType is the type of the service of the segment hosts.
+	ServiceType string `json:"service_type,omitempty"`
+
+	// Enabled enables or disables the segment.
+	// Requires microversion 1.2 or above.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToSegmentUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToSegmentUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "segment")
+}
+
+// Update requests the update of a failover segment.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToSegmentUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete requests the deletion of a failover segment.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
@@ -0,0 +1,148 @@
+package segments
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Segment represents a failover segment, a group of hosts which fail over
+// to each other.
+type Segment struct {
+	// ID is the internal ID of the segment.
+	ID int `json:"id"`
+
+	// UUID is the unique ID of the segment.
+	UUID string `json:"uuid"`
+
+	// Name is the name of the segment.
+	Name string `json:"name"`
+
+	// Description is a description of the segment.
+	Description string `json:"description"`
+
+	// RecoveryMethod is the method used to recover the instances of a
+	// failed host of the segment.
+	RecoveryMethod string `json:"recovery_method"`
+
+	// ServiceType is the type of the service of the segment hosts.
+	ServiceType string `json:"service_type"`
+
+	// Enabled indicates whether the segment is enabled.
+	// Only returned with microversion 1.2 or above.
+	Enabled *bool `json:"enabled"`
+
+	// Deleted indicates whether the segment was deleted.
+	Deleted bool `json:"deleted"`
+
+	// CreatedAt is the date the segment was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the segment was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+func (r *Segment) UnmarshalJSON(b []byte) error {
+	type tmp Segment
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Segment(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Segment.
+func (r commonResult) Extract() (*Segment, error) {
+	var s struct {
+		Segment *Segment `json:"segment"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Segment, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Segment.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Segment.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Segment.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// SegmentPage is a single page of Segment results.
+type SegmentPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to
+// the next page of results.
+func (r SegmentPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"segments_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r SegmentPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"segments_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
+// IsEmpty determines whether or not a SegmentPage contains any results.
+func (r SegmentPage) IsEmpty() (bool, error) {
+	segments, err := ExtractSegments(r)
+	return len(segments) == 0, err
+}
+
+// ExtractSegments returns a slice of Segments contained in a single page of
+// results.
+func ExtractSegments(r pagination.Page) ([]Segment, error) {
+	var s struct {
+		Segments []Segment `json:"segments"`
+	}
+	err := (r.(SegmentPage)).ExtractInto(&s)
+	return s.Segments, err
+}
@@ -0,0 +1,2 @@
+// instanceha_segments_v1
+package testing
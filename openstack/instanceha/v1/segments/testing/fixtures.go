@@ -0,0 +1,142 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/instanceha/v1/segments"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const SegmentID = "9e800031-6946-4b43-bf09-8b3d1cab792b"
+
+// SegmentBody is the JSON representation of a single segment.
+const SegmentBody = `
+{
+  "id": 1,
+  "uuid": "9e800031-6946-4b43-bf09-8b3d1cab792b",
+  "name": "segment1",
+  "description": "failover segment",
+  "recovery_method": "auto",
+  "service_type": "compute",
+  "enabled": true,
+  "deleted": false,
+  "created_at": "2018-03-21T09:42:44.000000",
+  "updated_at": null,
+  "deleted_at": null
+}
+`
+
+// GetResponse is a sample response to a Get call.
+var GetResponse = fmt.Sprintf(`{"segment": %s}`, SegmentBody)
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`{"segments": [%s]}`, SegmentBody)
+
+// CreateRequest is a sample request to create a segment.
+const CreateRequest = `
+{
+  "segment": {
+    "name": "segment1",
+    "description": "failover segment",
+    "recovery_method": "auto",
+    "service_type": "compute"
+  }
+}
+`
+
+// UpdateRequest is a sample request to update a segment.
+const UpdateRequest = `
+{
+  "segment": {
+    "description": "failover segment",
+    "enabled": true
+  }
+}
+`
+
+var enabled = true
+
+// FirstSegment is the segment described by SegmentBody.
+var FirstSegment = segments.Segment{
+	ID:             1,
+	UUID:           SegmentID,
+	Name:           "segment1",
+	Description:    "failover segment",
+	RecoveryMethod: "auto",
+	ServiceType:    "compute",
+	Enabled:        &enabled,
+	CreatedAt:      time.Date(2018, 3, 21, 9, 42, 44, 0, time.UTC),
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"recovery_method": "auto",
+			"enabled":         "true",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/segments/"+SegmentID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/segments/"+SegmentID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/segments/"+SegmentID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
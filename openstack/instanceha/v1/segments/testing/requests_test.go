@@ -0,0 +1,94 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/instanceha/v1/segments"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListSegments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	enabled := true
+	listOpts := segments.ListOpts{
+		RecoveryMethod: segments.RecoveryMethodAuto,
+		Enabled:        &enabled,
+	}
+
+	count := 0
+	err := segments.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := segments.ExtractSegments(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []segments.Segment{FirstSegment}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetSegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := segments.Get(fake.ServiceClient(), SegmentID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstSegment, actual)
+}
+
+func TestCreateSegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := segments.CreateOpts{
+		Name:           "segment1",
+		Description:    "failover segment",
+		RecoveryMethod: segments.RecoveryMethodAuto,
+		ServiceType:    "compute",
+	}
+
+	actual, err := segments.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstSegment, actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	_, err := segments.CreateOpts{Name: "segment1"}.ToSegmentCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdateSegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	description := "failover segment"
+	enabled := true
+	updateOpts := segments.UpdateOpts{
+		Description: &description,
+		Enabled:     &enabled,
+	}
+
+	actual, err := segments.Update(fake.ServiceClient(), SegmentID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstSegment, actual)
+}
+
+func TestDeleteSegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := segments.Delete(fake.ServiceClient(), SegmentID)
+	th.AssertNoErr(t, res.Err)
+}
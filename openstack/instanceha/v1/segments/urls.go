@@ -0,0 +1,33 @@
+package segments
+
+import "github.com/gophercloud/gophercloud"
+
+const segmentsPath = "segments"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(segmentsPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, segmentID string) string {
+	return c.ServiceURL(segmentsPath, segmentID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, segmentID string) string {
+	return resourceURL(c, segmentID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, segmentID string) string {
+	return resourceURL(c, segmentID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, segmentID string) string {
+	return resourceURL(c, segmentID)
+}
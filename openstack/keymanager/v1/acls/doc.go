@@ -17,9 +17,11 @@ Example to Set a Secret's ACL
 	users := []string{"uuid", "uuid"}
 	iFalse := false
 	setOpts := acls.SetOpts{
-		Type:          "read",
-		users:         &users,
-		ProjectAccess: &iFalse,
+		acls.SetOpt{
+			Type:          "read",
+			Users:         &users,
+			ProjectAccess: &iFalse,
+		},
 	}
 
 	aclRef, err := acls.SetSecretACL(client, secretID, setOpts).Extract()
@@ -33,8 +35,10 @@ Example to Update a Secret's ACL
 
 	users := []string{}
 	setOpts := acls.SetOpts{
-		Type:  "read",
-		users: &users,
+		acls.SetOpt{
+			Type:  "read",
+			Users: &users,
+		},
 	}
 
 	aclRef, err := acls.UpdateSecretACL(client, secretID, setOpts).Extract()
@@ -48,7 +52,22 @@ Example to Delete a Secret's ACL
 
 	err := acls.DeleteSecretACL(client, secretID).ExtractErr()
 	if err != nil {
-		panci(err)
+		panic(err)
+	}
+
+Example to Set a Container's ACL
+
+	users := []string{"uuid"}
+	setOpts := acls.SetOpts{
+		acls.SetOpt{
+			Type:  "read",
+			Users: &users,
+		},
+	}
+
+	aclRef, err := acls.SetContainerACL(client, containerID, setOpts).Extract()
+	if err != nil {
+		panic(err)
 	}
 */
 package acls
@@ -286,6 +286,11 @@ func HandleListConsumersSuccessfully(t *testing.T) {
 		th.TestHeader(t, r, "Accept", "application/json")
 		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
 
+		r.ParseForm()
+		if limit := r.Form.Get("limit"); limit != "" && limit != "10" {
+			t.Errorf("Unexpected limit: %s", limit)
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, ListConsumersResponse)
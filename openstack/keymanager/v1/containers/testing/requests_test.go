@@ -113,6 +113,22 @@ func TestListConsumersAllPages(t *testing.T) {
 	th.AssertDeepEquals(t, ExpectedConsumersSlice, actual)
 }
 
+func TestListConsumersWithOpts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListConsumersSuccessfully(t)
+
+	listOpts := containers.ListConsumersOpts{
+		Limit: 10,
+	}
+
+	allPages, err := containers.ListConsumers(client.ServiceClient(), "dfdb88f3-4ddb-4525-9da6-066453caa9b0", listOpts).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := containers.ExtractConsumers(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedConsumersSlice, actual)
+}
+
 func TestCreateConsumer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
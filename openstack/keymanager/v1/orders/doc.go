@@ -35,6 +35,27 @@ Example to Create a Order
 
 	fmt.Printf("%v\n", order)
 
+Example to Create an Asymmetric Order
+
+The generated key pair is stored in the container referenced by the
+ContainerRef of the order once it becomes ACTIVE.
+
+	createOpts := orders.CreateOpts{
+		Type: orders.AsymmetricOrder,
+		Meta: orders.MetaOpts{
+			Name:      "rsa-keypair",
+			Algorithm: "rsa",
+			BitLength: 2048,
+		},
+	}
+
+	order, err := orders.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(order.ContainerRef)
+
 Example to Delete a Order
 
 	err := orders.Delete(client, orderID).ExtractErr()
@@ -11,8 +11,9 @@ import (
 type OrderType string
 
 const (
-	KeyOrder        OrderType = "key"
-	AsymmetricOrder OrderType = "asymmetric"
+	KeyOrder         OrderType = "key"
+	AsymmetricOrder  OrderType = "asymmetric"
+	CertificateOrder OrderType = "certificate"
 )
 
 // ListOptsBuilder allows extensions to add additional parameters to
@@ -74,20 +75,42 @@ type MetaOpts struct {
 	// Expiration is the expiration date of the order.
 	Expiration *time.Time `json:"-"`
 
-	// Mode is the mode of the secret.
-	Mode string `json:"mode"`
+	// Mode is the mode of the secret. It is only used by key orders.
+	Mode string `json:"mode,omitempty"`
 
 	// Name is the name of the secret.
 	Name string `json:"name,omitempty"`
 
 	// PayloadContentType is the content type of the secret payload.
 	PayloadContentType string `json:"payload_content_type,omitempty"`
+
+	// RequestType is the type of certificate request of a certificate order,
+	// ie: simple-cmc, stored-key or custom.
+	RequestType string `json:"request_type,omitempty"`
+
+	// SubjectDN is the subject of the certificate of a stored-key certificate
+	// order.
+	SubjectDN string `json:"subject_dn,omitempty"`
+
+	// ContainerRef is the URL of the RSA container holding the keys of a
+	// stored-key certificate order.
+	ContainerRef string `json:"container_ref,omitempty"`
+
+	// CAID is the ID of the certificate authority to issue the certificate.
+	CAID string `json:"ca_id,omitempty"`
+
+	// Profile is the certificate authority profile to use.
+	Profile string `json:"profile,omitempty"`
+
+	// RequestData is the base64 encoded certificate signing request of a
+	// simple-cmc certificate order.
+	RequestData string `json:"request_data,omitempty"`
 }
 
 // CreateOpts provides options used to create a orders.
 type CreateOpts struct {
 	// Type is the type of order to create.
-	Type OrderType `json:"type"`
+	Type OrderType `json:"type" required:"true"`
 
 	// Meta contains secrets data to create a secret.
 	Meta MetaOpts `json:"meta"`
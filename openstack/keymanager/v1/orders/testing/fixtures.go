@@ -184,3 +184,67 @@ func HandleDeleteOrderSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// CreateAsymmetricRequest provides the input to a Create request for an
+// asymmetric order.
+const CreateAsymmetricRequest = `
+{
+  "meta": {
+    "algorithm": "rsa",
+    "bit_length": 2048,
+    "name": "rsa-keypair"
+  },
+  "type": "asymmetric"
+}`
+
+// GetAsymmetricResponse provides a Get result for an asymmetric order.
+const GetAsymmetricResponse = `
+{
+  "container_ref": "http://barbican:9311/v1/containers/6bd7cc4e-ec6c-4a15-9e6f-a1cc5e21e9b3",
+  "created": "2018-06-22T05:08:15",
+  "creator_id": "5c70d99f4a8641c38f8084b32b5e5c0e",
+  "meta": {
+    "algorithm": "rsa",
+    "bit_length": 2048,
+    "expiration": null,
+    "name": "rsa-keypair"
+  },
+  "order_ref": "http://barbican:9311/v1/orders/b8fcfd52-0fd2-4de7-9a3c-9c4c0b0a5b7b",
+  "status": "ACTIVE",
+  "sub_status": "Unknown",
+  "sub_status_message": "Unknown",
+  "type": "asymmetric",
+  "updated": "2018-06-22T05:08:15"
+}
+`
+
+// AsymmetricOrder is the resource returned by GetAsymmetricResponse.
+var AsymmetricOrder = orders.Order{
+	ContainerRef: "http://barbican:9311/v1/containers/6bd7cc4e-ec6c-4a15-9e6f-a1cc5e21e9b3",
+	Created:      time.Date(2018, 6, 22, 5, 8, 15, 0, time.UTC),
+	CreatorID:    "5c70d99f4a8641c38f8084b32b5e5c0e",
+	Meta: orders.Meta{
+		Algorithm: "rsa",
+		BitLength: 2048,
+		Name:      "rsa-keypair",
+	},
+	OrderRef:         "http://barbican:9311/v1/orders/b8fcfd52-0fd2-4de7-9a3c-9c4c0b0a5b7b",
+	Status:           "ACTIVE",
+	SubStatus:        "Unknown",
+	SubStatusMessage: "Unknown",
+	Type:             "asymmetric",
+	Updated:          time.Date(2018, 6, 22, 5, 8, 15, 0, time.UTC),
+}
+
+// HandleCreateAsymmetricOrderSuccessfully creates an HTTP handler at `/orders`
+// on the test handler mux that tests the creation of an asymmetric order.
+func HandleCreateAsymmetricOrderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/orders", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateAsymmetricRequest)
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, GetAsymmetricResponse)
+	})
+}
@@ -71,6 +71,25 @@ func TestCreateOrder(t *testing.T) {
 	th.AssertDeepEquals(t, SecondOrder, *actual)
 }
 
+func TestCreateAsymmetricOrder(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateAsymmetricOrderSuccessfully(t)
+
+	createOpts := orders.CreateOpts{
+		Type: orders.AsymmetricOrder,
+		Meta: orders.MetaOpts{
+			Name:      "rsa-keypair",
+			Algorithm: "rsa",
+			BitLength: 2048,
+		},
+	}
+
+	actual, err := orders.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, AsymmetricOrder, *actual)
+}
+
 func TestDeleteOrder(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
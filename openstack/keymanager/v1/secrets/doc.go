@@ -38,13 +38,24 @@ Example to Get a Secret
 
 Example to Get a Payload
 
-	payload, err := secrets.GetPayload(client, secretID).Extract()
+	payload, err := secrets.GetPayload(client, secretID, nil).Extract()
 	if err != nil {
 		panic(err)
 	}
 
 	fmt.Println(string(payload))
 
+Example to Get a Binary Payload
+
+	getPayloadOpts := secrets.GetPayloadOpts{
+		PayloadContentType: "application/octet-stream",
+	}
+
+	payload, err := secrets.GetPayload(client, secretID, getPayloadOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Create a Secrets
 
 	createOpts := secrets.CreateOpts{
@@ -64,6 +75,21 @@ Example to Create a Secrets
 
 	fmt.Println(secret.SecretRef)
 
+Example to Create a Secret With a Binary Payload
+
+	createOpts := secrets.CreateOpts{
+		Name:                   "mykey",
+		Payload:                base64.StdEncoding.EncodeToString(key),
+		PayloadContentType:     "application/octet-stream",
+		PayloadContentEncoding: "base64",
+		SecretType:             secrets.SymmetricSecret,
+	}
+
+	secret, err := secrets.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Add a Payload
 
 	updateOpts := secrets.UpdateOpts{
@@ -111,6 +111,19 @@ func (r AmphoraPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r AmphoraPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"amphorae_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a AmphoraPage struct is empty.
 func (r AmphoraPage) IsEmpty() (bool, error) {
 	is, err := ExtractAmphorae(r)
@@ -146,3 +159,9 @@ func (r commonResult) Extract() (*Amphora, error) {
 type GetResult struct {
 	commonResult
 }
+
+// FailoverResult represents the result of a failover operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type FailoverResult struct {
+	gophercloud.ErrResult
+}
@@ -5,6 +5,7 @@ import "github.com/gophercloud/gophercloud"
 const (
 	rootPath     = "octavia"
 	resourcePath = "amphorae"
+	failoverPath = "failover"
 )
 
 func rootURL(c *gophercloud.ServiceClient) string {
@@ -14,3 +15,7 @@ func rootURL(c *gophercloud.ServiceClient) string {
 func resourceURL(c *gophercloud.ServiceClient, id string) string {
 	return c.ServiceURL(rootPath, resourcePath, id)
 }
+
+func failoverRootURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id, failoverPath)
+}
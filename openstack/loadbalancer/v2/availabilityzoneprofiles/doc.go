@@ -0,0 +1,62 @@
+/*
+Package availabilityzoneprofiles provides information and interaction with
+Availability Zone Profiles of OpenStack Load-balancing service. Availability
+zone profiles contain the provider specific settings used by availability
+zones and can only be managed by administrators.
+
+Example to List Availability Zone Profiles
+
+	listOpts := availabilityzoneprofiles.ListOpts{
+		ProviderName: "amphora",
+	}
+
+	allPages, err := availabilityzoneprofiles.List(octaviaClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAvailabilityZoneProfiles, err := availabilityzoneprofiles.ExtractAvailabilityZoneProfiles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, availabilityZoneProfile := range allAvailabilityZoneProfiles {
+		fmt.Printf("%+v\n", availabilityZoneProfile)
+	}
+
+Example to Create an Availability Zone Profile
+
+	createOpts := availabilityzoneprofiles.CreateOpts{
+		Name:                 "az-one",
+		ProviderName:         "amphora",
+		AvailabilityZoneData: `{"compute_zone": "nova-az1"}`,
+	}
+
+	availabilityZoneProfile, err := availabilityzoneprofiles.Create(octaviaClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Availability Zone Profile
+
+	availabilityZoneProfileID := "dcd65be5-f117-4260-ab3d-b32cc5bd1272"
+
+	updateOpts := availabilityzoneprofiles.UpdateOpts{
+		AvailabilityZoneData: `{"compute_zone": "nova-az2"}`,
+	}
+
+	availabilityZoneProfile, err := availabilityzoneprofiles.Update(octaviaClient, availabilityZoneProfileID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Availability Zone Profile
+
+	availabilityZoneProfileID := "dcd65be5-f117-4260-ab3d-b32cc5bd1272"
+
+	err := availabilityzoneprofiles.Delete(octaviaClient, availabilityZoneProfileID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package availabilityzoneprofiles
@@ -0,0 +1,144 @@
+package availabilityzoneprofiles
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAvailabilityZoneProfileListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the AvailabilityZoneProfile attributes you want to see returned. SortKey allows you to
+// sort by a particular attribute. SortDir sets the direction, and is
+// either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID           string   `q:"id"`
+	Name         string   `q:"name"`
+	ProviderName string   `q:"provider_name"`
+	Fields       []string `q:"fields"`
+	Limit        int      `q:"limit"`
+	Marker       string   `q:"marker"`
+	SortKey      string   `q:"sort_key"`
+	SortDir      string   `q:"sort_dir"`
+}
+
+// ToAvailabilityZoneProfileListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAvailabilityZoneProfileListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// availability zone profiles. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+//
+// Listing availability zone profiles requires admin privileges.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToAvailabilityZoneProfileListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AvailabilityZoneProfilePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAvailabilityZoneProfileCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is the common options struct used in this package's Create
+// operation.
+type CreateOpts struct {
+	// Human-readable name for the availability zone profile.
+	Name string `json:"name" required:"true"`
+
+	// The name of the provider the availability zone profile is for.
+	ProviderName string `json:"provider_name" required:"true"`
+
+	// The JSON string containing the provider specific availability zone metadata,
+	// e.g. `{"compute_zone": "nova-az2"}`.
+	AvailabilityZoneData string `json:"availability_zone_data" required:"true"`
+}
+
+// ToAvailabilityZoneProfileCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToAvailabilityZoneProfileCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+}
+
+// Create is an operation which provisions a new availability zone profile based on the
+// configuration defined in the CreateOpts struct. Once the request is
+// validated and progress has started on the provisioning process, a
+// CreateResult will be returned.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAvailabilityZoneProfileCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
+	return
+}
+
+// Get retrieves a particular availability zone profile based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToAvailabilityZoneProfileUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is the common options struct used in this package's Update
+// operation.
+type UpdateOpts struct {
+	// Human-readable name for the availability zone profile.
+	Name string `json:"name,omitempty"`
+
+	// The name of the provider the availability zone profile is for.
+	ProviderName string `json:"provider_name,omitempty"`
+
+	// The JSON string containing the provider specific availability zone metadata.
+	AvailabilityZoneData string `json:"availability_zone_data,omitempty"`
+}
+
+// ToAvailabilityZoneProfileUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToAvailabilityZoneProfileUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+}
+
+// Update is an operation which modifies the attributes of the specified
+// availability zone profile.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAvailabilityZoneProfileUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete will permanently delete a particular availability zone profile based on its
+// unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	return
+}
@@ -0,0 +1,157 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/availabilityzoneprofiles"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// AvailabilityZoneProfilesListBody contains the canned body of a availability zone profile list response.
+const AvailabilityZoneProfilesListBody = `
+{
+	"availability_zone_profiles": [
+		{
+			"id": "c55d080d-af45-47ee-b48c-4caa5e87724f",
+			"name": "az-one",
+			"provider_name": "amphora",
+			"availability_zone_data": "{\"compute_zone\": \"nova-az1\"}"
+		},
+		{
+			"id": "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+			"name": "az-two",
+			"provider_name": "amphora",
+			"availability_zone_data": "{\"compute_zone\": \"nova-az2\"}"
+		}
+	]
+}
+`
+
+// SingleAvailabilityZoneProfileBody is the canned body of a Get request on an existing availability zone profile.
+const SingleAvailabilityZoneProfileBody = `
+{
+	"availability_zone_profile": {
+		"id": "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		"name": "az-two",
+		"provider_name": "amphora",
+		"availability_zone_data": "{\"compute_zone\": \"nova-az2\"}"
+	}
+}
+`
+
+// PostUpdateAvailabilityZoneProfileBody is the canned response body of a Update request on an existing availability zone profile.
+const PostUpdateAvailabilityZoneProfileBody = `
+{
+	"availability_zone_profile": {
+		"id": "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		"name": "az-two-updated",
+		"provider_name": "amphora",
+		"availability_zone_data": "{\"compute_zone\": \"nova-az1\"}"
+	}
+}
+`
+
+var (
+	AvailabilityZoneProfileSingle = availabilityzoneprofiles.AvailabilityZoneProfile{
+		ID:                   "c55d080d-af45-47ee-b48c-4caa5e87724f",
+		Name:                 "az-one",
+		ProviderName:         "amphora",
+		AvailabilityZoneData: "{\"compute_zone\": \"nova-az1\"}",
+	}
+
+	AvailabilityZoneProfileAct = availabilityzoneprofiles.AvailabilityZoneProfile{
+		ID:                   "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		Name:                 "az-two",
+		ProviderName:         "amphora",
+		AvailabilityZoneData: "{\"compute_zone\": \"nova-az2\"}",
+	}
+
+	AvailabilityZoneProfileUpdated = availabilityzoneprofiles.AvailabilityZoneProfile{
+		ID:                   "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		Name:                 "az-two-updated",
+		ProviderName:         "amphora",
+		AvailabilityZoneData: "{\"compute_zone\": \"nova-az1\"}",
+	}
+)
+
+// HandleAvailabilityZoneProfileListSuccessfully sets up the test server to respond to a availability zone profile List request.
+func HandleAvailabilityZoneProfileListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzoneprofiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprint(w, AvailabilityZoneProfilesListBody)
+		case "dcd65be5-f117-4260-ab3d-b32cc5bd1272":
+			fmt.Fprint(w, `{ "availability_zone_profiles": [] }`)
+		default:
+			t.Fatalf("/v2.0/lbaas/availabilityzoneprofiles invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
+// HandleAvailabilityZoneProfileCreationSuccessfully sets up the test server to respond to a availability zone profile creation request
+// with a given response.
+func HandleAvailabilityZoneProfileCreationSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzoneprofiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"availability_zone_profile": {
+				"name": "az-two",
+				"provider_name": "amphora",
+				"availability_zone_data": "{\"compute_zone\": \"nova-az2\"}"
+			}
+		}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, response)
+	})
+}
+
+// HandleAvailabilityZoneProfileGetSuccessfully sets up the test server to respond to a availability zone profile Get request.
+func HandleAvailabilityZoneProfileGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzoneprofiles/dcd65be5-f117-4260-ab3d-b32cc5bd1272", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		fmt.Fprint(w, SingleAvailabilityZoneProfileBody)
+	})
+}
+
+// HandleAvailabilityZoneProfileDeletionSuccessfully sets up the test server to respond to a availability zone profile deletion request.
+func HandleAvailabilityZoneProfileDeletionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzoneprofiles/dcd65be5-f117-4260-ab3d-b32cc5bd1272", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleAvailabilityZoneProfileUpdateSuccessfully sets up the test server to respond to a availability zone profile Update request.
+func HandleAvailabilityZoneProfileUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/availabilityzoneprofiles/dcd65be5-f117-4260-ab3d-b32cc5bd1272", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, `{
+			"availability_zone_profile": {
+				"name": "az-two-updated",
+				"availability_zone_data": "{\"compute_zone\": \"nova-az1\"}"
+			}
+		}`)
+
+		fmt.Fprint(w, PostUpdateAvailabilityZoneProfileBody)
+	})
+}
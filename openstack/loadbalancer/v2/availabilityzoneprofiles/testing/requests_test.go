@@ -0,0 +1,119 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/availabilityzoneprofiles"
+	fake "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/testhelper"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestListAvailabilityZoneProfiles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileListSuccessfully(t)
+
+	pages := 0
+	err := availabilityzoneprofiles.List(fake.ServiceClient(), availabilityzoneprofiles.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := availabilityzoneprofiles.ExtractAvailabilityZoneProfiles(page)
+		if err != nil {
+			return false, err
+		}
+
+		if len(actual) != 2 {
+			t.Fatalf("Expected 2 availability zone profiles, got %d", len(actual))
+		}
+		th.CheckDeepEquals(t, AvailabilityZoneProfileSingle, actual[0])
+		th.CheckDeepEquals(t, AvailabilityZoneProfileAct, actual[1])
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if pages != 1 {
+		t.Errorf("Expected 1 page, saw %d", pages)
+	}
+}
+
+func TestListAllAvailabilityZoneProfiles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileListSuccessfully(t)
+
+	allPages, err := availabilityzoneprofiles.List(fake.ServiceClient(), availabilityzoneprofiles.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := availabilityzoneprofiles.ExtractAvailabilityZoneProfiles(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, AvailabilityZoneProfileSingle, actual[0])
+	th.CheckDeepEquals(t, AvailabilityZoneProfileAct, actual[1])
+}
+
+func TestCreateAvailabilityZoneProfile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileCreationSuccessfully(t, SingleAvailabilityZoneProfileBody)
+
+	actual, err := availabilityzoneprofiles.Create(fake.ServiceClient(), availabilityzoneprofiles.CreateOpts{
+		Name:                 "az-two",
+		ProviderName:         "amphora",
+		AvailabilityZoneData: "{\"compute_zone\": \"nova-az2\"}",
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, AvailabilityZoneProfileAct, *actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := availabilityzoneprofiles.Create(fake.ServiceClient(), availabilityzoneprofiles.CreateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+	res = availabilityzoneprofiles.Create(fake.ServiceClient(), availabilityzoneprofiles.CreateOpts{Name: "az-one"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestGetAvailabilityZoneProfile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileGetSuccessfully(t)
+
+	client := fake.ServiceClient()
+	actual, err := availabilityzoneprofiles.Get(client, "dcd65be5-f117-4260-ab3d-b32cc5bd1272").Extract()
+	if err != nil {
+		t.Fatalf("Unexpected Get error: %v", err)
+	}
+
+	th.CheckDeepEquals(t, AvailabilityZoneProfileAct, *actual)
+}
+
+func TestDeleteAvailabilityZoneProfile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileDeletionSuccessfully(t)
+
+	res := availabilityzoneprofiles.Delete(fake.ServiceClient(), "dcd65be5-f117-4260-ab3d-b32cc5bd1272")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUpdateAvailabilityZoneProfile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAvailabilityZoneProfileUpdateSuccessfully(t)
+
+	client := fake.ServiceClient()
+	actual, err := availabilityzoneprofiles.Update(client, "dcd65be5-f117-4260-ab3d-b32cc5bd1272", availabilityzoneprofiles.UpdateOpts{
+		Name:                 "az-two-updated",
+		AvailabilityZoneData: "{\"compute_zone\": \"nova-az1\"}",
+	}).Extract()
+	if err != nil {
+		t.Fatalf("Unexpected Update error: %v", err)
+	}
+
+	th.CheckDeepEquals(t, AvailabilityZoneProfileUpdated, *actual)
+}
@@ -0,0 +1,62 @@
+/*
+Package flavorprofiles provides information and interaction with Flavor
+Profiles of OpenStack Load-balancing service. Flavor profiles contain the
+provider specific settings used by flavors and can only be managed by
+administrators.
+
+Example to List Flavor Profiles
+
+	listOpts := flavorprofiles.ListOpts{
+		ProviderName: "amphora",
+	}
+
+	allPages, err := flavorprofiles.List(octaviaClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allFlavorProfiles, err := flavorprofiles.ExtractFlavorProfiles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, flavorProfile := range allFlavorProfiles {
+		fmt.Printf("%+v\n", flavorProfile)
+	}
+
+Example to Create a Flavor Profile
+
+	createOpts := flavorprofiles.CreateOpts{
+		Name:         "amphora-single",
+		ProviderName: "amphora",
+		FlavorData:   `{"loadbalancer_topology": "SINGLE"}`,
+	}
+
+	flavorProfile, err := flavorprofiles.Create(octaviaClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Flavor Profile
+
+	flavorProfileID := "dcd65be5-f117-4260-ab3d-b32cc5bd1272"
+
+	updateOpts := flavorprofiles.UpdateOpts{
+		FlavorData: `{"loadbalancer_topology": "ACTIVE_STANDBY"}`,
+	}
+
+	flavorProfile, err := flavorprofiles.Update(octaviaClient, flavorProfileID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Flavor Profile
+
+	flavorProfileID := "dcd65be5-f117-4260-ab3d-b32cc5bd1272"
+
+	err := flavorprofiles.Delete(octaviaClient, flavorProfileID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package flavorprofiles
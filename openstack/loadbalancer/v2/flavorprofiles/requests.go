@@ -0,0 +1,144 @@
+package flavorprofiles
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToFlavorProfileListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the FlavorProfile attributes you want to see returned. SortKey allows you to
+// sort by a particular attribute. SortDir sets the direction, and is
+// either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID           string   `q:"id"`
+	Name         string   `q:"name"`
+	ProviderName string   `q:"provider_name"`
+	Fields       []string `q:"fields"`
+	Limit        int      `q:"limit"`
+	Marker       string   `q:"marker"`
+	SortKey      string   `q:"sort_key"`
+	SortDir      string   `q:"sort_dir"`
+}
+
+// ToFlavorProfileListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFlavorProfileListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// flavor profiles. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+//
+// Listing flavor profiles requires admin privileges.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToFlavorProfileListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return FlavorProfilePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToFlavorProfileCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is the common options struct used in this package's Create
+// operation.
+type CreateOpts struct {
+	// Human-readable name for the flavor profile.
+	Name string `json:"name" required:"true"`
+
+	// The name of the provider the flavor profile is for.
+	ProviderName string `json:"provider_name" required:"true"`
+
+	// The JSON string containing the provider specific flavor metadata,
+	// e.g. `{"loadbalancer_topology": "ACTIVE_STANDBY"}`.
+	FlavorData string `json:"flavor_data" required:"true"`
+}
+
+// ToFlavorProfileCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToFlavorProfileCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavorprofile")
+}
+
+// Create is an operation which provisions a new flavor profile based on the
+// configuration defined in the CreateOpts struct. Once the request is
+// validated and progress has started on the provisioning process, a
+// CreateResult will be returned.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFlavorProfileCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
+	return
+}
+
+// Get retrieves a particular flavor profile based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToFlavorProfileUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is the common options struct used in this package's Update
+// operation.
+type UpdateOpts struct {
+	// Human-readable name for the flavor profile.
+	Name string `json:"name,omitempty"`
+
+	// The name of the provider the flavor profile is for.
+	ProviderName string `json:"provider_name,omitempty"`
+
+	// The JSON string containing the provider specific flavor metadata.
+	FlavorData string `json:"flavor_data,omitempty"`
+}
+
+// ToFlavorProfileUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToFlavorProfileUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavorprofile")
+}
+
+// Update is an operation which modifies the attributes of the specified
+// flavor profile.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFlavorProfileUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete will permanently delete a particular flavor profile based on its
+// unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	return
+}
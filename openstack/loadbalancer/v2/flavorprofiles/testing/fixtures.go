@@ -0,0 +1,157 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/flavorprofiles"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// FlavorProfilesListBody contains the canned body of a flavor profile list response.
+const FlavorProfilesListBody = `
+{
+	"flavorprofiles": [
+		{
+			"id": "c55d080d-af45-47ee-b48c-4caa5e87724f",
+			"name": "amphora-single",
+			"provider_name": "amphora",
+			"flavor_data": "{\"loadbalancer_topology\": \"SINGLE\"}"
+		},
+		{
+			"id": "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+			"name": "amphora-act-stdby",
+			"provider_name": "amphora",
+			"flavor_data": "{\"loadbalancer_topology\": \"ACTIVE_STANDBY\"}"
+		}
+	]
+}
+`
+
+// SingleFlavorProfileBody is the canned body of a Get request on an existing flavor profile.
+const SingleFlavorProfileBody = `
+{
+	"flavorprofile": {
+		"id": "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		"name": "amphora-act-stdby",
+		"provider_name": "amphora",
+		"flavor_data": "{\"loadbalancer_topology\": \"ACTIVE_STANDBY\"}"
+	}
+}
+`
+
+// PostUpdateFlavorProfileBody is the canned response body of a Update request on an existing flavor profile.
+const PostUpdateFlavorProfileBody = `
+{
+	"flavorprofile": {
+		"id": "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		"name": "amphora-test-updated",
+		"provider_name": "amphora",
+		"flavor_data": "{\"loadbalancer_topology\": \"SINGLE\"}"
+	}
+}
+`
+
+var (
+	FlavorProfileSingle = flavorprofiles.FlavorProfile{
+		ID:           "c55d080d-af45-47ee-b48c-4caa5e87724f",
+		Name:         "amphora-single",
+		ProviderName: "amphora",
+		FlavorData:   "{\"loadbalancer_topology\": \"SINGLE\"}",
+	}
+
+	FlavorProfileAct = flavorprofiles.FlavorProfile{
+		ID:           "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		Name:         "amphora-act-stdby",
+		ProviderName: "amphora",
+		FlavorData:   "{\"loadbalancer_topology\": \"ACTIVE_STANDBY\"}",
+	}
+
+	FlavorProfileUpdated = flavorprofiles.FlavorProfile{
+		ID:           "dcd65be5-f117-4260-ab3d-b32cc5bd1272",
+		Name:         "amphora-test-updated",
+		ProviderName: "amphora",
+		FlavorData:   "{\"loadbalancer_topology\": \"SINGLE\"}",
+	}
+)
+
+// HandleFlavorProfileListSuccessfully sets up the test server to respond to a flavor profile List request.
+func HandleFlavorProfileListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavorprofiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprint(w, FlavorProfilesListBody)
+		case "dcd65be5-f117-4260-ab3d-b32cc5bd1272":
+			fmt.Fprint(w, `{ "flavorprofiles": [] }`)
+		default:
+			t.Fatalf("/v2.0/lbaas/flavorprofiles invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
+// HandleFlavorProfileCreationSuccessfully sets up the test server to respond to a flavor profile creation request
+// with a given response.
+func HandleFlavorProfileCreationSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavorprofiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"flavorprofile": {
+				"name": "amphora-act-stdby",
+				"provider_name": "amphora",
+				"flavor_data": "{\"loadbalancer_topology\": \"ACTIVE_STANDBY\"}"
+			}
+		}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, response)
+	})
+}
+
+// HandleFlavorProfileGetSuccessfully sets up the test server to respond to a flavor profile Get request.
+func HandleFlavorProfileGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavorprofiles/dcd65be5-f117-4260-ab3d-b32cc5bd1272", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		fmt.Fprint(w, SingleFlavorProfileBody)
+	})
+}
+
+// HandleFlavorProfileDeletionSuccessfully sets up the test server to respond to a flavor profile deletion request.
+func HandleFlavorProfileDeletionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavorprofiles/dcd65be5-f117-4260-ab3d-b32cc5bd1272", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleFlavorProfileUpdateSuccessfully sets up the test server to respond to a flavor profile Update request.
+func HandleFlavorProfileUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/flavorprofiles/dcd65be5-f117-4260-ab3d-b32cc5bd1272", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, `{
+			"flavorprofile": {
+				"name": "amphora-test-updated",
+				"flavor_data": "{\"loadbalancer_topology\": \"SINGLE\"}"
+			}
+		}`)
+
+		fmt.Fprint(w, PostUpdateFlavorProfileBody)
+	})
+}
@@ -0,0 +1,2 @@
+// flavors unit tests
+package testing
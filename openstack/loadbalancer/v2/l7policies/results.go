@@ -127,6 +127,19 @@ func (r L7PolicyPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r L7PolicyPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"l7policies_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a L7PolicyPage struct is empty.
 func (r L7PolicyPage) IsEmpty() (bool, error) {
 	is, err := ExtractL7Policies(r)
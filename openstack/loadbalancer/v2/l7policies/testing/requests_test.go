@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/l7policies"
@@ -176,6 +177,20 @@ func TestCreateRule(t *testing.T) {
 	th.CheckDeepEquals(t, RulePath, *actual)
 }
 
+type ErrorCreateRuleOpts l7policies.CreateRuleOpts
+
+func (opts ErrorCreateRuleOpts) ToRuleCreateMap() (map[string]interface{}, error) {
+	return nil, errors.New("This is an error")
+}
+
+func TestCreateRuleOptsBuilder(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	res := l7policies.CreateRule(fake.ServiceClient(), "8a1412f0-4c32-4257-8b07-af4770b604fd", ErrorCreateRuleOpts{})
+	th.AssertEquals(t, "This is an error", res.Err.Error())
+}
+
 func TestRequiredRuleCreateOpts(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/gophercloud/gophercloud"
@@ -151,6 +152,20 @@ func TestUpdateListener(t *testing.T) {
 	th.CheckDeepEquals(t, ListenerUpdated, *actual)
 }
 
+type ErrorUpdateOpts listeners.UpdateOpts
+
+func (opts ErrorUpdateOpts) ToListenerUpdateMap() (map[string]interface{}, error) {
+	return nil, errors.New("This is an error")
+}
+
+func TestUpdateListenerOptsBuilder(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	res := listeners.Update(fake.ServiceClient(), "4ec89087-d057-4e2c-911f-60a3b47ee304", ErrorUpdateOpts{})
+	th.AssertEquals(t, "This is an error", res.Err.Error())
+}
+
 func TestGetListenerStatsTree(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
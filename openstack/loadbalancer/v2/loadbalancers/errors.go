@@ -0,0 +1,19 @@
+package loadbalancers
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrLoadBalancerFailed is returned by WaitForStatus when a load balancer
+// enters the ERROR provisioning status.
+type ErrLoadBalancerFailed struct {
+	gophercloud.BaseError
+	ID                 string
+	ProvisioningStatus string
+}
+
+func (e ErrLoadBalancerFailed) Error() string {
+	return fmt.Sprintf("load balancer %s is in provisioning status %s", e.ID, e.ProvisioningStatus)
+}
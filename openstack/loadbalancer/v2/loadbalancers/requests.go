@@ -25,6 +25,7 @@ type ListOpts struct {
 	VipPortID          string   `q:"vip_port_id"`
 	VipSubnetID        string   `q:"vip_subnet_id"`
 	VipNetworkID       string   `q:"vip_network_id"`
+	VipQosPolicyID     string   `q:"vip_qos_policy_id"`
 	ID                 string   `q:"id"`
 	OperatingStatus    string   `q:"operating_status"`
 	Name               string   `q:"name"`
@@ -104,6 +105,9 @@ type CreateOpts struct {
 	// The IP address of the Loadbalancer.
 	VipAddress string `json:"vip_address,omitempty"`
 
+	// The ID of the QoS Policy which will apply to the Virtual IP.
+	VipQosPolicyID string `json:"vip_qos_policy_id,omitempty"`
+
 	// The administrative state of the Loadbalancer. A valid value is true (UP)
 	// or false (DOWN).
 	AdminStateUp *bool `json:"admin_state_up,omitempty"`
@@ -162,6 +166,9 @@ type UpdateOpts struct {
 	// or false (DOWN).
 	AdminStateUp *bool `json:"admin_state_up,omitempty"`
 
+	// The ID of the QoS Policy which will apply to the Virtual IP.
+	VipQosPolicyID *string `json:"vip_qos_policy_id,omitempty"`
+
 	// Tags is a set of resource tags.
 	Tags *[]string `json:"tags,omitempty"`
 }
@@ -173,7 +180,7 @@ func (opts UpdateOpts) ToLoadBalancerUpdateMap() (map[string]interface{}, error)
 
 // Update is an operation which modifies the attributes of the specified
 // LoadBalancer.
-func Update(c *gophercloud.ServiceClient, id string, opts UpdateOpts) (r UpdateResult) {
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
 	b, err := opts.ToLoadBalancerUpdateMap()
 	if err != nil {
 		r.Err = err
@@ -47,6 +47,9 @@ type LoadBalancer struct {
 	// Loadbalancer address.
 	VipNetworkID string `json:"vip_network_id"`
 
+	// The ID of the QoS Policy which will apply to the Virtual IP.
+	VipQosPolicyID string `json:"vip_qos_policy_id"`
+
 	// The unique ID for the LoadBalancer.
 	ID string `json:"id"`
 
@@ -153,6 +156,19 @@ func (r LoadBalancerPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r LoadBalancerPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"loadbalancers_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a LoadBalancerPage struct is empty.
 func (r LoadBalancerPage) IsEmpty() (bool, error) {
 	is, err := ExtractLoadBalancers(r)
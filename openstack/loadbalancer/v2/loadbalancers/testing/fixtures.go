@@ -3,6 +3,7 @@ package testing
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -45,6 +46,7 @@ const LoadbalancersListBody = `
 			"vip_subnet_id": "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
 			"vip_address": "10.30.176.48",
 			"vip_port_id": "2bf413c8-41a9-4477-b505-333d5cbe8b55",
+			"vip_qos_policy_id": "ec8ab56e-5a1d-4d07-8fe0-b3fce7dc2e22",
 			"flavor_id": "bba40eb2-ee8c-11e9-81b4-2a2ae2dbcce4",
 			"provider": "haproxy",
 			"admin_state_up": true,
@@ -69,6 +71,7 @@ const SingleLoadbalancerBody = `
 		"vip_subnet_id": "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
 		"vip_address": "10.30.176.48",
 		"vip_port_id": "2bf413c8-41a9-4477-b505-333d5cbe8b55",
+		"vip_qos_policy_id": "ec8ab56e-5a1d-4d07-8fe0-b3fce7dc2e22",
 		"flavor_id": "bba40eb2-ee8c-11e9-81b4-2a2ae2dbcce4",
 		"provider": "haproxy",
 		"admin_state_up": true,
@@ -92,6 +95,7 @@ const PostUpdateLoadbalancerBody = `
 		"vip_subnet_id": "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
 		"vip_address": "10.30.176.48",
 		"vip_port_id": "2bf413c8-41a9-4477-b505-333d5cbe8b55",
+		"vip_qos_policy_id": "ec8ab56e-5a1d-4d07-8fe0-b3fce7dc2e22",
 		"flavor_id": "bba40eb2-ee8c-11e9-81b4-2a2ae2dbcce4",
 		"provider": "haproxy",
 		"admin_state_up": true,
@@ -183,6 +187,7 @@ var (
 		VipSubnetID:        "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
 		VipAddress:         "10.30.176.48",
 		VipPortID:          "2bf413c8-41a9-4477-b505-333d5cbe8b55",
+		VipQosPolicyID:     "ec8ab56e-5a1d-4d07-8fe0-b3fce7dc2e22",
 		FlavorID:           "bba40eb2-ee8c-11e9-81b4-2a2ae2dbcce4",
 		Provider:           "haproxy",
 		AdminStateUp:       true,
@@ -200,6 +205,7 @@ var (
 		VipSubnetID:        "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
 		VipAddress:         "10.30.176.48",
 		VipPortID:          "2bf413c8-41a9-4477-b505-333d5cbe8b55",
+		VipQosPolicyID:     "ec8ab56e-5a1d-4d07-8fe0-b3fce7dc2e22",
 		FlavorID:           "bba40eb2-ee8c-11e9-81b4-2a2ae2dbcce4",
 		Provider:           "haproxy",
 		AdminStateUp:       true,
@@ -279,6 +285,7 @@ func HandleLoadbalancerCreationSuccessfully(t *testing.T, response string) {
 				"vip_port_id": "2bf413c8-41a9-4477-b505-333d5cbe8b55",
 				"vip_subnet_id": "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
 				"vip_address": "10.30.176.48",
+				"vip_qos_policy_id": "ec8ab56e-5a1d-4d07-8fe0-b3fce7dc2e22",
 				"flavor_id": "bba40eb2-ee8c-11e9-81b4-2a2ae2dbcce4",
 				"provider": "haproxy",
 				"admin_state_up": true,
@@ -334,6 +341,7 @@ func HandleLoadbalancerUpdateSuccessfully(t *testing.T) {
 		th.TestJSONRequest(t, r, `{
 			"loadbalancer": {
 				"name": "NewLoadbalancerName",
+				"vip_qos_policy_id": "ec8ab56e-5a1d-4d07-8fe0-b3fce7dc2e22",
 				"tags": ["test"]
 			}
 		}`)
@@ -362,3 +370,22 @@ func HandleLoadbalancerFailoverSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// HandleLoadbalancerGetProvisioningStatuses sets up the test server to respond
+// to loadbalancer Get requests with the given provisioning statuses in turn,
+// repeating the last one.
+func HandleLoadbalancerGetProvisioningStatuses(t *testing.T, statuses ...string) {
+	calls := 0
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+
+		fmt.Fprint(w, strings.Replace(SingleLoadbalancerBody, "PENDING_CREATE", status, 1))
+	})
+}
@@ -59,14 +59,15 @@ func TestCreateLoadbalancer(t *testing.T) {
 	HandleLoadbalancerCreationSuccessfully(t, SingleLoadbalancerBody)
 
 	actual, err := loadbalancers.Create(fake.ServiceClient(), loadbalancers.CreateOpts{
-		Name:         "db_lb",
-		AdminStateUp: gophercloud.Enabled,
-		VipPortID:    "2bf413c8-41a9-4477-b505-333d5cbe8b55",
-		VipSubnetID:  "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
-		VipAddress:   "10.30.176.48",
-		FlavorID:     "bba40eb2-ee8c-11e9-81b4-2a2ae2dbcce4",
-		Provider:     "haproxy",
-		Tags:         []string{"test", "stage"},
+		Name:           "db_lb",
+		AdminStateUp:   gophercloud.Enabled,
+		VipPortID:      "2bf413c8-41a9-4477-b505-333d5cbe8b55",
+		VipSubnetID:    "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
+		VipAddress:     "10.30.176.48",
+		VipQosPolicyID: "ec8ab56e-5a1d-4d07-8fe0-b3fce7dc2e22",
+		FlavorID:       "bba40eb2-ee8c-11e9-81b4-2a2ae2dbcce4",
+		Provider:       "haproxy",
+		Tags:           []string{"test", "stage"},
 	}).Extract()
 	th.AssertNoErr(t, err)
 
@@ -117,10 +118,12 @@ func TestUpdateLoadbalancer(t *testing.T) {
 
 	client := fake.ServiceClient()
 	name := "NewLoadbalancerName"
+	qosPolicyID := "ec8ab56e-5a1d-4d07-8fe0-b3fce7dc2e22"
 	tags := []string{"test"}
 	actual, err := loadbalancers.Update(client, "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", loadbalancers.UpdateOpts{
-		Name: &name,
-		Tags: &tags,
+		Name:           &name,
+		VipQosPolicyID: &qosPolicyID,
+		Tags:           &tags,
 	}).Extract()
 	if err != nil {
 		t.Fatalf("Unexpected Update error: %v", err)
@@ -169,3 +172,24 @@ func TestFailoverLoadbalancer(t *testing.T) {
 	res := loadbalancers.Failover(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerGetProvisioningStatuses(t, "PENDING_CREATE", "ACTIVE")
+
+	err := loadbalancers.WaitForStatus(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "ACTIVE", 10)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerGetProvisioningStatuses(t, "ERROR")
+
+	err := loadbalancers.WaitForStatus(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "ACTIVE", 10)
+	failed, ok := err.(loadbalancers.ErrLoadBalancerFailed)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", failed.ID)
+	th.AssertEquals(t, "ERROR", failed.ProvisioningStatus)
+}
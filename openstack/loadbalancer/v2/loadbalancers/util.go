@@ -0,0 +1,26 @@
+package loadbalancers
+
+import "github.com/gophercloud/gophercloud"
+
+// WaitForStatus will continually poll a load balancer until its provisioning
+// status reaches the specified one, e.g. "ACTIVE". It fails early if the
+// provisioning status becomes "ERROR". It will do this for at most the number
+// of seconds specified.
+func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
+	return gophercloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.ProvisioningStatus == status {
+			return true, nil
+		}
+
+		if current.ProvisioningStatus == "ERROR" {
+			return false, ErrLoadBalancerFailed{ID: id, ProvisioningStatus: current.ProvisioningStatus}
+		}
+
+		return false, nil
+	})
+}
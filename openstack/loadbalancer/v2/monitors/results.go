@@ -105,6 +105,21 @@ func (r MonitorPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r MonitorPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"healthmonitors_links"`
+	}
+
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a MonitorPage struct is empty.
 func (r MonitorPage) IsEmpty() (bool, error) {
 	is, err := ExtractMonitors(r)
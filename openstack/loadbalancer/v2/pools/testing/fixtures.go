@@ -174,6 +174,27 @@ func HandlePoolCreationSuccessfully(t *testing.T, response string) {
 	})
 }
 
+// HandlePoolSourceIPPortCreationSuccessfully sets up the test server to
+// respond to the creation of a pool balanced by source IP and port.
+func HandlePoolSourceIPPortCreationSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/v2.0/lbaas/pools", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"pool": {
+			        "lb_algorithm": "SOURCE_IP_PORT",
+			        "protocol": "TCP",
+			        "name": "Example pool",
+			        "loadbalancer_id": "79e05663-7f03-45d2-a092-8b94062f22ab"
+			}
+		}`)
+
+		w.WriteHeader(http.StatusAccepted)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, response)
+	})
+}
+
 // HandlePoolGetSuccessfully sets up the test server to respond to a pool Get request.
 func HandlePoolGetSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/v2.0/lbaas/pools/c3741b06-df4d-4715-b142-276b6bce75ab", func(w http.ResponseWriter, r *http.Request) {
@@ -69,6 +69,20 @@ func TestCreatePool(t *testing.T) {
 	th.CheckDeepEquals(t, PoolDb, *actual)
 }
 
+func TestCreatePoolSourceIPPort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePoolSourceIPPortCreationSuccessfully(t, SinglePoolBody)
+
+	_, err := pools.Create(fake.ServiceClient(), pools.CreateOpts{
+		LBMethod:       pools.LBMethodSourceIpPort,
+		Protocol:       pools.ProtocolTCP,
+		Name:           "Example pool",
+		LoadbalancerID: "79e05663-7f03-45d2-a092-8b94062f22ab",
+	}).Extract()
+	th.AssertNoErr(t, err)
+}
+
 func TestGetPool(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -34,6 +34,19 @@ func (r ProviderPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r ProviderPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"providers_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a ProviderPage struct is empty.
 func (r ProviderPage) IsEmpty() (bool, error) {
 	is, err := ExtractProviders(r)
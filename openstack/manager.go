@@ -0,0 +1,161 @@
+package openstack
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Cloud describes how a Manager connects to a single cloud.
+type Cloud struct {
+	// AuthOptions are used to authenticate against the cloud. Set AllowReauth
+	// for long running programs.
+	AuthOptions gophercloud.AuthOptions
+
+	// Region is the region used when none is requested.
+	Region string
+
+	// Availability is the endpoint availability of the service clients, e.g.
+	// gophercloud.AvailabilityInternal. It defaults to public.
+	Availability gophercloud.Availability
+}
+
+// ServiceClientFunc creates a service client, e.g. NewComputeV2.
+type ServiceClientFunc func(*gophercloud.ProviderClient, gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error)
+
+// Manager holds the provider clients of several clouds and hands out service
+// clients by cloud, region and service. A cloud is authenticated the first
+// time one of its clients is requested, and its provider client is reused
+// afterwards. A Manager is safe for concurrent use.
+//
+// The AuthOptions of a Cloud can be built from a clouds.yaml file with the
+// clientconfig package of github.com/gophercloud/utils.
+type Manager struct {
+	// HTTPClient is the HTTP client shared by the provider clients of all
+	// clouds. It must be set before any client is requested.
+	HTTPClient http.Client
+
+	// UserAgent is prepended to the User-Agent header of all requests, if set.
+	// It must be set before any client is requested.
+	UserAgent string
+
+	mut    sync.RWMutex
+	clouds map[string]*managedCloud
+}
+
+type managedCloud struct {
+	Cloud
+
+	mut      sync.Mutex
+	provider *gophercloud.ProviderClient
+}
+
+// NewManager creates a Manager for the given clouds, keyed by name.
+func NewManager(clouds map[string]Cloud) *Manager {
+	m := &Manager{
+		clouds: make(map[string]*managedCloud, len(clouds)),
+	}
+	for name, cloud := range clouds {
+		m.clouds[name] = &managedCloud{Cloud: cloud}
+	}
+	return m
+}
+
+// AddCloud adds a cloud to the Manager, replacing any cloud of the same name
+// along with its provider client.
+func (m *Manager) AddCloud(name string, cloud Cloud) {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+
+	if m.clouds == nil {
+		m.clouds = make(map[string]*managedCloud)
+	}
+	m.clouds[name] = &managedCloud{Cloud: cloud}
+}
+
+// Clouds returns the sorted names of the clouds of the Manager.
+func (m *Manager) Clouds() []string {
+	m.mut.RLock()
+	defer m.mut.RUnlock()
+
+	names := make([]string, 0, len(m.clouds))
+	for name := range m.clouds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ProviderClient returns the authenticated provider client of a cloud. The
+// cloud is authenticated on the first call. If authentication fails, the
+// next call tries again.
+func (m *Manager) ProviderClient(cloud string) (*gophercloud.ProviderClient, error) {
+	c, err := m.cloud(cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if c.provider != nil {
+		return c.provider, nil
+	}
+
+	provider, err := NewClient(c.AuthOptions.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	provider.HTTPClient = m.HTTPClient
+	if m.UserAgent != "" {
+		provider.UserAgent.Prepend(m.UserAgent)
+	}
+
+	err = Authenticate(provider, c.AuthOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	c.provider = provider
+	return provider, nil
+}
+
+// ServiceClient returns a service client of a cloud in the given region. An
+// empty region selects the Region of the cloud. newClient creates the service
+// client, e.g.:
+//
+//	client, err := manager.ServiceClient("production", "RegionOne", openstack.NewComputeV2)
+func (m *Manager) ServiceClient(cloud, region string, newClient ServiceClientFunc) (*gophercloud.ServiceClient, error) {
+	c, err := m.cloud(cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := m.ProviderClient(cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	if region == "" {
+		region = c.Region
+	}
+
+	return newClient(provider, gophercloud.EndpointOpts{
+		Region:       region,
+		Availability: c.Availability,
+	})
+}
+
+func (m *Manager) cloud(name string) (*managedCloud, error) {
+	m.mut.RLock()
+	defer m.mut.RUnlock()
+
+	c, ok := m.clouds[name]
+	if !ok {
+		return nil, gophercloud.ErrResourceNotFound{Name: name, ResourceType: "cloud"}
+	}
+	return c, nil
+}
@@ -170,6 +170,24 @@ func (r QueuePage) NextPageURL() (string, error) {
 	return nextPageURL(r.URL.String(), next)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r QueuePage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	next, err := gophercloud.ExtractPreviousURL(s.Links)
+	if err != nil {
+		return "", err
+	}
+	return nextPageURL(r.URL.String(), next)
+}
+
 func (r *QueueDetails) UnmarshalJSON(b []byte) error {
 	type tmp QueueDetails
 	var s struct {
@@ -0,0 +1,95 @@
+/*
+Package subscriptions provides information and interaction with the
+subscriptions through the OpenStack Messaging (Zaqar) service.
+
+Example to List Subscriptions
+
+	listOpts := subscriptions.ListOpts{
+		Limit: 10,
+	}
+
+	queueName := "my_queue"
+
+	pager := subscriptions.List(client, queueName, listOpts)
+
+	err = pager.EachPage(func(page pagination.Page) (bool, error) {
+		allSubscriptions, err := subscriptions.ExtractSubscriptions(page)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, subscription := range allSubscriptions {
+			fmt.Printf("%+v\n", subscription)
+		}
+
+		return true, nil
+	})
+
+Example to Create a Subscription
+
+	createOpts := subscriptions.CreateOpts{
+		Subscriber: "mailto:test@example.com",
+		TTL:        3600,
+		Options: map[string]interface{}{
+			"from":    "zaqar@example.com",
+			"subject": "New message",
+		},
+	}
+
+	queueName := "my_queue"
+
+	subscriptionID, err := subscriptions.Create(client, queueName, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get a Subscription
+
+	queueName := "my_queue"
+	subscriptionID := "57692ab13990b48c644bb7e6"
+
+	subscription, err := subscriptions.Get(client, queueName, subscriptionID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Subscription
+
+	updateOpts := subscriptions.UpdateOpts{
+		TTL: 7200,
+	}
+
+	queueName := "my_queue"
+	subscriptionID := "57692ab13990b48c644bb7e6"
+
+	err := subscriptions.Update(client, queueName, subscriptionID, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Confirm a Subscription
+
+	confirmed := true
+	confirmOpts := subscriptions.ConfirmOpts{
+		Confirmed: &confirmed,
+	}
+
+	queueName := "my_queue"
+	subscriptionID := "57692ab13990b48c644bb7e6"
+
+	err := subscriptions.Confirm(client, queueName, subscriptionID, confirmOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Subscription
+
+	queueName := "my_queue"
+	subscriptionID := "57692ab13990b48c644bb7e6"
+
+	err := subscriptions.Delete(client, queueName, subscriptionID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package subscriptions
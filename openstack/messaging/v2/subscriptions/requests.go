@@ -0,0 +1,169 @@
+package subscriptions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSubscriptionListQuery() (string, error)
+}
+
+// ListOpts params to be used with List.
+type ListOpts struct {
+	// Limit instructs List to refrain from sending excessively large lists of subscriptions.
+	Limit int `q:"limit,omitempty"`
+
+	// Marker and Limit control paging. Marker instructs List where to start listing from.
+	Marker string `q:"marker,omitempty"`
+}
+
+// ToSubscriptionListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSubscriptionListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List instructs OpenStack to provide a list of subscriptions of a queue.
+func List(client *gophercloud.ServiceClient, queueName string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client, queueName)
+	if opts != nil {
+		query, err := opts.ToSubscriptionListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return SubscriptionPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSubscriptionCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the subscription creation parameters.
+type CreateOpts struct {
+	// The subscriber notified of new messages. The HTTP(S), mail and trust
+	// schemes are supported, for example "http://example.com/notify" or
+	// "mailto:user@example.com".
+	Subscriber string `json:"subscriber" required:"true"`
+
+	// The time to live of the subscription in seconds.
+	TTL int `json:"ttl,omitempty"`
+
+	// The subscriber specific options, for example "from" and "subject" for
+	// mail subscribers.
+	Options map[string]interface{} `json:"options,omitempty"`
+}
+
+// ToSubscriptionCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToSubscriptionCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create subscribes to the messages of the specified queue.
+func Create(client *gophercloud.ServiceClient, queueName string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSubscriptionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(createURL(client, queueName), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Get queries the specified subscription of the specified queue.
+func Get(client *gophercloud.ServiceClient, queueName string, subscriptionID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, queueName, subscriptionID), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToSubscriptionUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the subscription update parameters.
+type UpdateOpts struct {
+	// The subscriber notified of new messages.
+	Subscriber string `json:"subscriber,omitempty"`
+
+	// The time to live of the subscription in seconds.
+	TTL int `json:"ttl,omitempty"`
+
+	// The subscriber specific options.
+	Options map[string]interface{} `json:"options,omitempty"`
+}
+
+// ToSubscriptionUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToSubscriptionUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update updates the specified subscription of the specified queue.
+func Update(client *gophercloud.ServiceClient, queueName string, subscriptionID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToSubscriptionUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Patch(updateURL(client, queueName, subscriptionID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Delete removes the specified subscription of the specified queue.
+func Delete(client *gophercloud.ServiceClient, queueName string, subscriptionID string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, queueName, subscriptionID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ConfirmOptsBuilder allows extensions to add additional parameters to the
+// Confirm request.
+type ConfirmOptsBuilder interface {
+	ToSubscriptionConfirmMap() (map[string]interface{}, error)
+}
+
+// ConfirmOpts specifies the subscription confirmation parameters.
+type ConfirmOpts struct {
+	// Confirmed indicates whether the subscription is confirmed or
+	// unsubscribed.
+	Confirmed *bool `json:"confirmed" required:"true"`
+}
+
+// ToSubscriptionConfirmMap constructs a request body from ConfirmOpts.
+func (opts ConfirmOpts) ToSubscriptionConfirmMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Confirm confirms or unsubscribes the specified subscription of the
+// specified queue.
+func Confirm(client *gophercloud.ServiceClient, queueName string, subscriptionID string, opts ConfirmOptsBuilder) (r ConfirmResult) {
+	b, err := opts.ToSubscriptionConfirmMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(confirmURL(client, queueName, subscriptionID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,143 @@
+package subscriptions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// commonResult is the response of a base result.
+type commonResult struct {
+	gophercloud.Result
+}
+
+// CreateResult is the response of a Create operations.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// GetResult is the response of a Get operations.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the response of a Update operations.
+type UpdateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult is the result from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ConfirmResult is the response of a Confirm operations.
+type ConfirmResult struct {
+	gophercloud.ErrResult
+}
+
+// SubscriptionPage contains a single page of all subscriptions from a List
+// operation.
+type SubscriptionPage struct {
+	pagination.LinkedPageBase
+}
+
+// Subscription represents a subscription to the messages of a queue.
+type Subscription struct {
+	// ID is the unique identifier of the subscription.
+	ID string `json:"id"`
+
+	// Age is how long the subscription has existed, in seconds.
+	Age int `json:"age"`
+
+	// Source is the name of the subscribed queue.
+	Source string `json:"source"`
+
+	// Subscriber is the URI notified of new messages, for example
+	// "http://example.com/notify" or "mailto:user@example.com".
+	Subscriber string `json:"subscriber"`
+
+	// TTL is the time to live of the subscription, in seconds.
+	TTL int `json:"ttl"`
+
+	// Options are the subscriber specific options.
+	Options map[string]interface{} `json:"options"`
+
+	// Confirmed indicates whether the subscription was confirmed by the
+	// subscriber.
+	Confirmed bool `json:"confirmed"`
+}
+
+// Extract interprets a CreateResult as the ID of the created subscription.
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		SubscriptionID string `json:"subscription_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SubscriptionID, err
+}
+
+// Extract interprets any commonResult as a Subscription.
+func (r commonResult) Extract() (*Subscription, error) {
+	var s *Subscription
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ExtractSubscriptions interprets the results of a single page from a List()
+// call, producing a slice of Subscription entities.
+func ExtractSubscriptions(r pagination.Page) ([]Subscription, error) {
+	var s struct {
+		Subscriptions []Subscription `json:"subscriptions"`
+	}
+	err := (r.(SubscriptionPage)).ExtractInto(&s)
+	return s.Subscriptions, err
+}
+
+// IsEmpty determines if a SubscriptionPage contains any results.
+func (r SubscriptionPage) IsEmpty() (bool, error) {
+	s, err := ExtractSubscriptions(r)
+	return len(s) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r SubscriptionPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	next, err := gophercloud.ExtractNextURL(s.Links)
+	if err != nil {
+		return "", err
+	}
+	if next == "" {
+		return "", nil
+	}
+	return nextPageURL(r.URL.String(), next)
+}
+
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r SubscriptionPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	next, err := gophercloud.ExtractPreviousURL(s.Links)
+	if err != nil {
+		return "", err
+	}
+	if next == "" {
+		return "", nil
+	}
+	return nextPageURL(r.URL.String(), next)
+}
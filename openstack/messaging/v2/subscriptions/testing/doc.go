@@ -0,0 +1,2 @@
+// Subscriptions unit tests
+package testing
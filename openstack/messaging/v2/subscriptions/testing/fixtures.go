@@ -0,0 +1,177 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/messaging/v2/subscriptions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// QueueName is the name of the queue
+var QueueName = "FakeTestQueue"
+
+// SubscriptionID is the id of the subscription
+var SubscriptionID = "57692ab13990b48c644bb7e6"
+
+// CreateSubscriptionRequest is a sample request to create a subscription.
+const CreateSubscriptionRequest = `
+{
+	"subscriber": "mailto:test@example.com",
+	"ttl": 3600,
+	"options": {
+		"from": "zaqar@example.com"
+	}
+}`
+
+// CreateSubscriptionResponse is a sample response to a create subscription.
+const CreateSubscriptionResponse = `
+{
+	"subscription_id": "57692ab13990b48c644bb7e6"
+}`
+
+// GetSubscriptionResponse is a sample response to a get subscription.
+const GetSubscriptionResponse = `
+{
+	"age": 3,
+	"id": "57692ab13990b48c644bb7e6",
+	"subscriber": "mailto:test@example.com",
+	"source": "FakeTestQueue",
+	"ttl": 3600,
+	"options": {
+		"from": "zaqar@example.com"
+	},
+	"confirmed": false
+}`
+
+// ListSubscriptionsResponse is a sample response to a list subscriptions.
+const ListSubscriptionsResponse = `
+{
+	"subscriptions": [
+		{
+			"age": 3,
+			"id": "57692ab13990b48c644bb7e6",
+			"subscriber": "mailto:test@example.com",
+			"source": "FakeTestQueue",
+			"ttl": 3600,
+			"options": {
+				"from": "zaqar@example.com"
+			},
+			"confirmed": false
+		}
+	],
+	"links": [
+		{
+			"href": "/v2/queues/FakeTestQueue/subscriptions?marker=57692ab13990b48c644bb7e6",
+			"rel": "next"
+		}
+	]
+}`
+
+// UpdateSubscriptionRequest is a sample request to update a subscription.
+const UpdateSubscriptionRequest = `
+{
+	"ttl": 7200
+}`
+
+// ConfirmSubscriptionRequest is a sample request to confirm a subscription.
+const ConfirmSubscriptionRequest = `
+{
+	"confirmed": true
+}`
+
+// FirstSubscription is the result of a get subscription.
+var FirstSubscription = subscriptions.Subscription{
+	Age:        3,
+	ID:         SubscriptionID,
+	Subscriber: "mailto:test@example.com",
+	Source:     QueueName,
+	TTL:        3600,
+	Options:    map[string]interface{}{"from": "zaqar@example.com"},
+	Confirmed:  false,
+}
+
+// ExpectedSubscriptionsSlice is the expected result of a list subscriptions.
+var ExpectedSubscriptionsSlice = []subscriptions.Subscription{FirstSubscription}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/v2/queues/%s/subscriptions", QueueName),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestJSONRequest(t, r, CreateSubscriptionRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, CreateSubscriptionResponse)
+		})
+}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/v2/queues/%s/subscriptions", QueueName),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			next := r.RequestURI
+
+			switch next {
+			case fmt.Sprintf("/v2/queues/%s/subscriptions?limit=1", QueueName):
+				fmt.Fprint(w, ListSubscriptionsResponse)
+			case fmt.Sprintf("/v2/queues/%s/subscriptions?marker=%s", QueueName, SubscriptionID):
+				fmt.Fprint(w, `{ "subscriptions": [] }`)
+			}
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/v2/queues/%s/subscriptions/%s", QueueName, SubscriptionID),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprint(w, GetSubscriptionResponse)
+		})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/v2/queues/%s/subscriptions/%s", QueueName, SubscriptionID),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PATCH")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestJSONRequest(t, r, UpdateSubscriptionRequest)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/v2/queues/%s/subscriptions/%s", QueueName, SubscriptionID),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
+
+// HandleConfirmSuccessfully configures the test server to respond to a Confirm request.
+func HandleConfirmSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/v2/queues/%s/subscriptions/%s/confirm", QueueName, SubscriptionID),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PUT")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestJSONRequest(t, r, ConfirmSubscriptionRequest)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
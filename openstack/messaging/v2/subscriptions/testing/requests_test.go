@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/messaging/v2/subscriptions"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := subscriptions.CreateOpts{
+		Subscriber: "mailto:test@example.com",
+		TTL:        3600,
+		Options:    map[string]interface{}{"from": "zaqar@example.com"},
+	}
+
+	actual, err := subscriptions.Create(fake.ServiceClient(), QueueName, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, SubscriptionID, actual)
+}
+
+func TestCreateRequiresSubscriber(t *testing.T) {
+	res := subscriptions.Create(fake.ServiceClient(), QueueName, subscriptions.CreateOpts{TTL: 3600})
+	if res.Err == nil {
+		t.Fatal("expected error for missing subscriber")
+	}
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := subscriptions.ListOpts{
+		Limit: 1,
+	}
+
+	count := 0
+	err := subscriptions.List(fake.ServiceClient(), QueueName, listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		actual, err := subscriptions.ExtractSubscriptions(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedSubscriptionsSlice, actual)
+		count++
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := subscriptions.Get(fake.ServiceClient(), QueueName, SubscriptionID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstSubscription, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := subscriptions.UpdateOpts{
+		TTL: 7200,
+	}
+
+	err := subscriptions.Update(fake.ServiceClient(), QueueName, SubscriptionID, updateOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := subscriptions.Delete(fake.ServiceClient(), QueueName, SubscriptionID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestConfirm(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleConfirmSuccessfully(t)
+
+	confirmed := true
+	confirmOpts := subscriptions.ConfirmOpts{
+		Confirmed: &confirmed,
+	}
+
+	err := subscriptions.Confirm(fake.ServiceClient(), QueueName, SubscriptionID, confirmOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,53 @@
+package subscriptions
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	apiVersion = "v2"
+	apiName    = "queues"
+)
+
+func commonURL(client *gophercloud.ServiceClient, queueName string) string {
+	return client.ServiceURL(apiVersion, apiName, queueName, "subscriptions")
+}
+
+func createURL(client *gophercloud.ServiceClient, queueName string) string {
+	return commonURL(client, queueName)
+}
+
+func listURL(client *gophercloud.ServiceClient, queueName string) string {
+	return commonURL(client, queueName)
+}
+
+func getURL(client *gophercloud.ServiceClient, queueName string, subscriptionID string) string {
+	return client.ServiceURL(apiVersion, apiName, queueName, "subscriptions", subscriptionID)
+}
+
+func updateURL(client *gophercloud.ServiceClient, queueName string, subscriptionID string) string {
+	return client.ServiceURL(apiVersion, apiName, queueName, "subscriptions", subscriptionID)
+}
+
+func deleteURL(client *gophercloud.ServiceClient, queueName string, subscriptionID string) string {
+	return client.ServiceURL(apiVersion, apiName, queueName, "subscriptions", subscriptionID)
+}
+
+func confirmURL(client *gophercloud.ServiceClient, queueName string, subscriptionID string) string {
+	return client.ServiceURL(apiVersion, apiName, queueName, "subscriptions", subscriptionID, "confirm")
+}
+
+// builds next page full url based on current url
+func nextPageURL(currentURL string, next string) (string, error) {
+	base, err := url.Parse(currentURL)
+	if err != nil {
+		return "", err
+	}
+	rel, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	return base.ResolveReference(rel).String(), nil
+}
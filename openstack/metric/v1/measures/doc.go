@@ -0,0 +1,64 @@
+/*
+Package measures provides the ability to push and query measures of a metric
+through the OpenStack Metric (Gnocchi) v1 API.
+
+Example of Listing measures of a metric
+
+	start := time.Date(2018, 1, 10, 0, 0, 0, 0, time.UTC)
+	listOpts := measures.ListOpts{
+		Start:       &start,
+		Aggregation: "max",
+		Granularity: "1h",
+	}
+
+	metricID := "9e5a6441-1044-4181-b66e-34e180753040"
+	allPages, err := measures.List(metricClient, metricID, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allMeasures, err := measures.ExtractMeasures(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, measure := range allMeasures {
+		fmt.Printf("%+v\n", measure)
+	}
+
+Example of Pushing measures to a metric
+
+	timestamp := time.Date(2018, 1, 10, 1, 0, 0, 0, time.UTC)
+	createOpts := measures.CreateOpts{
+		Measures: []measures.MeasureOpts{
+			{
+				Timestamp: &timestamp,
+				Value:     101.2,
+			},
+		},
+	}
+
+	metricID := "9e5a6441-1044-4181-b66e-34e180753040"
+	err := measures.Create(metricClient, metricID, createOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Pushing measures to several metrics at once
+
+	timestamp := time.Date(2018, 1, 10, 1, 0, 0, 0, time.UTC)
+	batchOpts := measures.BatchCreateMetricsOpts{
+		"9e5a6441-1044-4181-b66e-34e180753040": {
+			{Timestamp: &timestamp, Value: 101.2},
+		},
+		"777a01d6-4694-49cb-b86a-5ba9fd4e609e": {
+			{Timestamp: &timestamp, Value: 12},
+		},
+	}
+
+	err := measures.BatchCreateMetrics(metricClient, batchOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package measures
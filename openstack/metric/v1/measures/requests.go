@@ -0,0 +1,196 @@
+package measures
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMeasureListQuery() (string, error)
+}
+
+// ListOpts allows to provide additional options to the Metric measures List
+// request.
+type ListOpts struct {
+	// Refresh can be used to force any unprocessed measures to be handled in
+	// the Metric service before returning the result.
+	Refresh bool `q:"refresh"`
+
+	// Start is a timestamp of the first measure to retrieve.
+	Start *time.Time
+
+	// Stop is a timestamp of the last measure to retrieve.
+	Stop *time.Time
+
+	// Aggregation is the aggregation method to retrieve measures for,
+	// for example "mean", "max" or "sum". It defaults to "mean".
+	Aggregation string `q:"aggregation"`
+
+	// Granularity is the granularity of the aggregation to retrieve, in
+	// seconds or as a timespan such as "1h".
+	Granularity string `q:"granularity"`
+
+	// Resample allows to resample the aggregated measures to a coarser
+	// granularity. Granularity must be set with Resample.
+	Resample string `q:"resample"`
+}
+
+// ToMeasureListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMeasureListQuery() (string, error) {
+	if opts.Resample != "" && opts.Granularity == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "measures.ListOpts.Granularity"
+		return "", err
+	}
+
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+
+	if opts.Start != nil {
+		params.Add("start", opts.Start.Format(time.RFC3339Nano))
+	}
+
+	if opts.Stop != nil {
+		params.Add("stop", opts.Stop.Format(time.RFC3339Nano))
+	}
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over the aggregated
+// measures of a single metric.
+func List(c *gophercloud.ServiceClient, metricID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c, metricID)
+	if opts != nil {
+		query, err := opts.ToMeasureListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return MeasurePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// MeasureOpts represents a single measure to push to the Metric service.
+type MeasureOpts struct {
+	// Timestamp is the time at which the measure was taken.
+	Timestamp *time.Time `json:"-"`
+
+	// Value is the value of the measure.
+	Value float64 `json:"value"`
+}
+
+// ToMap constructs a request body from MeasureOpts.
+func (opts MeasureOpts) ToMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Timestamp != nil {
+		b["timestamp"] = opts.Timestamp.Format(time.RFC3339Nano)
+	}
+
+	return b, nil
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToMeasureCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies a set of measures to push to a single metric.
+type CreateOpts struct {
+	// Measures is the set of measures to push.
+	Measures []MeasureOpts `json:"-"`
+}
+
+// ToMeasureCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToMeasureCreateMap() (map[string]interface{}, error) {
+	if len(opts.Measures) == 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "measures.CreateOpts.Measures"
+		return nil, err
+	}
+
+	measures := make([]map[string]interface{}, len(opts.Measures))
+	for i, m := range opts.Measures {
+		b, err := m.ToMap()
+		if err != nil {
+			return nil, err
+		}
+		measures[i] = b
+	}
+
+	return map[string]interface{}{"measures": measures}, nil
+}
+
+// Create requests the pushing of measures to the specified metric.
+// Measures are processed asynchronously by the Metric service.
+func Create(c *gophercloud.ServiceClient, metricID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToMeasureCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c, metricID), b["measures"], nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// BatchCreateMetricsOptsBuilder allows extensions to add additional
+// parameters to the BatchCreateMetrics request.
+type BatchCreateMetricsOptsBuilder interface {
+	ToMeasuresBatchCreateMetricsMap() (map[string]interface{}, error)
+}
+
+// BatchCreateMetricsOpts maps metric IDs to the measures that should be
+// pushed to each of them.
+type BatchCreateMetricsOpts map[string][]MeasureOpts
+
+// ToMeasuresBatchCreateMetricsMap constructs a request body from
+// BatchCreateMetricsOpts.
+func (opts BatchCreateMetricsOpts) ToMeasuresBatchCreateMetricsMap() (map[string]interface{}, error) {
+	b := make(map[string]interface{}, len(opts))
+	for metricID, metricMeasures := range opts {
+		measures := make([]map[string]interface{}, len(metricMeasures))
+		for i, m := range metricMeasures {
+			measure, err := m.ToMap()
+			if err != nil {
+				return nil, err
+			}
+			measures[i] = measure
+		}
+		b[metricID] = measures
+	}
+
+	return b, nil
+}
+
+// BatchCreateMetrics requests the pushing of measures to several metrics in
+// a single request.
+func BatchCreateMetrics(c *gophercloud.ServiceClient, opts BatchCreateMetricsOptsBuilder) (r BatchCreateMetricsResult) {
+	b, err := opts.ToMeasuresBatchCreateMetricsMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(batchCreateMetricsURL(c), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,94 @@
+package measures
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// CreateResult is the response from a Create operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type CreateResult struct {
+	gophercloud.ErrResult
+}
+
+// BatchCreateMetricsResult is the response from a BatchCreateMetrics
+// operation. Call its ExtractErr method to determine if the request succeeded
+// or failed.
+type BatchCreateMetricsResult struct {
+	gophercloud.ErrResult
+}
+
+// Measure is an aggregated datapoint of a metric.
+type Measure struct {
+	// Timestamp is the beginning of the aggregation period.
+	Timestamp time.Time `json:"-"`
+
+	// Granularity is the aggregation period in seconds.
+	Granularity float64 `json:"-"`
+
+	// Value is the aggregated value for the period.
+	Value float64 `json:"-"`
+}
+
+// UnmarshalJSON helps to unmarshal a Measure from the
+// [timestamp, granularity, value] list returned by the Metric v1 API.
+func (r *Measure) UnmarshalJSON(b []byte) error {
+	var s []interface{}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if len(s) != 3 {
+		return fmt.Errorf("failed to unmarshal measure: expected 3 elements, got %d", len(s))
+	}
+
+	ts, ok := s[0].(string)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal measure timestamp: %v", s[0])
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return err
+	}
+
+	granularity, ok := s[1].(float64)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal measure granularity: %v", s[1])
+	}
+
+	value, ok := s[2].(float64)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal measure value: %v", s[2])
+	}
+
+	r.Timestamp = t.UTC()
+	r.Granularity = granularity
+	r.Value = value
+
+	return nil
+}
+
+// MeasurePage abstracts the raw results of making a List() request against
+// the Metric v1 API.
+type MeasurePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a MeasurePage struct is empty.
+func (r MeasurePage) IsEmpty() (bool, error) {
+	measures, err := ExtractMeasures(r)
+	return len(measures) == 0, err
+}
+
+// ExtractMeasures interprets the results of a single page from a List() call,
+// producing a slice of Measure structs.
+func ExtractMeasures(r pagination.Page) ([]Measure, error) {
+	var s []Measure
+	err := (r.(MeasurePage)).ExtractInto(&s)
+	return s, err
+}
@@ -0,0 +1,2 @@
+// metric_measures_v1
+package testing
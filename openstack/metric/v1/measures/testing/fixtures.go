@@ -0,0 +1,129 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/metric/v1/measures"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListResult represents a raw server response from a List request.
+const ListResult = `
+[
+    [
+        "2018-01-10T12:00:00+00:00",
+        3600.0,
+        15.0
+    ],
+    [
+        "2018-01-10T13:00:00+00:00",
+        3600.0,
+        10.7
+    ],
+    [
+        "2018-01-10T14:00:00+00:00",
+        3600.0,
+        107.8
+    ]
+]
+`
+
+// ListMeasuresExpected is an expected representation of the ListResult.
+var ListMeasuresExpected = []measures.Measure{
+	{
+		Timestamp:   time.Date(2018, 1, 10, 12, 0, 0, 0, time.UTC),
+		Granularity: 3600.0,
+		Value:       15.0,
+	},
+	{
+		Timestamp:   time.Date(2018, 1, 10, 13, 0, 0, 0, time.UTC),
+		Granularity: 3600.0,
+		Value:       10.7,
+	},
+	{
+		Timestamp:   time.Date(2018, 1, 10, 14, 0, 0, 0, time.UTC),
+		Granularity: 3600.0,
+		Value:       107.8,
+	},
+}
+
+// CreateRequest represents a request to push measures to a metric.
+const CreateRequest = `
+[
+    {
+        "timestamp": "2018-01-18T12:31:00Z",
+        "value": 101.2
+    },
+    {
+        "timestamp": "2018-01-18T14:32:00Z",
+        "value": 102
+    }
+]
+`
+
+// BatchCreateMetricsRequest represents a request to push measures to
+// several metrics.
+const BatchCreateMetricsRequest = `
+{
+    "777a01d6-4694-49cb-b86a-5ba9fd4e609e": [
+        {
+            "timestamp": "2018-01-18T12:31:00Z",
+            "value": 101.2
+        }
+    ],
+    "6dbc97c5-bfdf-47a2-b184-02e7fa348d21": [
+        {
+            "timestamp": "2018-01-18T12:31:00Z",
+            "value": 12
+        }
+    ]
+}
+`
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/metric/9e5a6441-1044-4181-b66e-34e180753040/measures", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"start":       "2018-01-10T12:00:00Z",
+			"stop":        "2018-01-10T15:00:00Z",
+			"aggregation": "max",
+			"granularity": "1h",
+			"refresh":     "true",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListResult)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/metric/9e5a6441-1044-4181-b66e-34e180753040/measures", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleBatchCreateMetricsSuccessfully configures the test server to respond
+// to a BatchCreateMetrics request.
+func HandleBatchCreateMetricsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/batch/metrics/measures", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, BatchCreateMetricsRequest)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,94 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/metric/v1/measures"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListMeasures(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	start := time.Date(2018, 1, 10, 12, 0, 0, 0, time.UTC)
+	stop := time.Date(2018, 1, 10, 15, 0, 0, 0, time.UTC)
+	listOpts := measures.ListOpts{
+		Start:       &start,
+		Stop:        &stop,
+		Aggregation: "max",
+		Granularity: "1h",
+		Refresh:     true,
+	}
+
+	allPages, err := measures.List(fake.ServiceClient(), "9e5a6441-1044-4181-b66e-34e180753040", listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := measures.ExtractMeasures(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ListMeasuresExpected, actual)
+}
+
+func TestListMeasuresResampleRequiresGranularity(t *testing.T) {
+	listOpts := measures.ListOpts{
+		Resample: "2h",
+	}
+
+	_, err := listOpts.ToMeasureListQuery()
+	if err == nil {
+		t.Fatal("expected error when Resample is set without Granularity")
+	}
+}
+
+func TestCreateMeasures(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	firstTimestamp := time.Date(2018, 1, 18, 12, 31, 0, 0, time.UTC)
+	secondTimestamp := time.Date(2018, 1, 18, 14, 32, 0, 0, time.UTC)
+	createOpts := measures.CreateOpts{
+		Measures: []measures.MeasureOpts{
+			{
+				Timestamp: &firstTimestamp,
+				Value:     101.2,
+			},
+			{
+				Timestamp: &secondTimestamp,
+				Value:     102,
+			},
+		},
+	}
+
+	res := measures.Create(fake.ServiceClient(), "9e5a6441-1044-4181-b66e-34e180753040", createOpts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestCreateMeasuresRequiresMeasures(t *testing.T) {
+	res := measures.Create(fake.ServiceClient(), "9e5a6441-1044-4181-b66e-34e180753040", measures.CreateOpts{})
+	if res.Err == nil {
+		t.Fatal("expected error when no measures are set")
+	}
+}
+
+func TestBatchCreateMetrics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBatchCreateMetricsSuccessfully(t)
+
+	timestamp := time.Date(2018, 1, 18, 12, 31, 0, 0, time.UTC)
+	batchOpts := measures.BatchCreateMetricsOpts{
+		"777a01d6-4694-49cb-b86a-5ba9fd4e609e": {
+			{Timestamp: &timestamp, Value: 101.2},
+		},
+		"6dbc97c5-bfdf-47a2-b184-02e7fa348d21": {
+			{Timestamp: &timestamp, Value: 12},
+		},
+	}
+
+	res := measures.BatchCreateMetrics(fake.ServiceClient(), batchOpts)
+	th.AssertNoErr(t, res.Err)
+}
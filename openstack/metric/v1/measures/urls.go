@@ -0,0 +1,15 @@
+package measures
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(c *gophercloud.ServiceClient, metricID string) string {
+	return c.ServiceURL("metric", metricID, "measures")
+}
+
+func createURL(c *gophercloud.ServiceClient, metricID string) string {
+	return c.ServiceURL("metric", metricID, "measures")
+}
+
+func batchCreateMetricsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("batch", "metrics", "measures")
+}
@@ -0,0 +1,55 @@
+/*
+Package metrics provides the ability to retrieve and manage metrics through
+the OpenStack Metric (Gnocchi) v1 API.
+
+Example of Listing metrics
+
+	listOpts := metrics.ListOpts{
+		ResourceID: "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+	}
+
+	allPages, err := metrics.List(metricClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allMetrics, err := metrics.ExtractMetrics(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, metric := range allMetrics {
+		fmt.Printf("%+v\n", metric)
+	}
+
+Example of Getting a metric
+
+	metricID := "0ddf61cf-3747-4f75-bf13-13c28ff03ae3"
+	metric, err := metrics.Get(metricClient, metricID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Creating a metric attached to a resource
+
+	createOpts := metrics.CreateOpts{
+		ArchivePolicyName: "medium",
+		Name:              "memory.usage",
+		ResourceID:        "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+		Unit:              "MB",
+	}
+
+	metric, err := metrics.Create(metricClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Deleting a metric
+
+	metricID := "0ddf61cf-3747-4f75-bf13-13c28ff03ae3"
+	err := metrics.Delete(metricClient, metricID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package metrics
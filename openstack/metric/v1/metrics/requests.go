@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMetricListQuery() (string, error)
+}
+
+// ListOpts allows the filtering, limiting and sorting of paginated
+// collections through the Metric v1 API.
+type ListOpts struct {
+	// Limit allows to limit the number of returned metrics.
+	Limit int `q:"limit"`
+
+	// Marker is the ID of the last metric on the previous page.
+	Marker string `q:"marker"`
+
+	// SortKey allows to sort metrics by the specified key.
+	SortKey string `q:"sort_key"`
+
+	// SortDir allows to sort metrics in the specified direction.
+	SortDir string `q:"sort_dir"`
+
+	// ArchivePolicyName filters metrics by the archive policy name.
+	ArchivePolicyName string `q:"archive_policy_name"`
+
+	// Creator filters metrics by the creator.
+	Creator string `q:"creator"`
+
+	// Name filters metrics by the metric name.
+	Name string `q:"name"`
+
+	// ResourceID filters metrics by the identifier of the associated resource.
+	ResourceID string `q:"resource_id"`
+
+	// Unit filters metrics by the unit of measurement.
+	Unit string `q:"unit"`
+}
+
+// ToMetricListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMetricListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// metrics.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToMetricListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		p := MetricPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// Get retrieves a specific metric based on its ID.
+func Get(c *gophercloud.ServiceClient, metricID string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, metricID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToMetricCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new metric.
+type CreateOpts struct {
+	// ArchivePolicyName is the name of the archive policy to use with the
+	// metric. If it is not set, the default archive policy rule is applied.
+	ArchivePolicyName string `json:"archive_policy_name,omitempty"`
+
+	// Name is a human-readable name for the metric.
+	Name string `json:"name,omitempty"`
+
+	// ResourceID is the identifier of the resource to attach the metric to.
+	// Name must be set if ResourceID is set.
+	ResourceID string `json:"resource_id,omitempty"`
+
+	// Unit is the unit of measurement for measures of the metric.
+	Unit string `json:"unit,omitempty"`
+}
+
+// ToMetricCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToMetricCreateMap() (map[string]interface{}, error) {
+	if opts.ResourceID != "" && opts.Name == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "metrics.CreateOpts.Name"
+		return nil, err
+	}
+
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create requests the creation of a new metric.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToMetricCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Delete accepts a unique ID and deletes the related metric along with all
+// of its measures.
+func Delete(c *gophercloud.ServiceClient, metricID string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, metricID), nil)
+	return
+}
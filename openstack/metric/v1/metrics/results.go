@@ -0,0 +1,139 @@
+package metrics
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/metric/v1/resources"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Metric.
+func (r commonResult) Extract() (*Metric, error) {
+	var s *Metric
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Metric.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Metric.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Metric is an entity storing aggregates identified by an UUID.
+type Metric struct {
+	// ArchivePolicy is the archive policy with which the metric is associated.
+	// It is only returned by the Get and Create operations.
+	ArchivePolicy ArchivePolicy `json:"archive_policy"`
+
+	// ArchivePolicyName is the name of the archive policy with which the
+	// metric is associated. It is only returned by the List operation.
+	ArchivePolicyName string `json:"archive_policy_name"`
+
+	// CreatedByProjectID contains the id of the project that created the metric.
+	CreatedByProjectID string `json:"created_by_project_id"`
+
+	// CreatedByUserID contains the id of the user that created the metric.
+	CreatedByUserID string `json:"created_by_user_id"`
+
+	// Creator shows who created the metric.
+	// Usually it contains concatenated string with values from
+	// "created_by_user_id" and "created_by_project_id" fields.
+	Creator string `json:"creator"`
+
+	// ID uniquely identifies the metric.
+	ID string `json:"id"`
+
+	// Name is a human-readable name for the metric.
+	Name string `json:"name"`
+
+	// Resource is the resource the metric is attached to.
+	// It is only returned by the Get and Create operations.
+	Resource *resources.Resource `json:"resource"`
+
+	// ResourceID is the identifier of the resource the metric is attached to.
+	ResourceID string `json:"resource_id"`
+
+	// Unit is the unit of measurement for measures of the metric.
+	Unit string `json:"unit"`
+}
+
+// ArchivePolicy describes how measures of a metric are aggregated and stored.
+type ArchivePolicy struct {
+	// AggregationMethods is a list of functions used to aggregate
+	// multiple measures into an aggregate.
+	AggregationMethods []string `json:"aggregation_methods"`
+
+	// BackWindow configures number of coarsest periods to keep.
+	BackWindow int `json:"back_window"`
+
+	// Definition is a list of parameters that configures the resolution
+	// of the stored aggregates.
+	Definition []ArchivePolicyDefinition `json:"definition"`
+
+	// Name is the name of the archive policy.
+	Name string `json:"name"`
+}
+
+// ArchivePolicyDefinition represents a single resolution of an archive policy.
+type ArchivePolicyDefinition struct {
+	// Granularity is the level of precision that must be kept when
+	// aggregating data.
+	Granularity string `json:"granularity"`
+
+	// Points is the number of aggregates kept at this granularity.
+	Points int `json:"points"`
+
+	// TimeSpan is the time period for which aggregates are kept.
+	TimeSpan string `json:"timespan"`
+}
+
+// MetricPage abstracts the raw results of making a List() request against
+// the Metric v1 API.
+//
+// As Metric v1 API pagination is based on the marker, you should use the
+// AllPages or EachPage methods to get all the results.
+type MetricPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty checks whether a MetricPage struct is empty.
+func (r MetricPage) IsEmpty() (bool, error) {
+	metrics, err := ExtractMetrics(r)
+	return len(metrics) == 0, err
+}
+
+// LastMarker returns the ID of the last metric in a MetricPage.
+func (r MetricPage) LastMarker() (string, error) {
+	metrics, err := ExtractMetrics(r)
+	if err != nil {
+		return "", err
+	}
+	if len(metrics) == 0 {
+		return "", nil
+	}
+	return metrics[len(metrics)-1].ID, nil
+}
+
+// ExtractMetrics interprets the results of a single page from a List() call,
+// producing a slice of Metric structs.
+func ExtractMetrics(r pagination.Page) ([]Metric, error) {
+	var s []Metric
+	err := (r.(MetricPage)).ExtractInto(&s)
+	return s, err
+}
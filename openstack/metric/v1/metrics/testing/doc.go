@@ -0,0 +1,2 @@
+// metric_metrics_v1
+package testing
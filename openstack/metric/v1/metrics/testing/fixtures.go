@@ -0,0 +1,217 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/metric/v1/metrics"
+	"github.com/gophercloud/gophercloud/openstack/metric/v1/resources"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListResult represents a raw server response from a List request.
+const ListResult = `
+[
+    {
+        "archive_policy_name": "medium",
+        "created_by_project_id": "3d40ca37723449118987b9f288f4ae84",
+        "created_by_user_id": "fdcfb420c09645e69e71ad9e5d6a4c2a",
+        "creator": "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+        "id": "777a01d6-4694-49cb-b86a-5ba9fd4e609e",
+        "name": "memory.usage",
+        "resource_id": "1f3a0724-1807-4bd1-81f9-ee18c8ff6ccc",
+        "unit": "MB"
+    },
+    {
+        "archive_policy_name": "low",
+        "created_by_project_id": "3d40ca37723449118987b9f288f4ae84",
+        "created_by_user_id": "fdcfb420c09645e69e71ad9e5d6a4c2a",
+        "creator": "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+        "id": "6dbc97c5-bfdf-47a2-b184-02e7fa348d21",
+        "name": "cpu.delta",
+        "resource_id": "c5dc0c24-8d88-4a8f-9cd2-3ab7e29d7b11",
+        "unit": "ns"
+    }
+]
+`
+
+// Metric1 is an expected representation of a first metric from the ListResult.
+var Metric1 = metrics.Metric{
+	ArchivePolicyName:  "medium",
+	CreatedByProjectID: "3d40ca37723449118987b9f288f4ae84",
+	CreatedByUserID:    "fdcfb420c09645e69e71ad9e5d6a4c2a",
+	Creator:            "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+	ID:                 "777a01d6-4694-49cb-b86a-5ba9fd4e609e",
+	Name:               "memory.usage",
+	ResourceID:         "1f3a0724-1807-4bd1-81f9-ee18c8ff6ccc",
+	Unit:               "MB",
+}
+
+// Metric2 is an expected representation of a second metric from the ListResult.
+var Metric2 = metrics.Metric{
+	ArchivePolicyName:  "low",
+	CreatedByProjectID: "3d40ca37723449118987b9f288f4ae84",
+	CreatedByUserID:    "fdcfb420c09645e69e71ad9e5d6a4c2a",
+	Creator:            "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+	ID:                 "6dbc97c5-bfdf-47a2-b184-02e7fa348d21",
+	Name:               "cpu.delta",
+	ResourceID:         "c5dc0c24-8d88-4a8f-9cd2-3ab7e29d7b11",
+	Unit:               "ns",
+}
+
+// GetResult represents a raw server response from a Get request.
+const GetResult = `
+{
+    "archive_policy": {
+        "aggregation_methods": [
+            "max",
+            "min"
+        ],
+        "back_window": 0,
+        "definition": [
+            {
+                "granularity": "1:00:00",
+                "points": 48,
+                "timespan": "2 days, 0:00:00"
+            },
+            {
+                "granularity": "1 day, 0:00:00",
+                "points": 30,
+                "timespan": "30 days, 0:00:00"
+            }
+        ],
+        "name": "precise"
+    },
+    "created_by_project_id": "3d40ca37723449118987b9f288f4ae84",
+    "created_by_user_id": "fdcfb420c09645e69e71ad9e5d6a4c2a",
+    "creator": "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+    "id": "0ddf61cf-3747-4f75-bf13-13c28ff03ae3",
+    "name": "network.incoming.packets.rate",
+    "resource": {
+        "created_by_project_id": "3d40ca37723449118987b9f288f4ae84",
+        "created_by_user_id": "fdcfb420c09645e69e71ad9e5d6a4c2a",
+        "creator": "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+        "ended_at": null,
+        "id": "75274f99-faf6-4112-a6d5-2794cb07c789",
+        "original_resource_id": "75274f99-faf6-4112-a6d5-2794cb07c789",
+        "project_id": "4154f08883334e0494c41155c33c0fc9",
+        "revision_end": null,
+        "revision_start": "2018-01-01T11:44:31.742031+00:00",
+        "started_at": "2018-01-01T11:44:31.742011+00:00",
+        "type": "compute_instance_network",
+        "user_id": "bd5874d666624b24a9f01c128871e4ac"
+    },
+    "unit": "packet/s"
+}
+`
+
+// NetworkMetric is an expected representation of the GetResult.
+var NetworkMetric = metrics.Metric{
+	ArchivePolicy: metrics.ArchivePolicy{
+		AggregationMethods: []string{"max", "min"},
+		BackWindow:         0,
+		Definition: []metrics.ArchivePolicyDefinition{
+			{
+				Granularity: "1:00:00",
+				Points:      48,
+				TimeSpan:    "2 days, 0:00:00",
+			},
+			{
+				Granularity: "1 day, 0:00:00",
+				Points:      30,
+				TimeSpan:    "30 days, 0:00:00",
+			},
+		},
+		Name: "precise",
+	},
+	CreatedByProjectID: "3d40ca37723449118987b9f288f4ae84",
+	CreatedByUserID:    "fdcfb420c09645e69e71ad9e5d6a4c2a",
+	Creator:            "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+	ID:                 "0ddf61cf-3747-4f75-bf13-13c28ff03ae3",
+	Name:               "network.incoming.packets.rate",
+	Resource: &resources.Resource{
+		CreatedByProjectID: "3d40ca37723449118987b9f288f4ae84",
+		CreatedByUserID:    "fdcfb420c09645e69e71ad9e5d6a4c2a",
+		Creator:            "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+		ID:                 "75274f99-faf6-4112-a6d5-2794cb07c789",
+		OriginalResourceID: "75274f99-faf6-4112-a6d5-2794cb07c789",
+		ProjectID:          "4154f08883334e0494c41155c33c0fc9",
+		RevisionStart:      time.Date(2018, 1, 1, 11, 44, 31, 742031000, time.UTC),
+		StartedAt:          time.Date(2018, 1, 1, 11, 44, 31, 742011000, time.UTC),
+		Type:               "compute_instance_network",
+		UserID:             "bd5874d666624b24a9f01c128871e4ac",
+	},
+	Unit: "packet/s",
+}
+
+// CreateRequest represents a request to create a metric.
+const CreateRequest = `
+{
+    "archive_policy_name": "precise",
+    "name": "network.incoming.packets.rate",
+    "resource_id": "75274f99-faf6-4112-a6d5-2794cb07c789",
+    "unit": "packet/s"
+}
+`
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/metric", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, ListResult)
+		case "6dbc97c5-bfdf-47a2-b184-02e7fa348d21":
+			fmt.Fprintf(w, `[]`)
+		default:
+			t.Fatalf("/metric invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/metric/0ddf61cf-3747-4f75-bf13-13c28ff03ae3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetResult)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/metric", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetResult)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/metric/0ddf61cf-3747-4f75-bf13-13c28ff03ae3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/metric/v1/metrics"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListMetrics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+
+	err := metrics.List(fake.ServiceClient(), metrics.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := metrics.ExtractMetrics(page)
+		th.AssertNoErr(t, err)
+
+		expected := []metrics.Metric{Metric1, Metric2}
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetMetric(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := metrics.Get(fake.ServiceClient(), "0ddf61cf-3747-4f75-bf13-13c28ff03ae3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &NetworkMetric, actual)
+}
+
+func TestCreateMetric(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := metrics.CreateOpts{
+		ArchivePolicyName: "precise",
+		Name:              "network.incoming.packets.rate",
+		ResourceID:        "75274f99-faf6-4112-a6d5-2794cb07c789",
+		Unit:              "packet/s",
+	}
+
+	actual, err := metrics.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &NetworkMetric, actual)
+}
+
+func TestCreateMetricRequiresNameWithResource(t *testing.T) {
+	createOpts := metrics.CreateOpts{
+		ResourceID: "75274f99-faf6-4112-a6d5-2794cb07c789",
+	}
+
+	res := metrics.Create(fake.ServiceClient(), createOpts)
+	if res.Err == nil {
+		t.Fatal("expected error when Name is not set for a resource metric")
+	}
+}
+
+func TestDeleteMetric(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := metrics.Delete(fake.ServiceClient(), "0ddf61cf-3747-4f75-bf13-13c28ff03ae3")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,29 @@
+package metrics
+
+import "github.com/gophercloud/gophercloud"
+
+const metricPath = "metric"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(metricPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, metricID string) string {
+	return c.ServiceURL(metricPath, metricID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, metricID string) string {
+	return resourceURL(c, metricID)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, metricID string) string {
+	return resourceURL(c, metricID)
+}
@@ -0,0 +1,77 @@
+/*
+Package resources provides the ability to retrieve and manage resources
+through the OpenStack Metric (Gnocchi) v1 API.
+
+Example of Listing resources
+
+	listOpts := resources.ListOpts{
+		Details: true,
+	}
+
+	allPages, err := resources.List(metricClient, "generic", listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allResources, err := resources.ExtractResources(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, resource := range allResources {
+		fmt.Printf("%+v\n", resource)
+	}
+
+Example of Getting a resource
+
+	resourceID := "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55"
+	resource, err := resources.Get(metricClient, "generic", resourceID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Creating a resource with a new metric
+
+	startedAt := time.Date(2018, 1, 2, 23, 23, 34, 0, time.UTC)
+	createOpts := resources.CreateOpts{
+		ID:        "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+		ProjectID: "4154f08883334e0494c41155c33c0fc9",
+		StartedAt: &startedAt,
+		Metrics: map[string]interface{}{
+			"cpu.delta": map[string]string{
+				"archive_policy_name": "medium",
+			},
+		},
+		ExtraAttributes: map[string]interface{}{
+			"display_name": "MyServer",
+			"flavor_id":    "1",
+		},
+	}
+
+	resource, err := resources.Create(metricClient, "compute_instance", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Updating a resource
+
+	endedAt := time.Date(2018, 1, 3, 10, 0, 0, 0, time.UTC)
+	updateOpts := resources.UpdateOpts{
+		EndedAt: &endedAt,
+	}
+
+	resourceID := "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55"
+	resource, err := resources.Update(metricClient, "compute_instance", resourceID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Deleting a resource
+
+	resourceID := "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55"
+	err := resources.Delete(metricClient, "compute_instance", resourceID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package resources
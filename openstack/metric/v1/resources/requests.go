@@ -0,0 +1,203 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToResourceListQuery() (string, error)
+}
+
+// ListOpts allows the limiting and sorting of paginated collections through
+// the Metric v1 API.
+type ListOpts struct {
+	// Details allows to list resources with all attributes.
+	Details bool `q:"details"`
+
+	// Limit allows to limit the number of returned resources.
+	Limit int `q:"limit"`
+
+	// Marker is the ID of the last resource on the previous page.
+	Marker string `q:"marker"`
+
+	// SortKey allows to sort resources by the specified key.
+	SortKey string `q:"sort_key"`
+
+	// SortDir allows to sort resources in the specified direction.
+	SortDir string `q:"sort_dir"`
+
+	// History allows to list all revisions of the resources.
+	History bool `q:"history"`
+}
+
+// ToResourceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToResourceListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// resources of the specified type. Use the "generic" resource type to list
+// resources of all types.
+func List(c *gophercloud.ServiceClient, resourceType string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c, resourceType)
+	if opts != nil {
+		query, err := opts.ToResourceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		p := ResourcePage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// Get retrieves a specific resource based on its type and ID.
+func Get(c *gophercloud.ServiceClient, resourceType, resourceID string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, resourceType, resourceID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToResourceCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new resource.
+type CreateOpts struct {
+	// ID uniquely identifies the resource.
+	ID string `json:"id" required:"true"`
+
+	// ProjectID is the id of the project this resource belongs to.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// UserID is the id of the user who owns the resource.
+	UserID string `json:"user_id,omitempty"`
+
+	// StartedAt is the timestamp when the resource started being used.
+	StartedAt *time.Time `json:"-"`
+
+	// EndedAt is the timestamp when the resource stopped being used.
+	EndedAt *time.Time `json:"-"`
+
+	// Metrics maps metric names to either existing metric IDs or metric
+	// definitions such as {"archive_policy_name": "medium"}.
+	Metrics map[string]interface{} `json:"metrics,omitempty"`
+
+	// ExtraAttributes contains attributes specific to the resource type.
+	ExtraAttributes map[string]interface{} `json:"-"`
+}
+
+// ToResourceCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToResourceCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.StartedAt != nil {
+		b["started_at"] = opts.StartedAt.Format(time.RFC3339Nano)
+	}
+
+	if opts.EndedAt != nil {
+		b["ended_at"] = opts.EndedAt.Format(time.RFC3339Nano)
+	}
+
+	for k, v := range opts.ExtraAttributes {
+		b[k] = v
+	}
+
+	return b, nil
+}
+
+// Create requests the creation of a new resource of the specified type.
+func Create(c *gophercloud.ServiceClient, resourceType string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToResourceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c, resourceType), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToResourceUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents options used to update a resource.
+type UpdateOpts struct {
+	// ProjectID is the id of the project this resource belongs to.
+	ProjectID *string `json:"project_id,omitempty"`
+
+	// UserID is the id of the user who owns the resource.
+	UserID *string `json:"user_id,omitempty"`
+
+	// StartedAt is the timestamp when the resource started being used.
+	StartedAt *time.Time `json:"-"`
+
+	// EndedAt is the timestamp when the resource stopped being used.
+	EndedAt *time.Time `json:"-"`
+
+	// Metrics maps metric names to either existing metric IDs or metric
+	// definitions such as {"archive_policy_name": "medium"}.
+	Metrics *map[string]interface{} `json:"metrics,omitempty"`
+
+	// ExtraAttributes contains attributes specific to the resource type.
+	ExtraAttributes map[string]interface{} `json:"-"`
+}
+
+// ToResourceUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToResourceUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.StartedAt != nil {
+		b["started_at"] = opts.StartedAt.Format(time.RFC3339Nano)
+	}
+
+	if opts.EndedAt != nil {
+		b["ended_at"] = opts.EndedAt.Format(time.RFC3339Nano)
+	}
+
+	for k, v := range opts.ExtraAttributes {
+		b[k] = v
+	}
+
+	return b, nil
+}
+
+// Update accepts an UpdateOpts struct and updates an existing resource using
+// the values provided.
+func Update(c *gophercloud.ServiceClient, resourceType, resourceID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToResourceUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Patch(updateURL(c, resourceType, resourceID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete accepts a unique ID and deletes the related resource.
+func Delete(c *gophercloud.ServiceClient, resourceType, resourceID string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, resourceType, resourceID), nil)
+	return
+}
@@ -0,0 +1,163 @@
+package resources
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Resource.
+func (r commonResult) Extract() (*Resource, error) {
+	var s *Resource
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Resource.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Resource.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Resource.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Resource is an entity representing a resource in the Metric service.
+type Resource struct {
+	// CreatedByProjectID contains the id of the project that created the resource.
+	CreatedByProjectID string `json:"created_by_project_id"`
+
+	// CreatedByUserID contains the id of the user that created the resource.
+	CreatedByUserID string `json:"created_by_user_id"`
+
+	// Creator shows who created the resource.
+	// Usually it contains concatenated string with values from
+	// "created_by_user_id" and "created_by_project_id" fields.
+	Creator string `json:"creator"`
+
+	// ID uniquely identifies the resource.
+	ID string `json:"id"`
+
+	// Metrics contains metric names mapped to metric identifiers.
+	Metrics map[string]string `json:"metrics"`
+
+	// OriginalResourceID is the original resource id. It can be different
+	// from the ID field if the resource was created with a non-UUID id.
+	OriginalResourceID string `json:"original_resource_id"`
+
+	// ProjectID is the id of the project this resource belongs to.
+	ProjectID string `json:"project_id"`
+
+	// RevisionStart is the timestamp when the current revision of the
+	// resource was created.
+	RevisionStart time.Time `json:"revision_start"`
+
+	// RevisionEnd is the timestamp when the current revision of the
+	// resource was ended. It is empty for the latest revision.
+	RevisionEnd time.Time `json:"revision_end"`
+
+	// StartedAt is the timestamp when the resource started being used.
+	StartedAt time.Time `json:"started_at"`
+
+	// EndedAt is the timestamp when the resource stopped being used.
+	EndedAt time.Time `json:"ended_at"`
+
+	// Type is the type of the resource.
+	Type string `json:"type"`
+
+	// UserID is the id of the user who owns the resource.
+	UserID string `json:"user_id"`
+
+	// ExtraAttributes contains attributes specific to the resource type.
+	ExtraAttributes map[string]interface{} `json:"-"`
+}
+
+// UnmarshalJSON helps to unmarshal Resource fields into needed values.
+func (r *Resource) UnmarshalJSON(b []byte) error {
+	type tmp Resource
+	var s tmp
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*r = Resource(s)
+
+	r.RevisionStart = r.RevisionStart.UTC()
+	r.RevisionEnd = r.RevisionEnd.UTC()
+	r.StartedAt = r.StartedAt.UTC()
+	r.EndedAt = r.EndedAt.UTC()
+
+	var attributes map[string]interface{}
+	if err := json.Unmarshal(b, &attributes); err != nil {
+		return err
+	}
+
+	for _, k := range []string{
+		"created_by_project_id", "created_by_user_id", "creator", "id",
+		"metrics", "original_resource_id", "project_id", "revision_start",
+		"revision_end", "started_at", "ended_at", "type", "user_id",
+	} {
+		delete(attributes, k)
+	}
+
+	if len(attributes) > 0 {
+		r.ExtraAttributes = attributes
+	}
+
+	return nil
+}
+
+// ResourcePage abstracts the raw results of making a List() request against
+// the Metric v1 API.
+//
+// As Metric v1 API pagination is based on the marker, you should use the
+// AllPages or EachPage methods to get all the results.
+type ResourcePage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty checks whether a ResourcePage struct is empty.
+func (r ResourcePage) IsEmpty() (bool, error) {
+	resources, err := ExtractResources(r)
+	return len(resources) == 0, err
+}
+
+// LastMarker returns the ID of the last resource in a ResourcePage.
+func (r ResourcePage) LastMarker() (string, error) {
+	resources, err := ExtractResources(r)
+	if err != nil {
+		return "", err
+	}
+	if len(resources) == 0 {
+		return "", nil
+	}
+	return resources[len(resources)-1].ID, nil
+}
+
+// ExtractResources interprets the results of a single page from a List() call,
+// producing a slice of Resource structs.
+func ExtractResources(r pagination.Page) ([]Resource, error) {
+	var s []Resource
+	err := (r.(ResourcePage)).ExtractInto(&s)
+	return s, err
+}
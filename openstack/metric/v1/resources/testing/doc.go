@@ -0,0 +1,2 @@
+// metric_resources_v1
+package testing
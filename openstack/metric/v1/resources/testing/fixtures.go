@@ -0,0 +1,258 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/metric/v1/resources"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListResult represents a raw server response from the "generic" resource
+// listing.
+const ListResult = `
+[
+    {
+        "created_by_project_id": "3d40ca37723449118987b9f288f4ae84",
+        "created_by_user_id": "fdcfb420c09645e69e71ad9e5d6a4c2a",
+        "creator": "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+        "ended_at": null,
+        "id": "75274f99-faf6-4112-a6d5-2794cb07c789",
+        "metrics": {
+            "disk.read.bytes.rate": "ed1bb76f-6ccc-4ad2-994c-dbb19ddccbae",
+            "disk.write.bytes.rate": "0a2da84d-4753-43f5-a65f-0f8d44d2766c"
+        },
+        "original_resource_id": "75274f99-faf6-4112-a6d5-2794cb07c789",
+        "project_id": "4154f08883334e0494c41155c33c0fc9",
+        "revision_end": null,
+        "revision_start": "2018-01-02T11:39:33.051443+00:00",
+        "started_at": "2018-01-02T11:39:33.051427+00:00",
+        "type": "generic",
+        "user_id": "bd5874d666624b24a9f01c128871e4ac"
+    },
+    {
+        "created_by_project_id": "3d40ca37723449118987b9f288f4ae84",
+        "created_by_user_id": "fdcfb420c09645e69e71ad9e5d6a4c2a",
+        "creator": "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+        "ended_at": "2018-01-03T10:00:00+00:00",
+        "id": "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+        "metrics": {
+            "cpu.delta": "906ef4ad-3c03-4d6b-9b6d-5ba1b8dcd35d"
+        },
+        "original_resource_id": "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+        "project_id": "4154f08883334e0494c41155c33c0fc9",
+        "revision_end": null,
+        "revision_start": "2018-01-03T11:44:31.155773+00:00",
+        "started_at": "2018-01-03T11:44:31.155732+00:00",
+        "type": "compute_instance",
+        "user_id": "bd5874d666624b24a9f01c128871e4ac"
+    }
+]
+`
+
+// Resource1 is an expected representation of a first resource from the
+// ListResult.
+var Resource1 = resources.Resource{
+	CreatedByProjectID: "3d40ca37723449118987b9f288f4ae84",
+	CreatedByUserID:    "fdcfb420c09645e69e71ad9e5d6a4c2a",
+	Creator:            "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+	ID:                 "75274f99-faf6-4112-a6d5-2794cb07c789",
+	Metrics: map[string]string{
+		"disk.read.bytes.rate":  "ed1bb76f-6ccc-4ad2-994c-dbb19ddccbae",
+		"disk.write.bytes.rate": "0a2da84d-4753-43f5-a65f-0f8d44d2766c",
+	},
+	OriginalResourceID: "75274f99-faf6-4112-a6d5-2794cb07c789",
+	ProjectID:          "4154f08883334e0494c41155c33c0fc9",
+	RevisionStart:      time.Date(2018, 1, 2, 11, 39, 33, 51443000, time.UTC),
+	StartedAt:          time.Date(2018, 1, 2, 11, 39, 33, 51427000, time.UTC),
+	Type:               "generic",
+	UserID:             "bd5874d666624b24a9f01c128871e4ac",
+}
+
+// Resource2 is an expected representation of a second resource from the
+// ListResult.
+var Resource2 = resources.Resource{
+	CreatedByProjectID: "3d40ca37723449118987b9f288f4ae84",
+	CreatedByUserID:    "fdcfb420c09645e69e71ad9e5d6a4c2a",
+	Creator:            "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+	EndedAt:            time.Date(2018, 1, 3, 10, 0, 0, 0, time.UTC),
+	ID:                 "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+	Metrics: map[string]string{
+		"cpu.delta": "906ef4ad-3c03-4d6b-9b6d-5ba1b8dcd35d",
+	},
+	OriginalResourceID: "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+	ProjectID:          "4154f08883334e0494c41155c33c0fc9",
+	RevisionStart:      time.Date(2018, 1, 3, 11, 44, 31, 155773000, time.UTC),
+	StartedAt:          time.Date(2018, 1, 3, 11, 44, 31, 155732000, time.UTC),
+	Type:               "compute_instance",
+	UserID:             "bd5874d666624b24a9f01c128871e4ac",
+}
+
+// GetResult represents a raw server response for a single compute_instance
+// resource.
+const GetResult = `
+{
+    "created_by_project_id": "3d40ca37723449118987b9f288f4ae84",
+    "created_by_user_id": "fdcfb420c09645e69e71ad9e5d6a4c2a",
+    "creator": "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+    "display_name": "MyServer",
+    "ended_at": null,
+    "flavor_id": "1",
+    "id": "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+    "metrics": {
+        "cpu.delta": "906ef4ad-3c03-4d6b-9b6d-5ba1b8dcd35d"
+    },
+    "original_resource_id": "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+    "project_id": "4154f08883334e0494c41155c33c0fc9",
+    "revision_end": null,
+    "revision_start": "2018-01-03T11:44:31.155773+00:00",
+    "started_at": "2018-01-02T23:23:34+00:00",
+    "type": "compute_instance",
+    "user_id": "bd5874d666624b24a9f01c128871e4ac"
+}
+`
+
+// ComputeInstanceResource is an expected representation of the GetResult.
+var ComputeInstanceResource = resources.Resource{
+	CreatedByProjectID: "3d40ca37723449118987b9f288f4ae84",
+	CreatedByUserID:    "fdcfb420c09645e69e71ad9e5d6a4c2a",
+	Creator:            "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+	ID:                 "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+	Metrics: map[string]string{
+		"cpu.delta": "906ef4ad-3c03-4d6b-9b6d-5ba1b8dcd35d",
+	},
+	OriginalResourceID: "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+	ProjectID:          "4154f08883334e0494c41155c33c0fc9",
+	RevisionStart:      time.Date(2018, 1, 3, 11, 44, 31, 155773000, time.UTC),
+	StartedAt:          time.Date(2018, 1, 2, 23, 23, 34, 0, time.UTC),
+	Type:               "compute_instance",
+	UserID:             "bd5874d666624b24a9f01c128871e4ac",
+	ExtraAttributes: map[string]interface{}{
+		"display_name": "MyServer",
+		"flavor_id":    "1",
+	},
+}
+
+// CreateRequest represents a request to create a compute_instance resource.
+const CreateRequest = `
+{
+    "id": "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+    "project_id": "4154f08883334e0494c41155c33c0fc9",
+    "user_id": "bd5874d666624b24a9f01c128871e4ac",
+    "started_at": "2018-01-02T23:23:34Z",
+    "metrics": {
+        "cpu.delta": {
+            "archive_policy_name": "medium"
+        }
+    },
+    "display_name": "MyServer",
+    "flavor_id": "1"
+}
+`
+
+// UpdateRequest represents a request to update a compute_instance resource.
+const UpdateRequest = `
+{
+    "ended_at": "2018-01-03T10:00:00Z",
+    "display_name": "MyServer"
+}
+`
+
+// UpdateResult represents a raw server response to the UpdateRequest.
+const UpdateResult = `
+{
+    "created_by_project_id": "3d40ca37723449118987b9f288f4ae84",
+    "created_by_user_id": "fdcfb420c09645e69e71ad9e5d6a4c2a",
+    "creator": "fdcfb420c09645e69e71ad9e5d6a4c2a:3d40ca37723449118987b9f288f4ae84",
+    "display_name": "MyServer",
+    "ended_at": "2018-01-03T10:00:00+00:00",
+    "flavor_id": "1",
+    "id": "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+    "metrics": {
+        "cpu.delta": "906ef4ad-3c03-4d6b-9b6d-5ba1b8dcd35d"
+    },
+    "original_resource_id": "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+    "project_id": "4154f08883334e0494c41155c33c0fc9",
+    "revision_end": null,
+    "revision_start": "2018-01-03T11:44:31.155773+00:00",
+    "started_at": "2018-01-02T23:23:34+00:00",
+    "type": "compute_instance",
+    "user_id": "bd5874d666624b24a9f01c128871e4ac"
+}
+`
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/resource/generic", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, ListResult)
+		case "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55":
+			fmt.Fprintf(w, `[]`)
+		default:
+			t.Fatalf("/resource/generic invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/resource/compute_instance/23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetResult)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/resource/compute_instance", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetResult)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update
+// request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/resource/compute_instance/23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateResult)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/resource/compute_instance/23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,119 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/metric/v1/resources"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListResources(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+
+	err := resources.List(fake.ServiceClient(), "generic", resources.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := resources.ExtractResources(page)
+		th.AssertNoErr(t, err)
+
+		expected := []resources.Resource{Resource1, Resource2}
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListResourcesAllPages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	allPages, err := resources.List(fake.ServiceClient(), "generic", nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := resources.ExtractResources(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, len(actual))
+	th.CheckDeepEquals(t, Resource2, actual[1])
+}
+
+func TestGetResource(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := resources.Get(fake.ServiceClient(), "compute_instance", "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ComputeInstanceResource, actual)
+}
+
+func TestCreateResource(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	startedAt := time.Date(2018, 1, 2, 23, 23, 34, 0, time.UTC)
+	createOpts := resources.CreateOpts{
+		ID:        "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55",
+		ProjectID: "4154f08883334e0494c41155c33c0fc9",
+		UserID:    "bd5874d666624b24a9f01c128871e4ac",
+		StartedAt: &startedAt,
+		Metrics: map[string]interface{}{
+			"cpu.delta": map[string]string{
+				"archive_policy_name": "medium",
+			},
+		},
+		ExtraAttributes: map[string]interface{}{
+			"display_name": "MyServer",
+			"flavor_id":    "1",
+		},
+	}
+
+	actual, err := resources.Create(fake.ServiceClient(), "compute_instance", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ComputeInstanceResource, actual)
+}
+
+func TestCreateResourceRequiresID(t *testing.T) {
+	res := resources.Create(fake.ServiceClient(), "generic", resources.CreateOpts{})
+	if res.Err == nil {
+		t.Fatal("expected error when ID is not set")
+	}
+}
+
+func TestUpdateResource(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	endedAt := time.Date(2018, 1, 3, 10, 0, 0, 0, time.UTC)
+	updateOpts := resources.UpdateOpts{
+		EndedAt: &endedAt,
+		ExtraAttributes: map[string]interface{}{
+			"display_name": "MyServer",
+		},
+	}
+
+	actual, err := resources.Update(fake.ServiceClient(), "compute_instance", "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := ComputeInstanceResource
+	expected.EndedAt = endedAt
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
+func TestDeleteResource(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := resources.Delete(fake.ServiceClient(), "compute_instance", "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55")
+	th.AssertNoErr(t, res.Err)
+}
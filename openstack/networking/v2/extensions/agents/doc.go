@@ -28,5 +28,90 @@ Example to Get an Agent
     if err != nil {
         panic(err)
     }
+
+Example to Disable an Agent
+
+    adminStateUp := false
+    updateOpts := agents.UpdateOpts{
+        AdminStateUp: &adminStateUp,
+    }
+
+    agentID = "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+    agent, err := agents.Update(networkClient, agentID, updateOpts).Extract()
+    if err != nil {
+        panic(err)
+    }
+
+Example to Delete an Agent
+
+    agentID = "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+    err := agents.Delete(networkClient, agentID).ExtractErr()
+    if err != nil {
+        panic(err)
+    }
+
+Example to List Networks hosted by a DHCP Agent
+
+    agentID = "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+    networks, err := agents.ListDHCPNetworks(networkClient, agentID).Extract()
+    if err != nil {
+        panic(err)
+    }
+
+    for _, network := range networks {
+        fmt.Printf("%+v\n", network)
+    }
+
+Example to Schedule a Network to a DHCP Agent
+
+    agentID = "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+    opts := agents.ScheduleDHCPNetworkOpts{
+        NetworkID: "1ae075ca-708b-4e66-b4a7-b7698632f05f",
+    }
+    err := agents.ScheduleDHCPNetwork(networkClient, agentID, opts).ExtractErr()
+    if err != nil {
+        panic(err)
+    }
+
+Example to Remove a Network from a DHCP Agent
+
+    agentID = "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+    networkID := "1ae075ca-708b-4e66-b4a7-b7698632f05f"
+    err := agents.RemoveDHCPNetwork(networkClient, agentID, networkID).ExtractErr()
+    if err != nil {
+        panic(err)
+    }
+
+Example to List Routers hosted by a L3 Agent
+
+    agentID = "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+    routers, err := agents.ListL3Routers(networkClient, agentID).Extract()
+    if err != nil {
+        panic(err)
+    }
+
+    for _, router := range routers {
+        fmt.Printf("%+v\n", router)
+    }
+
+Example to Schedule a Router to a L3 Agent
+
+    agentID = "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+    opts := agents.ScheduleL3RouterOpts{
+        RouterID: "43e66290-79a4-415d-9eb9-7ff7919839e1",
+    }
+    err := agents.ScheduleL3Router(networkClient, agentID, opts).ExtractErr()
+    if err != nil {
+        panic(err)
+    }
+
+Example to Remove a Router from a L3 Agent
+
+    agentID = "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+    routerID := "43e66290-79a4-415d-9eb9-7ff7919839e1"
+    err := agents.RemoveL3Router(networkClient, agentID, routerID).ExtractErr()
+    if err != nil {
+        panic(err)
+    }
 */
 package agents
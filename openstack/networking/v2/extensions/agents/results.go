@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/pagination"
 )
@@ -28,6 +29,46 @@ type GetResult struct {
 	commonResult
 }
 
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as an Agent.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ScheduleDHCPNetworkResult represents the result of a schedule a network to
+// a DHCP agent operation. Call its ExtractErr method to determine if the request
+// succeeded or failed.
+type ScheduleDHCPNetworkResult struct {
+	gophercloud.ErrResult
+}
+
+// RemoveDHCPNetworkResult represents the result of a remove a network from a
+// DHCP agent operation. Call its ExtractErr method to determine if the request
+// succeeded or failed.
+type RemoveDHCPNetworkResult struct {
+	gophercloud.ErrResult
+}
+
+// ScheduleL3RouterResult represents the result of a schedule a router to a
+// L3 agent operation. Call its ExtractErr method to determine if the request
+// succeeded or failed.
+type ScheduleL3RouterResult struct {
+	gophercloud.ErrResult
+}
+
+// RemoveL3RouterResult represents the result of a remove a router from a
+// L3 agent operation. Call its ExtractErr method to determine if the request
+// succeeded or failed.
+type RemoveL3RouterResult struct {
+	gophercloud.ErrResult
+}
+
 // Agent represents a Neutron agent.
 type Agent struct {
 	// ID is the id of the agent.
@@ -112,6 +153,19 @@ func (r AgentPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r AgentPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"agents_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty determines whether or not a AgentPage is empty.
 func (r AgentPage) IsEmpty() (bool, error) {
 	agents, err := ExtractAgents(r)
@@ -143,3 +197,19 @@ func (r ListDHCPNetworksResult) Extract() ([]networks.Network, error) {
 	err := r.ExtractInto(&s)
 	return s.Networks, err
 }
+
+// ListL3RoutersResult is the response from a ListL3Routers operation.
+// Call its Extract method to interpret it as routers.
+type ListL3RoutersResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any ListL3RoutersResult as an array of routers.
+func (r ListL3RoutersResult) Extract() ([]routers.Router, error) {
+	var s struct {
+		Routers []routers.Router `json:"routers"`
+	}
+
+	err := r.ExtractInto(&s)
+	return s.Routers, err
+}
@@ -161,3 +161,85 @@ const AgentDHCPNetworksListResult = `
     ]
 }
 `
+
+// AgentUpdateRequest represents raw request to update an Agent.
+const AgentUpdateRequest = `
+{
+    "agent": {
+        "description": "My OVS agent for OpenStack",
+        "admin_state_up": false
+    }
+}
+`
+
+// AgentUpdateResult represents raw response for the Update request.
+const AgentUpdateResult = `
+{
+    "agent": {
+        "binary": "neutron-openvswitch-agent",
+        "description": "My OVS agent for OpenStack",
+        "availability_zone": null,
+        "heartbeat_timestamp": "2019-01-09 11:43:01",
+        "admin_state_up": false,
+        "alive": true,
+        "id": "43583cf5-472e-4dc8-af5b-6aed4c94ee3a",
+        "topic": "N/A",
+        "host": "compute3",
+        "agent_type": "Open vSwitch agent",
+        "started_at": "2018-06-26 21:46:20",
+        "created_at": "2017-07-26 23:02:05",
+        "configurations": {}
+    }
+}
+`
+
+// ScheduleDHCPNetworkRequest represents raw request for the
+// ScheduleDHCPNetwork request.
+const ScheduleDHCPNetworkRequest = `
+{
+    "network_id": "1ae075ca-708b-4e66-b4a7-b7698632f05f"
+}
+`
+
+// ScheduleL3RouterRequest represents raw request for the ScheduleL3Router
+// request.
+const ScheduleL3RouterRequest = `
+{
+    "router_id": "43e66290-79a4-415d-9eb9-7ff7919839e1"
+}
+`
+
+// AgentL3RoutersListResult represents raw response for the ListL3Routers
+// request.
+const AgentL3RoutersListResult = `
+{
+    "routers": [
+        {
+            "admin_state_up": true,
+            "availability_zone_hints": [],
+            "availability_zones": [
+                "nova"
+            ],
+            "description": "",
+            "distributed": false,
+            "external_gateway_info": {
+                "enable_snat": true,
+                "external_fixed_ips": [
+                    {
+                        "ip_address": "172.24.4.6",
+                        "subnet_id": "b930d7f6-ceb7-40a0-8b81-a425dd994ccf"
+                    }
+                ],
+                "network_id": "ae34051f-aa6c-4c75-abf5-50dc9ac99ef3"
+            },
+            "ha": false,
+            "id": "915a14a6-867b-4af7-83d1-70efceb146f9",
+            "name": "router2",
+            "routes": [],
+            "status": "ACTIVE",
+            "project_id": "0bd18306d801447bb457a46252d82d13",
+            "tenant_id": "0bd18306d801447bb457a46252d82d13"
+        }
+    ]
+}
+`
@@ -33,5 +33,26 @@ Example to Delete a tag from a Resource
 Example to confirm if a tag exists on a resource
 
 	exists, _ := attributestags.Confirm(client, "networks", network.ID, "atag").Extract()
+
+Example to List Resources filtered by Tags
+
+Resources which support tags can be filtered by them when being listed. Tags
+and NotTags match resources having all of the given comma-separated tags,
+TagsAny and NotTagsAny match resources having any of them.
+
+	listOpts := networks.ListOpts{
+		Tags:    "abc,123",
+		NotTags: "deprecated",
+	}
+
+	allPages, err := networks.List(conn, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allNetworks, err := networks.ExtractNetworks(allPages)
+	if err != nil {
+		panic(err)
+	}
 */
 package attributestags
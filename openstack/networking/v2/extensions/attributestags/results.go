@@ -23,6 +23,8 @@ type ReplaceAllResult struct {
 	tagResult
 }
 
+// ListResult is the result from a List operation.
+// Call its Extract method to interpret it as a slice of strings.
 type ListResult struct {
 	tagResult
 }
@@ -40,10 +42,13 @@ type AddResult struct {
 }
 
 // ConfirmResult is the result from an Confirm operation.
+// Call its Extract method to determine if the tag exists on the resource.
 type ConfirmResult struct {
 	gophercloud.Result
 }
 
+// Extract interprets a ConfirmResult as a boolean. A missing tag is
+// reported as false without an error.
 func (r ConfirmResult) Extract() (bool, error) {
 	exists := r.Err == nil
 
@@ -0,0 +1,28 @@
+/*
+Package availabilityzones provides the ability to list the availability zones
+of the Networking service. Availability zones are reported by the DHCP and L3
+agents and can be used as availability_zone_hints when creating networks and
+routers.
+
+Example to List Availability Zones of Routers
+
+	listOpts := availabilityzones.ListOpts{
+		Resource: "router",
+		State:    "available",
+	}
+
+	allPages, err := availabilityzones.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAvailabilityZones, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, availabilityZone := range allAvailabilityZones {
+		fmt.Printf("%+v\n", availabilityZone)
+	}
+*/
+package availabilityzones
@@ -0,0 +1,50 @@
+package availabilityzones
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAvailabilityZoneListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of availability zones through the Neutron
+// API.
+type ListOpts struct {
+	// Name allows to filter on the name of an availability zone.
+	Name string `q:"name"`
+
+	// Resource allows to filter on the resource type of an availability zone,
+	// for example "network" or "router".
+	Resource string `q:"resource"`
+
+	// State allows to filter on the state of an availability zone, either
+	// "available" or "unavailable".
+	State string `q:"state"`
+}
+
+// ToAvailabilityZoneListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAvailabilityZoneListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// availability zones. It accepts a ListOpts struct, which allows you to
+// filter the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToAvailabilityZoneListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AvailabilityZonePage{pagination.SinglePageBase(r)}
+	})
+}
@@ -0,0 +1,42 @@
+package availabilityzones
+
+import (
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// AvailabilityZone represents an availability zone reported by the Neutron
+// agents for a given resource type.
+type AvailabilityZone struct {
+	// Name is the name of the availability zone.
+	Name string `json:"name"`
+
+	// Resource is the type of resource the availability zone applies to,
+	// for example "network" or "router".
+	Resource string `json:"resource"`
+
+	// State is the state of the availability zone, either "available"
+	// or "unavailable".
+	State string `json:"state"`
+}
+
+// AvailabilityZonePage stores a single page of AvailabilityZones from a
+// List call.
+type AvailabilityZonePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if an AvailabilityZonePage contains any results.
+func (r AvailabilityZonePage) IsEmpty() (bool, error) {
+	availabilityZones, err := ExtractAvailabilityZones(r)
+	return len(availabilityZones) == 0, err
+}
+
+// ExtractAvailabilityZones interprets the results of a single page from a
+// List call, producing a slice of AvailabilityZones.
+func ExtractAvailabilityZones(r pagination.Page) ([]AvailabilityZone, error) {
+	var s struct {
+		AvailabilityZones []AvailabilityZone `json:"availability_zones"`
+	}
+	err := (r.(AvailabilityZonePage)).ExtractInto(&s)
+	return s.AvailabilityZones, err
+}
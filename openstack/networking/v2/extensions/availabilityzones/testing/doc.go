@@ -0,0 +1,2 @@
+// availabilityzones unit tests
+package testing
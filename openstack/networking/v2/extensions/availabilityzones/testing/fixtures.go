@@ -0,0 +1,47 @@
+package testing
+
+import "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/availabilityzones"
+
+// AvailabilityZoneListResult provides a list of availability zones result.
+const AvailabilityZoneListResult = `
+{
+    "availability_zones": [
+        {
+            "state": "available",
+            "resource": "router",
+            "name": "nova"
+        },
+        {
+            "state": "available",
+            "resource": "network",
+            "name": "nova"
+        },
+        {
+            "state": "unavailable",
+            "resource": "network",
+            "name": "zone2"
+        }
+    ]
+}
+`
+
+// AvailabilityZone1 is the first availability zone from the list result.
+var AvailabilityZone1 = availabilityzones.AvailabilityZone{
+	Name:     "nova",
+	Resource: "router",
+	State:    "available",
+}
+
+// AvailabilityZone2 is the second availability zone from the list result.
+var AvailabilityZone2 = availabilityzones.AvailabilityZone{
+	Name:     "nova",
+	Resource: "network",
+	State:    "available",
+}
+
+// AvailabilityZone3 is the third availability zone from the list result.
+var AvailabilityZone3 = availabilityzones.AvailabilityZone{
+	Name:     "zone2",
+	Resource: "network",
+	State:    "unavailable",
+}
@@ -0,0 +1,84 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/availability_zones", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AvailabilityZoneListResult)
+	})
+
+	count := 0
+
+	err := availabilityzones.List(fake.ServiceClient(), availabilityzones.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := availabilityzones.ExtractAvailabilityZones(page)
+		if err != nil {
+			t.Errorf("Failed to extract availability zones: %v", err)
+			return false, nil
+		}
+
+		expected := []availabilityzones.AvailabilityZone{
+			AvailabilityZone1,
+			AvailabilityZone2,
+			AvailabilityZone3,
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestListWithFilters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/availability_zones", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"resource": "router",
+			"state":    "available",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AvailabilityZoneListResult)
+	})
+
+	listOpts := availabilityzones.ListOpts{
+		Resource: "router",
+		State:    "available",
+	}
+
+	allPages, err := availabilityzones.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	_, err = availabilityzones.ExtractAvailabilityZones(allPages)
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,9 @@
+package availabilityzones
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "availability_zones"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
@@ -39,3 +39,26 @@ func Get(c *gophercloud.ServiceClient, alias string) GetResult {
 func List(c *gophercloud.ServiceClient) pagination.Pager {
 	return common.List(c)
 }
+
+// HasExtension reports whether the Networking service advertises an
+// extension with the given alias. It can be used to adapt behavior to the
+// extensions a deployment actually supports.
+func HasExtension(c *gophercloud.ServiceClient, alias string) (bool, error) {
+	allPages, err := List(c).AllPages()
+	if err != nil {
+		return false, err
+	}
+
+	allExtensions, err := ExtractExtensions(allPages)
+	if err != nil {
+		return false, err
+	}
+
+	for _, ext := range allExtensions {
+		if ext.Alias == alias {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,39 @@
+/*
+Package extensions provides information and interaction with the different
+extensions available for the OpenStack Networking service.
+
+Example to List Extensions
+
+	allPages, err := extensions.List(networkClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allExtensions, err := extensions.ExtractExtensions(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, extension := range allExtensions {
+		fmt.Printf("%+v\n", extension)
+	}
+
+Example to Get an Extension
+
+	extension, err := extensions.Get(networkClient, "trunk").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Check for an Extension
+
+	hasTrunks, err := extensions.HasExtension(networkClient, "trunk")
+	if err != nil {
+		panic(err)
+	}
+
+	if hasTrunks {
+		fmt.Println("trunks are supported")
+	}
+*/
+package extensions
@@ -0,0 +1,78 @@
+/*
+Package groups allows management and retrieval of Firewall Groups in the
+OpenStack Networking Service.
+
+Example to List Groups
+
+	listOpts := groups.ListOpts{
+		TenantID: "9f98fc0e5f944cd1b51798b668dc8778",
+	}
+
+	allPages, err := groups.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allGroups, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, group := range allGroups {
+		fmt.Printf("%+v\n", group)
+	}
+
+Example to Create a Group
+
+	createOpts := groups.CreateOpts{
+		Name:                    "group_1",
+		Description:             "A firewall group",
+		AdminStateUp:            gophercloud.Enabled,
+		IngressFirewallPolicyID: "e3f11142-3792-454b-8d3e-91ac1bf127b4",
+		EgressFirewallPolicyID:  "43a11f3a-ddac-4129-9469-02b9df26548e",
+		Ports: []string{
+			"a6af1e56-b12b-4733-8f77-49166afd5719",
+		},
+	}
+
+	group, err := groups.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Group
+
+	groupID := "a6af1e56-b12b-4733-8f77-49166afd5719"
+	description := "New Description"
+	ports := []string{
+		"d2d7e5c4-5a4b-48b1-8f9c-9b3a19e9d97e",
+	}
+
+	updateOpts := groups.UpdateOpts{
+		Description: &description,
+		Ports:       &ports,
+	}
+
+	group, err := groups.Update(networkClient, groupID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove the Ingress Policy of a Group
+
+	groupID := "a6af1e56-b12b-4733-8f77-49166afd5719"
+
+	group, err := groups.RemoveIngressPolicy(networkClient, groupID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Group
+
+	groupID := "a6af1e56-b12b-4733-8f77-49166afd5719"
+	err := groups.Delete(networkClient, groupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groups
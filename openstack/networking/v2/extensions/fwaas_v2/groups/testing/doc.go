@@ -0,0 +1,2 @@
+// networking_extensions_fwaas_groups_v2
+package testing
@@ -0,0 +1,2 @@
+// networking_extensions_fwaas_policies_v2
+package testing
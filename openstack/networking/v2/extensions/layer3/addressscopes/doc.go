@@ -53,6 +53,19 @@ Example to Update an Address scope
         panic(err)
     }
 
+Example to Associate a Subnetpool with an Address scope
+
+    addressScopeID := "9cc35860-522a-4d35-974d-51d4b011801e"
+    subnetPoolID := "099546ca-788d-41e5-a76d-17d8cd282d3e"
+    updateOpts := subnetpools.UpdateOpts{
+        AddressScopeID: &addressScopeID,
+    }
+
+    subnetPool, err := subnetpools.Update(networkClient, subnetPoolID, updateOpts).Extract()
+    if err != nil {
+        panic(err)
+    }
+
 Example to Delete an Address scope
 
     addressScopeID = "9cc35860-522a-4d35-974d-51d4b011801e"
@@ -82,7 +82,7 @@ type CreateOpts struct {
 	ProjectID string `json:"project_id,omitempty"`
 
 	// IPVersion is the IP protocol version.
-	IPVersion int `json:"ip_version"`
+	IPVersion int `json:"ip_version" required:"true"`
 
 	// Shared indicates whether this address-scope is shared across all projects.
 	Shared bool `json:"shared,omitempty"`
@@ -82,6 +82,19 @@ func (r AddressScopePage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r AddressScopePage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"address_scopes_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty determines whether or not a AddressScopePage is empty.
 func (r AddressScopePage) IsEmpty() (bool, error) {
 	addressScopes, err := ExtractAddressScopes(r)
@@ -151,3 +151,10 @@ func TestDelete(t *testing.T) {
 	res := addressscopes.Delete(fake.ServiceClient(), "9cc35860-522a-4d35-974d-51d4b011801e")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := addressscopes.Create(fake.ServiceClient(), addressscopes.CreateOpts{Name: "test0"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
@@ -47,6 +47,21 @@ Example to Update a Floating IP
 		panic(err)
 	}
 
+Example to Associate a Floating IP with a specific Fixed IP of a Port
+
+	fipID := "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+	portID := "76d0a61b-b8e5-490c-9892-4cf674f2bec8"
+
+	updateOpts := floatingips.UpdateOpts{
+		PortID:  &portID,
+		FixedIP: "10.0.0.5",
+	}
+
+	fip, err := floatingips.Update(networkingClient, fipID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Disassociate a Floating IP with a Port
 
 	fipID := "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
@@ -37,7 +37,7 @@ type ListOpts struct {
 	NotTagsAny        string `q:"not-tags-any"`
 }
 
-// ToNetworkListQuery formats a ListOpts into a query string.
+// ToFloatingIPListQuery formats a ListOpts into a query string.
 func (opts ListOpts) ToFloatingIPListQuery() (string, error) {
 	q, err := gophercloud.BuildQueryString(opts)
 	return q.String(), err
@@ -80,6 +80,44 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListWithFilters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"floating_network_id": "90f742b1-6d17-487b-ba95-71881dbc0b64",
+			"port_id":             "74a342ce-8e07-4e91-880c-9f834b68fa25",
+			"fixed_ip_address":    "192.0.0.2",
+			"status":              "DOWN",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `{"floatingips": [%s]}`, FipNoDNS)
+	})
+
+	listOpts := floatingips.ListOpts{
+		FloatingNetworkID: "90f742b1-6d17-487b-ba95-71881dbc0b64",
+		PortID:            "74a342ce-8e07-4e91-880c-9f834b68fa25",
+		FixedIP:           "192.0.0.2",
+		Status:            "DOWN",
+	}
+
+	allPages, err := floatingips.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := floatingips.ExtractFloatingIPs(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "ada25a95-f321-4f59-b0e0-f3a970dd3d63", actual[0].ID)
+	th.AssertEquals(t, "192.0.0.2", actual[0].FixedIP)
+}
+
 func TestInvalidNextPageURLs(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -359,6 +397,55 @@ func TestAssociate(t *testing.T) {
 	th.AssertDeepEquals(t, portID, ip.PortID)
 }
 
+func TestAssociateWithFixedIP(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/2f245a7b-796b-4f26-9cf9-9e82d248fda7", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+	"floatingip": {
+		"port_id": "423abc8d-2991-4a55-ba98-2aaea84cc72e",
+		"fixed_ip_address": "10.0.0.5"
+	}
+}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+	"floatingip": {
+			"router_id": "d23abc8d-2991-4a55-ba98-2aaea84cc72f",
+			"tenant_id": "4969c491a3c74ee4af974e6d800c62de",
+			"floating_network_id": "376da547-b977-4cfe-9cba-275c80debf57",
+			"fixed_ip_address": "10.0.0.5",
+			"floating_ip_address": "172.24.4.228",
+			"port_id": "423abc8d-2991-4a55-ba98-2aaea84cc72e",
+			"id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+	}
+}
+	`)
+	})
+
+	portID := "423abc8d-2991-4a55-ba98-2aaea84cc72e"
+	updateOpts := floatingips.UpdateOpts{
+		PortID:  &portID,
+		FixedIP: "10.0.0.5",
+	}
+
+	ip, err := floatingips.Update(fake.ServiceClient(), "2f245a7b-796b-4f26-9cf9-9e82d248fda7", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, portID, ip.PortID)
+	th.AssertDeepEquals(t, "10.0.0.5", ip.FixedIP)
+}
+
 func TestDisassociate(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -60,11 +60,11 @@ func Get(c *gophercloud.ServiceClient, floatingIpId string, pfId string) (r GetR
 // CreateOpts contains all the values needed to create a new port forwarding
 // resource. All attributes are required.
 type CreateOpts struct {
-	InternalPortID    string `json:"internal_port_id"`
-	InternalIPAddress string `json:"internal_ip_address"`
-	InternalPort      int    `json:"internal_port"`
-	ExternalPort      int    `json:"external_port"`
-	Protocol          string `json:"protocol"`
+	InternalPortID    string `json:"internal_port_id" required:"true"`
+	InternalIPAddress string `json:"internal_ip_address" required:"true"`
+	InternalPort      int    `json:"internal_port" required:"true"`
+	ExternalPort      int    `json:"external_port" required:"true"`
+	Protocol          string `json:"protocol" required:"true"`
 }
 
 // CreateOptsBuilder allows extensions to add additional parameters to the
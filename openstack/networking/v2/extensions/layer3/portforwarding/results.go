@@ -86,6 +86,19 @@ func (r PortForwardingPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r PortForwardingPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_forwarding_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a PortForwardingPage struct is empty.
 func (r PortForwardingPage) IsEmpty() (bool, error) {
 	is, err := ExtractPortForwardings(r)
@@ -121,6 +121,23 @@ func TestCreate(t *testing.T) {
 	th.AssertEquals(t, "tcp", pf.Protocol)
 }
 
+func TestRequiredFieldsForCreate(t *testing.T) {
+	res1 := portforwarding.Create(fake.ServiceClient(), "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e", portforwarding.CreateOpts{Protocol: "tcp"})
+	if res1.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+
+	res2 := portforwarding.Create(fake.ServiceClient(), "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e", portforwarding.CreateOpts{
+		InternalPortID:    "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+		InternalIPAddress: "10.0.0.11",
+		InternalPort:      25,
+		ExternalPort:      2230,
+	})
+	if res2.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
 func TestGet(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -71,6 +71,31 @@ Example to Remove all Routes from a Router
 		panic(err)
 	}
 
+Example to Set the External Gateway of a Router with a Fixed IP and SNAT disabled
+
+	routerID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+	enableSNAT := false
+	gatewayInfo := routers.GatewayInfo{
+		NetworkID:  "8ca37218-28ff-41cb-9b10-039601ea7e6b",
+		EnableSNAT: &enableSNAT,
+		ExternalFixedIPs: []routers.ExternalFixedIP{
+			{
+				SubnetID:  "ab561bc4-1a8e-48f2-9fbd-376fcb1a1def",
+				IPAddress: "192.0.2.17",
+			},
+		},
+	}
+
+	updateOpts := routers.UpdateOpts{
+		GatewayInfo: &gatewayInfo,
+	}
+
+	router, err := routers.Update(networkClient, routerID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Router
 
 	routerID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
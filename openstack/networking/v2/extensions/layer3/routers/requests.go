@@ -112,12 +112,24 @@ type UpdateOpts struct {
 
 // ToRouterUpdateMap builds an update body based on UpdateOpts.
 func (opts UpdateOpts) ToRouterUpdateMap() (map[string]interface{}, error) {
-	return gophercloud.BuildRequestBody(opts, "router")
+	b, err := gophercloud.BuildRequestBody(opts, "router")
+	if err != nil {
+		return nil, err
+	}
+
+	// A nil slice leaves the routes unchanged, an empty one removes them.
+	if opts.Routes == nil {
+		delete(b["router"].(map[string]interface{}), "routes")
+	}
+
+	return b, nil
 }
 
 // Update allows routers to be updated. You can update the name, administrative
-// state, and the external gateway. For more information about how to set the
-// external gateway for a router, see Create. This operation does not enable
+// state, the external gateway and the extra routes. Routes are only changed
+// when UpdateOpts.Routes is not nil; set it to an empty slice to remove all
+// routes. For more information about how to set the external gateway for a
+// router, see Create. This operation does not enable
 // the update of router interfaces. To do this, use the AddInterface and
 // RemoveInterface functions.
 func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
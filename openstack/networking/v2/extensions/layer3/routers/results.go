@@ -71,6 +71,10 @@ type Router struct {
 	// Used to make network resources highly available.
 	AvailabilityZoneHints []string `json:"availability_zone_hints"`
 
+	// AvailabilityZones are the availability zones the resource is actually
+	// hosted in.
+	AvailabilityZones []string `json:"availability_zones"`
+
 	// Tags optionally set via extensions/attributestags
 	Tags []string `json:"tags"`
 }
@@ -95,6 +99,19 @@ func (r RouterPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r RouterPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"routers_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a RouterPage struct is empty.
 func (r RouterPage) IsEmpty() (bool, error) {
 	is, err := ExtractRouters(r)
@@ -236,6 +236,7 @@ func TestGet(t *testing.T) {
         "tenant_id": "d6554fe62e2f41efbb6e026fad5c1542",
 		"distributed": false,
 		"availability_zone_hints": ["zone1", "zone2"],
+		"availability_zones": ["zone1"],
         "id": "a07eea83-7710-4860-931b-5fe220fae533"
     }
 }
@@ -258,6 +259,7 @@ func TestGet(t *testing.T) {
 	th.AssertEquals(t, n.ID, "a07eea83-7710-4860-931b-5fe220fae533")
 	th.AssertDeepEquals(t, n.Routes, []routers.Route{{DestinationCIDR: "40.0.1.0/24", NextHop: "10.1.0.10"}})
 	th.AssertDeepEquals(t, n.AvailabilityZoneHints, []string{"zone1", "zone2"})
+	th.AssertDeepEquals(t, n.AvailabilityZones, []string{"zone1"})
 }
 
 func TestUpdate(t *testing.T) {
@@ -331,6 +333,58 @@ func TestUpdate(t *testing.T) {
 	th.AssertDeepEquals(t, n.Routes, []routers.Route{{DestinationCIDR: "40.0.1.0/24", NextHop: "10.1.0.10"}})
 }
 
+func TestUpdateWithoutRoutes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "router": {
+        "name": "new_name"
+    }
+}
+			`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "router": {
+        "status": "ACTIVE",
+        "external_gateway_info": {
+            "network_id": "8ca37218-28ff-41cb-9b10-039601ea7e6b"
+        },
+        "name": "new_name",
+        "admin_state_up": true,
+        "tenant_id": "6b96ff0cb17a4b859e1e575d221683d3",
+        "distributed": false,
+        "id": "8604a0de-7f6b-409a-a47c-a1cc7bc77b2e",
+        "routes": [
+            {
+                "nexthop": "10.1.0.10",
+                "destination": "40.0.1.0/24"
+            }
+        ]
+    }
+}
+		`)
+	})
+
+	options := routers.UpdateOpts{Name: "new_name"}
+
+	n, err := routers.Update(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, n.Name, "new_name")
+	th.AssertDeepEquals(t, n.Routes, []routers.Route{{DestinationCIDR: "40.0.1.0/24", NextHop: "10.1.0.10"}})
+}
+
 func TestAllRoutesRemoved(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -0,0 +1,54 @@
+/*
+Package labels provides the ability to retrieve and manage metering labels
+through the Neutron metering extension. A metering label groups the
+metering rules used to account for the traffic of L3 routers.
+
+Example to List Metering Labels
+
+	listOpts := labels.ListOpts{
+		TenantID: "a99e9b4e620e4db09a2dfb6e42a01e66",
+	}
+
+	allPages, err := labels.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allLabels, err := labels.ExtractLabels(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, label := range allLabels {
+		fmt.Printf("%+v\n", label)
+	}
+
+Example to Get a Metering Label
+
+	labelID := "a6700594-5b7a-4105-8bfe-723b346ce866"
+	label, err := labels.Get(networkClient, labelID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Metering Label
+
+	createOpts := labels.CreateOpts{
+		Name:        "external-traffic",
+		Description: "Traffic to and from the public network",
+	}
+
+	label, err := labels.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Metering Label
+
+	labelID := "a6700594-5b7a-4105-8bfe-723b346ce866"
+	err := labels.Delete(networkClient, labelID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package labels
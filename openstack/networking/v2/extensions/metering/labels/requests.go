@@ -0,0 +1,113 @@
+package labels
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMeteringLabelListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the metering label attributes you want to see returned. SortKey allows you
+// to sort by a particular metering label attribute. SortDir sets the
+// direction, and is either `asc' or `desc'. Marker and Limit are used for
+// pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	Shared      *bool  `q:"shared"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToMeteringLabelListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMeteringLabelListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// metering labels. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToMeteringLabelListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return LabelPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific metering label based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToMeteringLabelCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents options used to create a metering label.
+type CreateOpts struct {
+	// Name is the human-readable name of the metering label.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the metering label.
+	Description string `json:"description,omitempty"`
+
+	// Shared indicates whether the metering label applies to the routers of
+	// all projects.
+	Shared *bool `json:"shared,omitempty"`
+
+	// TenantID is the project owner of the metering label. Only
+	// administrative users can specify a project UUID other than their own.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID is the project owner of the metering label. Only
+	// administrative users can specify a project UUID other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToMeteringLabelCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToMeteringLabelCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "metering_label")
+}
+
+// Create accepts a CreateOpts struct and creates a new metering label using
+// the values provided.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToMeteringLabelCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Delete accepts a unique ID and deletes the metering label associated with
+// it. The rules of the label are deleted as well.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return
+}
@@ -0,0 +1,110 @@
+package labels
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Label resource.
+func (r commonResult) Extract() (*Label, error) {
+	var s struct {
+		Label *Label `json:"metering_label"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Label, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Label.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Label.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Label represents a Neutron metering label. Traffic of the routers of the
+// owning project is counted against the label according to its rules.
+type Label struct {
+	// ID is the unique identifier of the metering label.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the metering label.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the metering label.
+	Description string `json:"description"`
+
+	// Shared indicates whether the metering label applies to the routers of
+	// all projects.
+	Shared bool `json:"shared"`
+
+	// TenantID is the project owner of the metering label.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the metering label.
+	ProjectID string `json:"project_id"`
+}
+
+// LabelPage is the page returned by a pager when traversing over a
+// collection of metering labels.
+type LabelPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of metering labels has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r LabelPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"metering_labels_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r LabelPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"metering_labels_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
+// IsEmpty checks whether a LabelPage struct is empty.
+func (r LabelPage) IsEmpty() (bool, error) {
+	is, err := ExtractLabels(r)
+	return len(is) == 0, err
+}
+
+// ExtractLabels accepts a Page struct, specifically a LabelPage struct,
+// and extracts the elements into a slice of Label structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractLabels(r pagination.Page) ([]Label, error) {
+	var s struct {
+		Labels []Label `json:"metering_labels"`
+	}
+	err := (r.(LabelPage)).ExtractInto(&s)
+	return s.Labels, err
+}
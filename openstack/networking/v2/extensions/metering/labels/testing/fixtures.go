@@ -0,0 +1,75 @@
+package testing
+
+import "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/metering/labels"
+
+// ListResponse is a sample response to a List call.
+const ListResponse = `
+{
+    "metering_labels": [
+        {
+            "project_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "description": "label1 description",
+            "name": "label1",
+            "id": "a6700594-5b7a-4105-8bfe-723b346ce866",
+            "shared": false
+        },
+        {
+            "project_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "description": "label2 description",
+            "name": "label2",
+            "id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+            "shared": true
+        }
+    ]
+}
+`
+
+// GetResponse is a sample response to a Get call.
+const GetResponse = `
+{
+    "metering_label": {
+        "project_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+        "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+        "description": "label1 description",
+        "name": "label1",
+        "id": "a6700594-5b7a-4105-8bfe-723b346ce866",
+        "shared": false
+    }
+}
+`
+
+// CreateRequest is a sample request to create a metering label.
+const CreateRequest = `
+{
+    "metering_label": {
+        "name": "label1",
+        "description": "label1 description",
+        "shared": false
+    }
+}
+`
+
+// CreateResponse is a sample response to a Create call.
+const CreateResponse = GetResponse
+
+// Label1 is the first metering label from the List response.
+var Label1 = labels.Label{
+	ID:          "a6700594-5b7a-4105-8bfe-723b346ce866",
+	Name:        "label1",
+	Description: "label1 description",
+	Shared:      false,
+	TenantID:    "45345b0ee1ea477fac0f541b2cb79cd4",
+	ProjectID:   "45345b0ee1ea477fac0f541b2cb79cd4",
+}
+
+// Label2 is the second metering label from the List response.
+var Label2 = labels.Label{
+	ID:          "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+	Name:        "label2",
+	Description: "label2 description",
+	Shared:      true,
+	TenantID:    "45345b0ee1ea477fac0f541b2cb79cd4",
+	ProjectID:   "45345b0ee1ea477fac0f541b2cb79cd4",
+}
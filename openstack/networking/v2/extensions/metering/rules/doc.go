@@ -0,0 +1,56 @@
+/*
+Package rules provides the ability to retrieve and manage metering label
+rules through the Neutron metering extension. A metering rule selects the
+router traffic, by direction and CIDR, that is accounted for by a metering
+label.
+
+Example to List Metering Rules of a Label
+
+	listOpts := rules.ListOpts{
+		MeteringLabelID: "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+	}
+
+	allPages, err := rules.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRules, err := rules.ExtractRules(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, rule := range allRules {
+		fmt.Printf("%+v\n", rule)
+	}
+
+Example to Get a Metering Rule
+
+	ruleID := "00e13b58-b4f2-4579-9c9c-7ac94615f9ae"
+	rule, err := rules.Get(networkClient, ruleID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Metering Rule
+
+	createOpts := rules.CreateOpts{
+		MeteringLabelID: "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+		Direction:       rules.DirEgress,
+		RemoteIPPrefix:  "10.0.0.0/24",
+	}
+
+	rule, err := rules.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Metering Rule
+
+	ruleID := "00e13b58-b4f2-4579-9c9c-7ac94615f9ae"
+	err := rules.Delete(networkClient, ruleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package rules
@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// RuleDirection is the direction of the traffic a metering rule applies to.
+type RuleDirection string
+
+const (
+	// DirIngress applies the metering rule to incoming traffic.
+	DirIngress RuleDirection = "ingress"
+
+	// DirEgress applies the metering rule to outgoing traffic.
+	DirEgress RuleDirection = "egress"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMeteringRuleListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the metering rule attributes you want to see returned. SortKey allows you
+// to sort by a particular metering rule attribute. SortDir sets the
+// direction, and is either `asc' or `desc'. Marker and Limit are used for
+// pagination.
+type ListOpts struct {
+	ID                  string        `q:"id"`
+	Direction           RuleDirection `q:"direction"`
+	MeteringLabelID     string        `q:"metering_label_id"`
+	RemoteIPPrefix      string        `q:"remote_ip_prefix"`
+	SourceIPPrefix      string        `q:"source_ip_prefix"`
+	DestinationIPPrefix string        `q:"destination_ip_prefix"`
+	Excluded            *bool         `q:"excluded"`
+	Limit               int           `q:"limit"`
+	Marker              string        `q:"marker"`
+	SortKey             string        `q:"sort_key"`
+	SortDir             string        `q:"sort_dir"`
+}
+
+// ToMeteringRuleListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMeteringRuleListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// metering rules. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToMeteringRuleListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return RulePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific metering rule based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToMeteringRuleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents options used to create a metering rule.
+type CreateOpts struct {
+	// MeteringLabelID is the ID of the metering label the rule belongs to.
+	MeteringLabelID string `json:"metering_label_id" required:"true"`
+
+	// Direction is the direction of the traffic to account for,
+	// either "ingress" or "egress". Neutron defaults to "ingress".
+	Direction RuleDirection `json:"direction,omitempty"`
+
+	// RemoteIPPrefix is the CIDR the traffic is accounted against.
+	// It is deprecated in favor of SourceIPPrefix and DestinationIPPrefix.
+	RemoteIPPrefix string `json:"remote_ip_prefix,omitempty"`
+
+	// SourceIPPrefix is the source CIDR of the traffic to account for.
+	SourceIPPrefix string `json:"source_ip_prefix,omitempty"`
+
+	// DestinationIPPrefix is the destination CIDR of the traffic to
+	// account for.
+	DestinationIPPrefix string `json:"destination_ip_prefix,omitempty"`
+
+	// Excluded indicates whether the matching traffic is excluded from
+	// the label's count.
+	Excluded *bool `json:"excluded,omitempty"`
+}
+
+// ToMeteringRuleCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToMeteringRuleCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "metering_label_rule")
+}
+
+// Create accepts a CreateOpts struct and creates a new metering rule using
+// the values provided.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToMeteringRuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Delete accepts a unique ID and deletes the metering rule associated with it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return
+}
@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Rule resource.
+func (r commonResult) Extract() (*Rule, error) {
+	var s struct {
+		Rule *Rule `json:"metering_label_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Rule, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Rule.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Rule.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Rule represents a Neutron metering label rule.
+type Rule struct {
+	// ID is the unique identifier of the metering rule.
+	ID string `json:"id"`
+
+	// MeteringLabelID is the ID of the metering label the rule belongs to.
+	MeteringLabelID string `json:"metering_label_id"`
+
+	// Direction is the direction of the accounted traffic,
+	// either "ingress" or "egress".
+	Direction RuleDirection `json:"direction"`
+
+	// RemoteIPPrefix is the CIDR the traffic is accounted against.
+	RemoteIPPrefix string `json:"remote_ip_prefix"`
+
+	// SourceIPPrefix is the source CIDR of the accounted traffic.
+	SourceIPPrefix string `json:"source_ip_prefix"`
+
+	// DestinationIPPrefix is the destination CIDR of the accounted traffic.
+	DestinationIPPrefix string `json:"destination_ip_prefix"`
+
+	// Excluded indicates whether the matching traffic is excluded from
+	// the label's count.
+	Excluded bool `json:"excluded"`
+}
+
+// RulePage is the page returned by a pager when traversing over a
+// collection of metering rules.
+type RulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of metering rules has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r RulePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"metering_label_rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r RulePage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"metering_label_rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
+// IsEmpty checks whether a RulePage struct is empty.
+func (r RulePage) IsEmpty() (bool, error) {
+	is, err := ExtractRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractRules accepts a Page struct, specifically a RulePage struct,
+// and extracts the elements into a slice of Rule structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractRules(r pagination.Page) ([]Rule, error) {
+	var s struct {
+		Rules []Rule `json:"metering_label_rules"`
+	}
+	err := (r.(RulePage)).ExtractInto(&s)
+	return s.Rules, err
+}
@@ -0,0 +1,2 @@
+// metering rules unit tests
+package testing
@@ -0,0 +1,70 @@
+package testing
+
+import "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/metering/rules"
+
+// ListResponse is a sample response to a List call.
+const ListResponse = `
+{
+    "metering_label_rules": [
+        {
+            "remote_ip_prefix": "10.0.1.0/24",
+            "direction": "ingress",
+            "metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+            "id": "9536641a-7d14-4dc5-afaf-93a973ce0eb8",
+            "excluded": false
+        },
+        {
+            "remote_ip_prefix": "10.0.0.0/24",
+            "direction": "egress",
+            "metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+            "id": "ffc6fd15-40de-4e7d-b617-34d3f7a93aec",
+            "excluded": true
+        }
+    ]
+}
+`
+
+// GetResponse is a sample response to a Get call.
+const GetResponse = `
+{
+    "metering_label_rule": {
+        "remote_ip_prefix": "10.0.1.0/24",
+        "direction": "ingress",
+        "metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+        "id": "9536641a-7d14-4dc5-afaf-93a973ce0eb8",
+        "excluded": false
+    }
+}
+`
+
+// CreateRequest is a sample request to create a metering rule.
+const CreateRequest = `
+{
+    "metering_label_rule": {
+        "remote_ip_prefix": "10.0.1.0/24",
+        "direction": "ingress",
+        "metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812"
+    }
+}
+`
+
+// CreateResponse is a sample response to a Create call.
+const CreateResponse = GetResponse
+
+// Rule1 is the first metering rule from the List response.
+var Rule1 = rules.Rule{
+	ID:              "9536641a-7d14-4dc5-afaf-93a973ce0eb8",
+	MeteringLabelID: "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+	Direction:       rules.DirIngress,
+	RemoteIPPrefix:  "10.0.1.0/24",
+	Excluded:        false,
+}
+
+// Rule2 is the second metering rule from the List response.
+var Rule2 = rules.Rule{
+	ID:              "ffc6fd15-40de-4e7d-b617-34d3f7a93aec",
+	MeteringLabelID: "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+	Direction:       rules.DirEgress,
+	RemoteIPPrefix:  "10.0.0.0/24",
+	Excluded:        true,
+}
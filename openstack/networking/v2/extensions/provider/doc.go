@@ -60,7 +60,7 @@ Example to Create a Provider Network
 		Shared:       &iTrue,
 	}
 
-	createOpts : provider.CreateOptsExt{
+	createOpts := provider.CreateOptsExt{
 		CreateOptsBuilder: networkCreateOpts,
 		Segments:          segments,
 	}
@@ -69,5 +69,27 @@ Example to Create a Provider Network
 	if err != nil {
 		panic(err)
 	}
+
+Example to Update the Segments of a Provider Network
+
+	networkID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+	segments := []provider.Segment{
+		provider.Segment{
+			NetworkType:     "vlan",
+			PhysicalNetwork: "physnet1",
+			SegmentationID:  101,
+		},
+	}
+
+	updateOpts := provider.UpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{},
+		Segments:          &segments,
+	}
+
+	network, err := networks.Update(networkClient, networkID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package provider
@@ -33,9 +33,9 @@ type NetworkProviderExt struct {
 
 // Segment defines a physical binding to a logical network.
 type Segment struct {
-	PhysicalNetwork string `json:"provider:physical_network"`
-	NetworkType     string `json:"provider:network_type"`
-	SegmentationID  int    `json:"provider:segmentation_id"`
+	PhysicalNetwork string `json:"provider:physical_network,omitempty"`
+	NetworkType     string `json:"provider:network_type,omitempty"`
+	SegmentationID  int    `json:"provider:segmentation_id,omitempty"`
 }
 
 func (r *NetworkProviderExt) UnmarshalJSON(b []byte) error {
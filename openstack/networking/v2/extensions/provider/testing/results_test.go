@@ -221,3 +221,79 @@ func TestUpdate(t *testing.T) {
 	th.AssertEquals(t, "local", s.NetworkType)
 	th.AssertEquals(t, "1234567890", s.SegmentationID)
 }
+
+func TestUpdateWithSegments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+	"network": {
+		"name": "new_network_name",
+		"segments": [
+			{
+				"provider:segmentation_id": 101,
+				"provider:physical_network": "physnet1",
+				"provider:network_type": "vlan"
+			},
+			{
+				"provider:segmentation_id": 615,
+				"provider:network_type": "vxlan"
+			}
+		]
+	}
+}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+	"network": {
+		"id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+		"name": "new_network_name",
+		"segments": [
+			{
+				"provider:segmentation_id": 101,
+				"provider:physical_network": "physnet1",
+				"provider:network_type": "vlan"
+			},
+			{
+				"provider:segmentation_id": 615,
+				"provider:physical_network": null,
+				"provider:network_type": "vxlan"
+			}
+		]
+	}
+}
+	`)
+	})
+
+	var s struct {
+		networks.Network
+		provider.NetworkProviderExt
+	}
+
+	name := "new_network_name"
+	segments := []provider.Segment{
+		provider.Segment{NetworkType: "vlan", PhysicalNetwork: "physnet1", SegmentationID: 101},
+		provider.Segment{NetworkType: "vxlan", SegmentationID: 615},
+	}
+
+	updateOpts := provider.UpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{Name: &name},
+		Segments:          &segments,
+	}
+
+	err := networks.Update(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", updateOpts).ExtractInto(&s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "4e8e5957-649f-477b-9e5b-f1f75b21c03c", s.ID)
+	th.AssertDeepEquals(t, segments, s.Segments)
+}
@@ -108,6 +108,19 @@ func (r PolicyPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r PolicyPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"policies_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a PolicyPage is empty.
 func (r PolicyPage) IsEmpty() (bool, error) {
 	is, err := ExtractPolicies(r)
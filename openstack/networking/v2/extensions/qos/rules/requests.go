@@ -122,7 +122,7 @@ func (opts UpdateBandwidthLimitRuleOpts) ToBandwidthLimitRuleUpdateMap() (map[st
 	return gophercloud.BuildRequestBody(opts, "bandwidth_limit_rule")
 }
 
-// UpdateBandwidthLimitRule requests the creation of a new BandwidthLimitRule on the server.
+// UpdateBandwidthLimitRule requests the update of an existing BandwidthLimitRule on the server.
 func UpdateBandwidthLimitRule(client *gophercloud.ServiceClient, policyID, ruleID string, opts UpdateBandwidthLimitRuleOptsBuilder) (r UpdateBandwidthLimitRuleResult) {
 	b, err := opts.ToBandwidthLimitRuleUpdateMap()
 	if err != nil {
@@ -135,7 +135,7 @@ func UpdateBandwidthLimitRule(client *gophercloud.ServiceClient, policyID, ruleI
 	return
 }
 
-// Delete accepts policy and rule ID and deletes the BandwidthLimitRule associated with them.
+// DeleteBandwidthLimitRule accepts policy and rule ID and deletes the BandwidthLimitRule associated with them.
 func DeleteBandwidthLimitRule(c *gophercloud.ServiceClient, policyID, ruleID string) (r DeleteBandwidthLimitRuleResult) {
 	_, r.Err = c.Delete(deleteBandwidthLimitRuleURL(c, policyID, ruleID), nil)
 	return
@@ -244,7 +244,7 @@ func (opts UpdateDSCPMarkingRuleOpts) ToDSCPMarkingRuleUpdateMap() (map[string]i
 	return gophercloud.BuildRequestBody(opts, "dscp_marking_rule")
 }
 
-// UpdateDSCPMarkingRule requests the creation of a new DSCPMarkingRule on the server.
+// UpdateDSCPMarkingRule requests the update of an existing DSCPMarkingRule on the server.
 func UpdateDSCPMarkingRule(client *gophercloud.ServiceClient, policyID, ruleID string, opts UpdateDSCPMarkingRuleOptsBuilder) (r UpdateDSCPMarkingRuleResult) {
 	b, err := opts.ToDSCPMarkingRuleUpdateMap()
 	if err != nil {
@@ -373,7 +373,7 @@ func (opts UpdateMinimumBandwidthRuleOpts) ToMinimumBandwidthRuleUpdateMap() (ma
 	return gophercloud.BuildRequestBody(opts, "minimum_bandwidth_rule")
 }
 
-// UpdateMinimumBandwidthRule requests the creation of a new MinimumBandwidthRule on the server.
+// UpdateMinimumBandwidthRule requests the update of an existing MinimumBandwidthRule on the server.
 func UpdateMinimumBandwidthRule(client *gophercloud.ServiceClient, policyID, ruleID string, opts UpdateMinimumBandwidthRuleOptsBuilder) (r UpdateMinimumBandwidthRuleResult) {
 	b, err := opts.ToMinimumBandwidthRuleUpdateMap()
 	if err != nil {
@@ -11,6 +11,16 @@ Example to Get project quotas
 
     fmt.Printf("quotas: %#v\n", quotasInfo)
 
+Example to Get project quotas with usage details
+
+    projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+    quotasInfo, err := quotas.GetDetail(networkClient, projectID).Extract()
+    if err != nil {
+        log.Fatal(err)
+    }
+
+    fmt.Printf("networks used: %d of %d\n", quotasInfo.Network.Used, quotasInfo.Network.Limit)
+
 Example to Update project quotas
 
     projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
@@ -26,11 +36,19 @@ Example to Update project quotas
         Subnet:            gophercloud.IntToPointer(25),
         SubnetPool:        gophercloud.IntToPointer(0),
     }
-    quotasInfo, err := quotas.Update(networkClient, projectID)
+    quotasInfo, err := quotas.Update(networkClient, projectID, updateOpts).Extract()
     if err != nil {
         log.Fatal(err)
     }
 
     fmt.Printf("quotas: %#v\n", quotasInfo)
+
+Example to Reset project quotas to their default values
+
+    projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+    err := quotas.Reset(networkClient, projectID).ExtractErr()
+    if err != nil {
+        log.Fatal(err)
+    }
 */
 package quotas
@@ -8,6 +8,13 @@ func Get(client *gophercloud.ServiceClient, projectID string) (r GetResult) {
 	return
 }
 
+// GetDetail returns detailed Networking Quotas for a project, including
+// current usage and reserved amounts.
+func GetDetail(client *gophercloud.ServiceClient, projectID string) (r GetDetailResult) {
+	_, r.Err = client.Get(getDetailURL(client, projectID), &r.Body, nil)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -63,3 +70,9 @@ func Update(c *gophercloud.ServiceClient, projectID string, opts UpdateOptsBuild
 
 	return
 }
+
+// Reset resets the Networking Quotas of a project to their default values.
+func Reset(c *gophercloud.ServiceClient, projectID string) (r ResetResult) {
+	_, r.Err = c.Delete(resetURL(c, projectID), nil)
+	return
+}
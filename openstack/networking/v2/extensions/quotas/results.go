@@ -1,6 +1,12 @@
 package quotas
 
-import "github.com/gophercloud/gophercloud"
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+)
 
 type commonResult struct {
 	gophercloud.Result
@@ -27,6 +33,28 @@ type UpdateResult struct {
 	commonResult
 }
 
+// GetDetailResult represents the result of a get detail operation. Call its
+// Extract method to interpret it as a QuotaDetailSet.
+type GetDetailResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a QuotaDetailSet
+// resource.
+func (r GetDetailResult) Extract() (*QuotaDetailSet, error) {
+	var s struct {
+		Quota *QuotaDetailSet `json:"quota"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quota, err
+}
+
+// ResetResult represents the result of a reset operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type ResetResult struct {
+	gophercloud.ErrResult
+}
+
 // Quota contains Networking quotas for a project.
 type Quota struct {
 	// FloatingIP represents a number of floating IPs. A "-1" value means no limit.
@@ -56,3 +84,83 @@ type Quota struct {
 	// SubnetPool represents a number of subnet pools. A "-1" value means no limit.
 	SubnetPool int `json:"subnetpool"`
 }
+
+// QuotaDetailSet contains the limit, usage and reservation details of the
+// Networking quotas for a project.
+type QuotaDetailSet struct {
+	// FloatingIP represents floating IP usage information.
+	FloatingIP QuotaDetail `json:"floatingip"`
+
+	// Network represents network usage information.
+	Network QuotaDetail `json:"network"`
+
+	// Port represents port usage information.
+	Port QuotaDetail `json:"port"`
+
+	// RBACPolicy represents RBAC policy usage information.
+	RBACPolicy QuotaDetail `json:"rbac_policy"`
+
+	// Router represents router usage information.
+	Router QuotaDetail `json:"router"`
+
+	// SecurityGroup represents security group usage information.
+	SecurityGroup QuotaDetail `json:"security_group"`
+
+	// SecurityGroupRule represents security group rule usage information.
+	SecurityGroupRule QuotaDetail `json:"security_group_rule"`
+
+	// Subnet represents subnet usage information.
+	Subnet QuotaDetail `json:"subnet"`
+
+	// SubnetPool represents subnet pool usage information.
+	SubnetPool QuotaDetail `json:"subnetpool"`
+
+	// Trunk represents trunk usage information.
+	Trunk QuotaDetail `json:"trunk"`
+}
+
+// QuotaDetail is a set of details about a single Networking resource quota.
+type QuotaDetail struct {
+	// Used is the current number of provisioned resources of the given type.
+	Used int `json:"used"`
+
+	// Reserved is the number of resources of the given type that have been
+	// reserved but not yet provisioned.
+	Reserved int `json:"reserved"`
+
+	// Limit is the maximum number of resources of the given type.
+	// A "-1" value means no limit.
+	Limit int `json:"limit"`
+}
+
+// UnmarshalJSON overrides the default unmarshalling function to accept
+// Reserved as a string, which some Neutron releases return.
+func (q *QuotaDetail) UnmarshalJSON(b []byte) error {
+	type tmp QuotaDetail
+	var s struct {
+		tmp
+		Reserved interface{} `json:"reserved"`
+	}
+
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*q = QuotaDetail(s.tmp)
+
+	switch t := s.Reserved.(type) {
+	case nil:
+		q.Reserved = 0
+	case float64:
+		q.Reserved = int(t)
+	case string:
+		if q.Reserved, err = strconv.Atoi(t); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("reserved has unexpected type: %T", t)
+	}
+
+	return nil
+}
@@ -57,3 +57,35 @@ var UpdateResponse = quotas.Quota{
 	Subnet:            25,
 	SubnetPool:        0,
 }
+
+// GetDetailedResponseRaw is a sample response body for a get detail request.
+// Reserved values are returned as strings by some Neutron releases.
+const GetDetailedResponseRaw = `
+{
+    "quota": {
+        "floatingip": {"used": 0, "limit": 15, "reserved": 0},
+        "network": {"used": 0, "limit": 20, "reserved": 0},
+        "port": {"used": 0, "limit": 25, "reserved": 0},
+        "rbac_policy": {"used": 0, "limit": -1, "reserved": 0},
+        "router": {"used": 0, "limit": 30, "reserved": 0},
+        "security_group": {"used": 0, "limit": 35, "reserved": 0},
+        "security_group_rule": {"used": 0, "limit": 40, "reserved": 0},
+        "subnet": {"used": 0, "limit": 45, "reserved": 0},
+        "subnetpool": {"used": 0, "limit": -1, "reserved": 0},
+        "trunk": {"used": 1, "limit": 50, "reserved": "1"}
+    }
+}
+`
+
+var GetDetailResponse = quotas.QuotaDetailSet{
+	FloatingIP:        quotas.QuotaDetail{Used: 0, Reserved: 0, Limit: 15},
+	Network:           quotas.QuotaDetail{Used: 0, Reserved: 0, Limit: 20},
+	Port:              quotas.QuotaDetail{Used: 0, Reserved: 0, Limit: 25},
+	RBACPolicy:        quotas.QuotaDetail{Used: 0, Reserved: 0, Limit: -1},
+	Router:            quotas.QuotaDetail{Used: 0, Reserved: 0, Limit: 30},
+	SecurityGroup:     quotas.QuotaDetail{Used: 0, Reserved: 0, Limit: 35},
+	SecurityGroupRule: quotas.QuotaDetail{Used: 0, Reserved: 0, Limit: 40},
+	Subnet:            quotas.QuotaDetail{Used: 0, Reserved: 0, Limit: 45},
+	SubnetPool:        quotas.QuotaDetail{Used: 0, Reserved: 0, Limit: -1},
+	Trunk:             quotas.QuotaDetail{Used: 1, Reserved: 1, Limit: 50},
+}
@@ -2,7 +2,10 @@ package quotas
 
 import "github.com/gophercloud/gophercloud"
 
-const resourcePath = "quotas"
+const (
+	resourcePath = "quotas"
+	detailPath   = "details.json"
+)
 
 func resourceURL(c *gophercloud.ServiceClient, projectID string) string {
 	return c.ServiceURL(resourcePath, projectID)
@@ -15,3 +18,11 @@ func getURL(c *gophercloud.ServiceClient, projectID string) string {
 func updateURL(c *gophercloud.ServiceClient, projectID string) string {
 	return resourceURL(c, projectID)
 }
+
+func getDetailURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(resourcePath, projectID, detailPath)
+}
+
+func resetURL(c *gophercloud.ServiceClient, projectID string) string {
+	return resourceURL(c, projectID)
+}
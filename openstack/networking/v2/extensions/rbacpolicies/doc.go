@@ -27,6 +27,20 @@ Example to Create a RBAC Policy
 		panic(err)
 	}
 
+Example to Share a Security Group with a Project
+
+	createOpts := rbacpolicies.CreateOpts{
+		Action:       rbacpolicies.ActionAccessShared,
+		ObjectType:   "security_group",
+		TargetTenant: "6e547a3bcfe44702889fdeff3c3520c3",
+		ObjectID:     "2b0f5c07-6fb7-4b0a-b5d6-4e0bba3da3e5",
+	}
+
+	rbacPolicy, err := rbacpolicies.Create(rbacClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to List RBAC Policies
 
 	listOpts := rbacpolicies.ListOpts{
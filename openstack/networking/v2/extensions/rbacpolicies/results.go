@@ -58,7 +58,8 @@ type RBACPolicy struct {
 	ObjectID string `json:"object_id"`
 
 	// ObjectType is the type of the object that the RBAC policy affects.
-	// Types include qos-policy or network.
+	// Types include network, qos_policy, security_group, address_scope
+	// or subnetpool.
 	ObjectType string `json:"object_type"`
 
 	// TenantID is the ID of the project that owns the resource.
@@ -80,6 +81,33 @@ type RBACPolicyPage struct {
 	pagination.LinkedPageBase
 }
 
+// NextPageURL is invoked when a paginated collection of rbac policies has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r RBACPolicyPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"rbac_policies_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r RBACPolicyPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"rbac_policies_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a RBACPolicyPage struct is empty.
 func (r RBACPolicyPage) IsEmpty() (bool, error) {
 	is, err := ExtractRBACPolicies(r)
@@ -110,3 +110,49 @@ var rbacPolicy2 = rbacpolicies.RBACPolicy{
 }
 
 var ExpectedRBACPoliciesSlice = []rbacpolicies.RBACPolicy{rbacPolicy1, rbacPolicy2}
+
+// ListResponseFirstPage is the first page of a paginated list of
+// rbac-policies. It expects the test server URL to be formatted in.
+const ListResponseFirstPage = `
+{
+	"rbac_policies": [
+		{
+			"target_tenant": "6e547a3bcfe44702889fdeff3c3520c3",
+			"tenant_id": "3de27ce0a2a54cc6ae06dc62dd0ec832",
+			"object_type": "network",
+			"object_id": "240d22bf-bd17-4238-9758-25f72610ecdc",
+			"action": "access_as_shared",
+			"project_id": "3de27ce0a2a54cc6ae06dc62dd0ec832",
+			"id": "2cf7523a-93b5-4e69-9360-6c6bf986bb7c"
+		}
+	],
+	"rbac_policies_links": [
+		{
+			"href": "%s/v2.0/rbac-policies?limit=1&marker=2cf7523a-93b5-4e69-9360-6c6bf986bb7c",
+			"rel": "next"
+		}
+	]
+}`
+
+// ListResponseSecondPage is the last page of a paginated list of
+// rbac-policies. It expects the test server URL to be formatted in.
+const ListResponseSecondPage = `
+{
+	"rbac_policies": [
+		{
+			"target_tenant": "1a547a3bcfe44702889fdeff3c3520c3",
+			"tenant_id": "1ae27ce0a2a54cc6ae06dc62dd0ec832",
+			"object_type": "network",
+			"object_id": "120d22bf-bd17-4238-9758-25f72610ecdc",
+			"action": "access_as_shared",
+			"project_id": "1ae27ce0a2a54cc6ae06dc62dd0ec832",
+			"id":"1ab7523a-93b5-4e69-9360-6c6bf986bb7c"
+		}
+	],
+	"rbac_policies_links": [
+		{
+			"href": "%s/v2.0/rbac-policies?limit=1&marker=1ab7523a-93b5-4e69-9360-6c6bf986bb7c&page_reverse=True",
+			"rel": "previous"
+		}
+	]
+}`
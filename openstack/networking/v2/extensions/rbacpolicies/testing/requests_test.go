@@ -167,3 +167,94 @@ func TestUpdate(t *testing.T) {
 	th.AssertEquals(t, rbacResult.TargetTenant, "9d766060b6354c9e8e2da44cab0e8f38")
 	th.AssertEquals(t, rbacResult.ID, "2cf7523a-93b5-4e69-9360-6c6bf986bb7c")
 }
+
+func TestListPagination(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/rbac-policies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, ListResponseFirstPage, th.Server.URL)
+		case "2cf7523a-93b5-4e69-9360-6c6bf986bb7c":
+			fmt.Fprintf(w, ListResponseSecondPage, th.Server.URL)
+		default:
+			t.Fatalf("/v2.0/rbac-policies invoked with unexpected marker=[%s]", marker)
+		}
+	})
+
+	count := 0
+
+	err := rbacpolicies.List(fake.ServiceClient(), rbacpolicies.ListOpts{Limit: 1}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := rbacpolicies.ExtractRBACPolicies(page)
+		if err != nil {
+			t.Errorf("Failed to extract rbac policies: %v", err)
+			return false, err
+		}
+
+		switch count {
+		case 1:
+			th.CheckDeepEquals(t, []rbacpolicies.RBACPolicy{rbacPolicy1}, actual)
+		case 2:
+			th.CheckDeepEquals(t, []rbacpolicies.RBACPolicy{rbacPolicy2}, actual)
+		}
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 2 {
+		t.Errorf("Expected 2 pages, got %d", count)
+	}
+}
+
+func TestListPreviousPage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/rbac-policies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch {
+		case marker == "":
+			fmt.Fprintf(w, ListResponseFirstPage, th.Server.URL)
+		case marker == "2cf7523a-93b5-4e69-9360-6c6bf986bb7c":
+			fmt.Fprintf(w, ListResponseSecondPage, th.Server.URL)
+		case marker == "1ab7523a-93b5-4e69-9360-6c6bf986bb7c" && r.Form.Get("page_reverse") == "True":
+			fmt.Fprintf(w, ListResponseFirstPage, th.Server.URL)
+		default:
+			t.Fatalf("/v2.0/rbac-policies invoked with unexpected marker=[%s]", marker)
+		}
+	})
+
+	pager := rbacpolicies.List(fake.ServiceClient(), rbacpolicies.ListOpts{Limit: 1})
+	page, err := pager.First()
+	th.AssertNoErr(t, err)
+
+	_, err = pager.Previous(page)
+	th.AssertEquals(t, pagination.ErrPageNotAvailable, err)
+
+	page, err = pager.Next(page)
+	th.AssertNoErr(t, err)
+
+	page, err = pager.Previous(page)
+	th.AssertNoErr(t, err)
+	actual, err := rbacpolicies.ExtractRBACPolicies(page)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []rbacpolicies.RBACPolicy{rbacPolicy1}, actual)
+}
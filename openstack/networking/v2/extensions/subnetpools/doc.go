@@ -61,6 +61,34 @@ Example to Update a Subnetpool
 		panic(err)
 	}
 
+Example to Add Prefixes to a Subnetpool
+
+	subnetPoolID := "099546ca-788d-41e5-a76d-17d8cd282d3e"
+	prefixesOpts := subnetpools.PrefixesOpts{
+		Prefixes: []string{
+			"10.11.12.0/24",
+		},
+	}
+
+	prefixes, err := subnetpools.AddPrefixes(networkClient, subnetPoolID, prefixesOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove Prefixes from a Subnetpool
+
+	subnetPoolID := "099546ca-788d-41e5-a76d-17d8cd282d3e"
+	prefixesOpts := subnetpools.PrefixesOpts{
+		Prefixes: []string{
+			"10.11.12.0/24",
+		},
+	}
+
+	prefixes, err := subnetpools.RemovePrefixes(networkClient, subnetPoolID, prefixesOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Subnetpool
 
 	subnetPoolID := "23d5d3f7-9dfa-4f73-b72b-8b0b0063ec55"
@@ -223,3 +223,50 @@ func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
 	_, r.Err = c.Delete(deleteURL(c, id), nil)
 	return
 }
+
+// PrefixesOptsBuilder allows extensions to add additional parameters to the
+// AddPrefixes and RemovePrefixes requests.
+type PrefixesOptsBuilder interface {
+	ToSubnetPoolPrefixesMap() (map[string]interface{}, error)
+}
+
+// PrefixesOpts represents options used to add or remove prefixes of a
+// subnetpool.
+type PrefixesOpts struct {
+	// Prefixes is the list of subnet prefixes to add to or remove from
+	// the subnetpool.
+	Prefixes []string `json:"prefixes" required:"true"`
+}
+
+// ToSubnetPoolPrefixesMap builds a request body from PrefixesOpts.
+func (opts PrefixesOpts) ToSubnetPoolPrefixesMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// AddPrefixes adds the provided prefixes to an existing subnetpool without
+// having to resubmit the whole list of prefixes.
+func AddPrefixes(c *gophercloud.ServiceClient, subnetPoolID string, opts PrefixesOptsBuilder) (r PrefixesResult) {
+	b, err := opts.ToSubnetPoolPrefixesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(addPrefixesURL(c, subnetPoolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// RemovePrefixes removes the provided prefixes from an existing subnetpool.
+// Prefixes that are in use by subnets can't be removed.
+func RemovePrefixes(c *gophercloud.ServiceClient, subnetPoolID string, opts PrefixesOptsBuilder) (r PrefixesResult) {
+	b, err := opts.ToSubnetPoolPrefixesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(removePrefixesURL(c, subnetPoolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
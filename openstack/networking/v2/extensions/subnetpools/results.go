@@ -47,6 +47,23 @@ type DeleteResult struct {
 	gophercloud.ErrResult
 }
 
+// PrefixesResult represents the result of an add or remove prefixes
+// operation. Call its Extract method to interpret it as the resulting list
+// of subnetpool prefixes.
+type PrefixesResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the prefixes of
+// a subnetpool.
+func (r PrefixesResult) Extract() ([]string, error) {
+	var s struct {
+		Prefixes []string `json:"prefixes"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Prefixes, err
+}
+
 // SubnetPool represents a Neutron subnetpool.
 // A subnetpool is a pool of addresses from which subnets can be allocated.
 type SubnetPool struct {
@@ -249,6 +266,19 @@ func (r SubnetPoolPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r SubnetPoolPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"subnetpools_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty determines whether or not a SubnetPoolPage is empty.
 func (r SubnetPoolPage) IsEmpty() (bool, error) {
 	subnetpools, err := ExtractSubnetPools(r)
@@ -257,3 +257,38 @@ const SubnetPoolUpdateResponse = `
     }
 }
 `
+
+const SubnetPoolAddPrefixesRequest = `
+{
+    "prefixes": [
+        "10.11.12.0/24"
+    ]
+}
+`
+
+const SubnetPoolAddPrefixesResponse = `
+{
+    "prefixes": [
+        "10.8.0.0/16",
+        "10.11.12.0/24",
+        "10.24.0.0/16"
+    ]
+}
+`
+
+const SubnetPoolRemovePrefixesRequest = `
+{
+    "prefixes": [
+        "10.24.0.0/16"
+    ]
+}
+`
+
+const SubnetPoolRemovePrefixesResponse = `
+{
+    "prefixes": [
+        "10.8.0.0/16",
+        "10.11.12.0/24"
+    ]
+}
+`
@@ -192,3 +192,69 @@ func TestDelete(t *testing.T) {
 	res := subnetpools.Delete(fake.ServiceClient(), "099546ca-788d-41e5-a76d-17d8cd282d3e")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestAddPrefixes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnetpools/099546ca-788d-41e5-a76d-17d8cd282d3e/add_prefixes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetPoolAddPrefixesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SubnetPoolAddPrefixesResponse)
+	})
+
+	opts := subnetpools.PrefixesOpts{
+		Prefixes: []string{"10.11.12.0/24"},
+	}
+	prefixes, err := subnetpools.AddPrefixes(fake.ServiceClient(), "099546ca-788d-41e5-a76d-17d8cd282d3e", opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, prefixes, []string{
+		"10.8.0.0/16",
+		"10.11.12.0/24",
+		"10.24.0.0/16",
+	})
+}
+
+func TestRemovePrefixes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnetpools/099546ca-788d-41e5-a76d-17d8cd282d3e/remove_prefixes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetPoolRemovePrefixesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SubnetPoolRemovePrefixesResponse)
+	})
+
+	opts := subnetpools.PrefixesOpts{
+		Prefixes: []string{"10.24.0.0/16"},
+	}
+	prefixes, err := subnetpools.RemovePrefixes(fake.ServiceClient(), "099546ca-788d-41e5-a76d-17d8cd282d3e", opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, prefixes, []string{
+		"10.8.0.0/16",
+		"10.11.12.0/24",
+	})
+}
+
+func TestRequiredPrefixes(t *testing.T) {
+	res := subnetpools.AddPrefixes(fake.ServiceClient(), "099546ca-788d-41e5-a76d-17d8cd282d3e", subnetpools.PrefixesOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
@@ -104,3 +104,38 @@ func TestGet(t *testing.T) {
 	th.AssertEquals(t, ext.Alias, "agent")
 	th.AssertEquals(t, ext.Description, "The agent management extension.")
 }
+
+func TestHasExtension(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/extensions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		fmt.Fprintf(w, `
+{
+    "extensions": [
+        {
+            "updated": "2013-01-20T00:00:00-00:00",
+            "name": "Neutron Service Type Management",
+            "links": [],
+            "namespace": "http://docs.openstack.org/ext/neutron/service-type/api/v1.0",
+            "alias": "service-type",
+            "description": "API for retrieving service providers for Neutron advanced services"
+        }
+    ]
+}
+      `)
+	})
+
+	ok, err := extensions.HasExtension(fake.ServiceClient(), "service-type")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, ok)
+
+	ok, err = extensions.HasExtension(fake.ServiceClient(), "trunk")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, ok)
+}
@@ -0,0 +1,2 @@
+// trunkdetails unit tests
+package testing
@@ -0,0 +1,24 @@
+package testing
+
+// PortWithTrunkDetailsResult represents a raw server response from the
+// Neutron API with trunk_details enabled.
+// Some fields have been deleted from the response.
+const PortWithTrunkDetailsResult = `
+{
+  "port": {
+    "id": "dc3e8758-ee96-402d-94b0-4be5e9396c01",
+    "name": "test-port-with-subports",
+    "trunk_details": {
+      "trunk_id": "f170c831-8c55-4ceb-ad13-75eab4a121e5",
+      "sub_ports": [
+        {
+          "segmentation_id": 100,
+          "segmentation_type": "vlan",
+          "port_id": "20b7a7d3-6eb5-4a0f-b4e6-4c3a8ee8b3c4",
+          "mac_address": "fa:16:3e:88:29:a0"
+        }
+      ]
+    }
+  }
+}
+`
@@ -0,0 +1,49 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunkdetails"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestPortWithTrunkDetails(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	portID := "dc3e8758-ee96-402d-94b0-4be5e9396c01"
+
+	th.Mux.HandleFunc("/v2.0/ports/"+portID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, PortWithTrunkDetailsResult)
+	})
+
+	var portExt struct {
+		ports.Port
+		trunkdetails.TrunkDetailsExt
+	}
+
+	err := ports.Get(fake.ServiceClient(), portID).ExtractInto(&portExt)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, portExt.TrunkDetails.TrunkID, "f170c831-8c55-4ceb-ad13-75eab4a121e5")
+	th.AssertEquals(t, len(portExt.TrunkDetails.SubPorts), 1)
+
+	expected := trunkdetails.Subport{
+		Subport: trunks.Subport{
+			SegmentationID:   100,
+			SegmentationType: "vlan",
+			PortID:           "20b7a7d3-6eb5-4a0f-b4e6-4c3a8ee8b3c4",
+		},
+		MACAddress: "fa:16:3e:88:29:a0",
+	}
+	th.CheckDeepEquals(t, expected, portExt.TrunkDetails.SubPorts[0])
+}
@@ -110,6 +110,33 @@ type TrunkPage struct {
 	pagination.LinkedPageBase
 }
 
+// NextPageURL is invoked when a paginated collection of trunks has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (page TrunkPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"trunks_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (page TrunkPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"trunks_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 func (page TrunkPage) IsEmpty() (bool, error) {
 	trunks, err := ExtractTrunks(page)
 	return len(trunks) == 0, err
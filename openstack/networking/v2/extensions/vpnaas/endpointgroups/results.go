@@ -62,6 +62,19 @@ func (r EndpointGroupPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r EndpointGroupPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"endpoint_groups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether an EndpointGroupPage struct is empty.
 func (r EndpointGroupPage) IsEmpty() (bool, error) {
 	is, err := ExtractEndpointGroups(r)
@@ -70,6 +70,19 @@ func (r ServicePage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r ServicePage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"vpnservices_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a ServicePage struct is empty.
 func (r ServicePage) IsEmpty() (bool, error) {
 	is, err := ExtractServices(r)
@@ -151,7 +151,9 @@ type ListOptsBuilder interface {
 
 // ListOpts allows the filtering and sorting of paginated collections through
 // the API. Filtering is achieved by passing in struct field values that map to
-// the IPSec site connection attributes you want to see returned.
+// the IPSec site connection attributes you want to see returned. SortKey allows
+// you to sort by a particular attribute. SortDir sets the direction, and is
+// either `asc' or `desc'. Marker and Limit are used for pagination.
 type ListOpts struct {
 	IKEPolicyID    string    `q:"ikepolicy_id"`
 	VPNServiceID   string    `q:"vpnservice_id"`
@@ -169,6 +171,14 @@ type ListOpts struct {
 	Initiator      Initiator `q:"initiator"`
 	AdminStateUp   *bool     `q:"admin_state_up"`
 	MTU            int       `q:"mtu"`
+	RouteMode      string    `q:"route_mode"`
+	AuthMode       string    `q:"auth_mode"`
+	Status         string    `q:"status"`
+	ID             string    `q:"id"`
+	Limit          int       `q:"limit"`
+	Marker         string    `q:"marker"`
+	SortKey        string    `q:"sort_key"`
+	SortDir        string    `q:"sort_dir"`
 }
 
 // ToConnectionListQuery formats a ListOpts into a query string.
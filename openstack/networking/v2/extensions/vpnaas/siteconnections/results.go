@@ -112,6 +112,19 @@ func (r ConnectionPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r ConnectionPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"ipsec_site_connections_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a ConnectionPage struct is empty.
 func (r ConnectionPage) IsEmpty() (bool, error) {
 	is, err := ExtractConnections(r)
@@ -311,6 +311,52 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListWithFilters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/vpn/ipsec-site-connections", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"vpnservice_id": "5c561d9d-eaea-45f6-ae3e-08d1a7080828",
+			"status":        "ACTIVE",
+			"limit":         "1",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "ipsec_site_connections":[
+	{
+        "status": "ACTIVE",
+        "name": "vpnconnection1",
+        "vpnservice_id": "5c561d9d-eaea-45f6-ae3e-08d1a7080828",
+        "id": "851f280f-5639-4ea3-81aa-e298525ab74b"
+    }]
+}
+	  `)
+	})
+
+	listOpts := siteconnections.ListOpts{
+		VPNServiceID: "5c561d9d-eaea-45f6-ae3e-08d1a7080828",
+		Status:       "ACTIVE",
+		Limit:        1,
+	}
+
+	allPages, err := siteconnections.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := siteconnections.ExtractConnections(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "851f280f-5639-4ea3-81aa-e298525ab74b", actual[0].ID)
+	th.AssertEquals(t, "ACTIVE", actual[0].Status)
+}
+
 func TestUpdate(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
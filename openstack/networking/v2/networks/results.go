@@ -88,6 +88,10 @@ type Network struct {
 	// Used to make network resources highly available.
 	AvailabilityZoneHints []string `json:"availability_zone_hints"`
 
+	// AvailabilityZones are the availability zones the resource is actually
+	// hosted in.
+	AvailabilityZones []string `json:"availability_zones"`
+
 	// Tags optionally set via extensions/attributestags
 	Tags []string `json:"tags"`
 }
@@ -150,6 +154,19 @@ func (r NetworkPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r NetworkPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"networks_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a NetworkPage struct is empty.
 func (r NetworkPage) IsEmpty() (bool, error) {
 	is, err := ExtractNetworks(r)
@@ -50,6 +50,41 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListWithTags(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"tags":         "abc,123",
+			"tags-any":     "red,blue",
+			"not-tags":     "deprecated",
+			"not-tags-any": "old,stale",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	listOpts := networks.ListOpts{
+		Tags:       "abc,123",
+		TagsAny:    "red,blue",
+		NotTags:    "deprecated",
+		NotTagsAny: "old,stale",
+	}
+
+	allPages, err := networks.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := networks.ExtractNetworks(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedNetworkSlice, actual)
+}
+
 func TestListWithExtensions(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
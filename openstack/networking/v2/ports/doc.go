@@ -62,6 +62,21 @@ Example to Update a Port
 		panic(err)
 	}
 
+Example to Allow a Virtual IP on a Port
+
+	portID := "c34bae2b-7641-49b6-bf6d-d8e473620ed8"
+
+	updateOpts := ports.UpdateOpts{
+		AllowedAddressPairs: &[]ports.AddressPair{
+			{IPAddress: "10.0.0.100"},
+		},
+	}
+
+	port, err := ports.Update(networkClient, portID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Port
 
 	portID := "c34bae2b-7641-49b6-bf6d-d8e473620ed8"
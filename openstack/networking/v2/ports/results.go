@@ -52,7 +52,12 @@ type IP struct {
 
 // AddressPair contains the IP Address and the MAC address.
 type AddressPair struct {
-	IPAddress  string `json:"ip_address,omitempty"`
+	// IPAddress is the IP address or CIDR that is allowed to pass through
+	// the port in addition to its fixed IPs, for example a VRRP VIP.
+	IPAddress string `json:"ip_address,omitempty" required:"true"`
+
+	// MACAddress is the MAC address allowed together with IPAddress. If
+	// omitted, the MAC address of the port is used.
 	MACAddress string `json:"mac_address,omitempty"`
 }
 
@@ -129,6 +134,19 @@ func (r PortPage) NextPageURL() (string, error) {
 	return gophercloud.ExtractNextURL(s.Links)
 }
 
+// PreviousPageURL uses the response's embedded link reference to navigate to
+// the previous page of results.
+func (r PortPage) PreviousPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"ports_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractPreviousURL(s.Links)
+}
+
 // IsEmpty checks whether a PortPage struct is empty.
 func (r PortPage) IsEmpty() (bool, error) {
 	is, err := ExtractPorts(r)
@@ -313,6 +313,29 @@ func TestRequiredCreateOpts(t *testing.T) {
 	}
 }
 
+func TestRequiredAllowedAddressPairIPAddress(t *testing.T) {
+	createOpts := ports.CreateOpts{
+		NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		AllowedAddressPairs: []ports.AddressPair{
+			{MACAddress: "fa:16:3e:c9:cb:f0"},
+		},
+	}
+	res := ports.Create(fake.ServiceClient(), createOpts)
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+
+	updateOpts := ports.UpdateOpts{
+		AllowedAddressPairs: &[]ports.AddressPair{
+			{MACAddress: "fa:16:3e:c9:cb:f0"},
+		},
+	}
+	updateRes := ports.Update(fake.ServiceClient(), "65c0ee9f-d634-4522-8954-51021b570b0d", updateOpts)
+	if updateRes.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
 func TestCreatePortSecurity(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
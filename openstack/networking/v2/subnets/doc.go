@@ -94,6 +94,20 @@ Example to Create a Subnet With a Default Gateway
 		panic(err)
 	}
 
+Example to Create a Subnet From a Subnet Pool
+
+	createOpts := subnets.CreateOpts{
+		NetworkID:    "d32019d3-bc6e-4319-9c1d-6722fc136a23",
+		IPVersion:    4,
+		SubnetPoolID: "b80340c7-9960-4f67-a99c-02501656284b",
+		Prefixlen:    26,
+	}
+
+	subnet, err := subnets.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Subnet
 
 	subnetID := "db77d064-e34f-4d06-b060-f21e28a61c23"
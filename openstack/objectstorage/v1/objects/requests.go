@@ -219,6 +219,10 @@ func (opts CreateOpts) ToObjectCreateParams() (io.Reader, map[string]string, str
 // object. If the returned response's ETag header fails to match the local
 // checksum, the failed request will automatically be retried up to a maximum
 // of 3 times.
+//
+// Content that is not an io.ReadSeeker is read into memory to compute its
+// checksum. To stream large objects instead, set NoETag (or ETag), and
+// either ContentLength or TransferEncoding "chunked".
 func Create(c *gophercloud.ServiceClient, containerName, objectName string, opts CreateOptsBuilder) (r CreateResult) {
 	url := createURL(c, containerName, objectName)
 	h := make(map[string]string)
@@ -42,6 +42,34 @@ Example for list stack resources
     }
 
 
+Example for list stack resources including nested stacks
+
+    listOpts := stackresources.ListOpts{
+        Depth: 2,
+    }
+
+    allPages, err := stackresources.List(client, stack.Name, stack.ID, listOpts).AllPages()
+    if err != nil {
+        panic(err)
+    }
+
+    allResources, err := stackresources.ExtractResources(allPages)
+    if err != nil {
+        panic(err)
+    }
+
+Example for signal a resource
+
+    signalOpts := stackresources.SignalOpts{
+        "status": "SUCCESS",
+        "reason": "configuration complete",
+    }
+
+    err := stackresources.Signal(client, stack.Name, stack.ID, "wait_handle", signalOpts).ExtractErr()
+    if err != nil {
+        panic(err)
+    }
+
 Example for get resource type schema
 
     schema_result := stackresources.Schema(client, "OS::Heat::Stack")
@@ -22,6 +22,9 @@ type ListOptsBuilder interface {
 type ListOpts struct {
 	// Include resources from nest stacks up to Depth levels of recursion.
 	Depth int `q:"nested_depth"`
+
+	// WithDetail includes the resource attributes and metadata in the listing.
+	WithDetail bool `q:"with_detail"`
 }
 
 // ToStackResourceListQuery formats a ListOpts into a query string.
@@ -111,3 +114,37 @@ func MarkUnhealthy(c *gophercloud.ServiceClient, stackName, stackID, resourceNam
 	_, r.Err = c.Patch(markUnhealthyURL(c, stackName, stackID, resourceName), b, nil, nil)
 	return
 }
+
+// SignalOptsBuilder is the interface options structs have to satisfy in order
+// to be used in the Signal operation in this package.
+type SignalOptsBuilder interface {
+	ToSignalMap() (map[string]interface{}, error)
+}
+
+// SignalOpts is the data sent to a resource by the Signal operation. The
+// accepted keys depend on the type of the resource being signaled, e.g.
+// "status", "reason", "data" and "id" for OS::Heat::WaitConditionHandle.
+type SignalOpts map[string]interface{}
+
+// ToSignalMap returns the SignalOpts as a request body.
+func (opts SignalOpts) ToSignalMap() (map[string]interface{}, error) {
+	return opts, nil
+}
+
+// Signal sends a signal to the specified resource in the stack. opts may be
+// nil if the resource does not expect any data.
+func Signal(c *gophercloud.ServiceClient, stackName, stackID, resourceName string, opts SignalOptsBuilder) (r SignalResult) {
+	var b map[string]interface{}
+	if opts != nil {
+		var err error
+		b, err = opts.ToSignalMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+	}
+	_, r.Err = c.Post(signalURL(c, stackName, stackID, resourceName), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -210,3 +210,9 @@ func (r TemplateResult) Extract() ([]byte, error) {
 type MarkUnhealthyResult struct {
 	gophercloud.ErrResult
 }
+
+// SignalResult represents the result of a signal operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type SignalResult struct {
+	gophercloud.ErrResult
+}
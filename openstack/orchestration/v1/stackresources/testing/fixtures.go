@@ -454,3 +454,15 @@ func HandleMarkUnhealthySuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 }
+
+// HandleSignalSuccessfully creates an HTTP handler at `/stacks/teststack/0b1771bd-9336-4f2b-ae86-a80f971faf1e/resources/wait_handle/signal`
+// on the test handler mux that responds with a `Signal` response.
+func HandleSignalSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/teststack/0b1771bd-9336-4f2b-ae86-a80f971faf1e/resources/wait_handle/signal", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"status": "SUCCESS", "reason": "configured"}`)
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -123,3 +123,26 @@ func TestMarkUnhealthyResource(t *testing.T) {
 	err := stackresources.MarkUnhealthy(fake.ServiceClient(), "teststack", "0b1771bd-9336-4f2b-ae86-a80f971faf1e", "wordpress_instance", markUnhealthyOpts).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestSignalResource(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSignalSuccessfully(t)
+
+	signalOpts := stackresources.SignalOpts{
+		"status": "SUCCESS",
+		"reason": "configured",
+	}
+	err := stackresources.Signal(fake.ServiceClient(), "teststack", "0b1771bd-9336-4f2b-ae86-a80f971faf1e", "wait_handle", signalOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListOptsNested(t *testing.T) {
+	opts := stackresources.ListOpts{
+		Depth:      2,
+		WithDetail: true,
+	}
+	query, err := opts.ToStackResourceListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?nested_depth=2&with_detail=true", query)
+}
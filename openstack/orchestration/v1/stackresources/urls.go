@@ -33,3 +33,7 @@ func templateURL(c *gophercloud.ServiceClient, typeName string) string {
 func markUnhealthyURL(c *gophercloud.ServiceClient, stackName, stackID, resourceName string) string {
 	return c.ServiceURL("stacks", stackName, stackID, "resources", resourceName)
 }
+
+func signalURL(c *gophercloud.ServiceClient, stackName, stackID, resourceName string) string {
+	return c.ServiceURL("stacks", stackName, stackID, "resources", resourceName, "signal")
+}
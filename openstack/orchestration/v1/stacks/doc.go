@@ -200,6 +200,25 @@ Example to Update a Stack Using the UpdatePatch (PATCH) Method
 		panic(res.Err)
 	}
 
+Example to Preview an Update of a Stack
+
+	stackName := "my_stack"
+	stackId := "d68cc349-ccc5-4b44-a17d-07f068c01e5a"
+
+	stackOpts := &stacks.UpdateOpts{
+		Parameters:   params,
+		TemplateOpts: &template,
+	}
+
+	changes, err := stacks.UpdatePreview(orchestrationClient, stackName, stackId, stackOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, resource := range changes.Replaced {
+		fmt.Printf("%s will be replaced\n", resource["resource_name"])
+	}
+
 Example YAML Template Containing a Heat::ResourceGroup With Three Nodes
 
 	heat_template_version: 2016-04-08
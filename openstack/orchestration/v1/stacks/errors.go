@@ -39,3 +39,17 @@ type ErrTemplateRequired struct {
 func (e ErrTemplateRequired) Error() string {
 	return fmt.Sprintf("Template required for this function.")
 }
+
+// ErrStackFailed is returned by WaitForStatus when a stack enters a failed
+// status.
+type ErrStackFailed struct {
+	gophercloud.BaseError
+	Name   string
+	ID     string
+	Status string
+	Reason string
+}
+
+func (e ErrStackFailed) Error() string {
+	return fmt.Sprintf("Stack %s (%s) is in status %s: %s", e.Name, e.ID, e.Status, e.Reason)
+}
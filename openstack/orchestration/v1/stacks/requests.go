@@ -420,6 +420,21 @@ func UpdatePatch(c *gophercloud.ServiceClient, stackName, stackID string, opts U
 	return
 }
 
+// UpdatePreview accepts an UpdateOpts struct and previews the changes an
+// Update with the same options would make to an existing stack, without
+// applying them. opts.TemplateOpts is required.
+func UpdatePreview(c *gophercloud.ServiceClient, stackName, stackID string, opts UpdateOptsBuilder) (r UpdatePreviewResult) {
+	b, err := opts.ToStackUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(updatePreviewURL(c, stackName, stackID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
 // Delete deletes a stack based on the stack name and stack ID.
 func Delete(c *gophercloud.ServiceClient, stackName, stackID string) (r DeleteResult) {
 	_, r.Err = c.Delete(deleteURL(c, stackName, stackID), nil)
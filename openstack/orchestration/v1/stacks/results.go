@@ -189,6 +189,31 @@ type UpdateResult struct {
 	gophercloud.ErrResult
 }
 
+// ResourceChanges represents the changes an update would make to the
+// resources of a stack, grouped by the kind of change.
+type ResourceChanges struct {
+	Added     []map[string]interface{} `json:"added"`
+	Deleted   []map[string]interface{} `json:"deleted"`
+	Replaced  []map[string]interface{} `json:"replaced"`
+	Unchanged []map[string]interface{} `json:"unchanged"`
+	Updated   []map[string]interface{} `json:"updated"`
+}
+
+// UpdatePreviewResult represents the result of an UpdatePreview operation.
+type UpdatePreviewResult struct {
+	gophercloud.Result
+}
+
+// Extract returns a pointer to a ResourceChanges object and is called after
+// an UpdatePreview operation.
+func (r UpdatePreviewResult) Extract() (*ResourceChanges, error) {
+	var s struct {
+		ResourceChanges *ResourceChanges `json:"resource_changes"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ResourceChanges, err
+}
+
 // DeleteResult represents the result of a Delete operation.
 type DeleteResult struct {
 	gophercloud.ErrResult
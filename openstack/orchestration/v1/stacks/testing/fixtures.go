@@ -261,6 +261,54 @@ func HandleUpdatePatchSuccessfully(t *testing.T) {
 	})
 }
 
+// UpdatePreviewOutput represents the response body from an UpdatePreview
+// request.
+const UpdatePreviewOutput = `
+{
+  "resource_changes": {
+    "added": [],
+    "deleted": [],
+    "replaced": [
+      {
+        "resource_name": "hello_world",
+        "resource_type": "OS::Nova::Server"
+      }
+    ],
+    "unchanged": [],
+    "updated": []
+  }
+}`
+
+// UpdatePreviewExpected represents the expected object from an UpdatePreview
+// request.
+var UpdatePreviewExpected = &stacks.ResourceChanges{
+	Added:   []map[string]interface{}{},
+	Deleted: []map[string]interface{}{},
+	Replaced: []map[string]interface{}{
+		{
+			"resource_name": "hello_world",
+			"resource_type": "OS::Nova::Server",
+		},
+	},
+	Unchanged: []map[string]interface{}{},
+	Updated:   []map[string]interface{}{},
+}
+
+// HandleUpdatePreviewSuccessfully creates an HTTP handler at
+// `/stacks/gophercloud-test-stack-2/db6977b2-27aa-4775-9ae7-6213212d4ada/preview`
+// on the test handler mux that responds with an `UpdatePreview` response.
+func HandleUpdatePreviewSuccessfully(t *testing.T, output string) {
+	th.Mux.HandleFunc("/stacks/gophercloud-test-stack-2/db6977b2-27aa-4775-9ae7-6213212d4ada/preview", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, output)
+	})
+}
+
 // HandleDeleteSuccessfully creates an HTTP handler at `/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87`
 // on the test handler mux that responds with a `Delete` response.
 func HandleDeleteSuccessfully(t *testing.T) {
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/gophercloud/gophercloud"
@@ -207,6 +208,31 @@ func TestUpdatePatchStack(t *testing.T) {
 	th.AssertNoErr(t, err)
 }
 
+func TestUpdatePreviewStack(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdatePreviewSuccessfully(t, UpdatePreviewOutput)
+
+	template := new(stacks.Template)
+	template.Bin = []byte(`
+		{
+			"heat_template_version": "2013-05-23",
+			"description": "Simple template to test heat commands",
+			"parameters": {
+				"flavor": {
+					"default": "m1.tiny",
+					"type": "string"
+				}
+			}
+		}`)
+	updateOpts := &stacks.UpdateOpts{
+		TemplateOpts: template,
+	}
+	actual, err := stacks.UpdatePreview(fake.ServiceClient(), "gophercloud-test-stack-2", "db6977b2-27aa-4775-9ae7-6213212d4ada", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, UpdatePreviewExpected, actual)
+}
+
 func TestDeleteStack(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -257,3 +283,27 @@ func TestAbandonStack(t *testing.T) {
 	expected := AbandonExpected
 	th.AssertDeepEquals(t, expected, actual)
 }
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t, GetOutput)
+
+	err := stacks.WaitForStatus(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87", "CREATE_COMPLETE", 5)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStatusFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	output := strings.Replace(GetOutput, `"stack_status": "CREATE_COMPLETE"`, `"stack_status": "CREATE_FAILED"`, 1)
+	output = strings.Replace(output, "Stack CREATE completed successfully", "Resource CREATE failed", 1)
+	HandleGetSuccessfully(t, output)
+
+	err := stacks.WaitForStatus(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87", "CREATE_COMPLETE", 5)
+
+	failed, ok := err.(stacks.ErrStackFailed)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "CREATE_FAILED", failed.Status)
+	th.AssertEquals(t, "Resource CREATE failed", failed.Reason)
+}
@@ -34,6 +34,10 @@ func previewURL(c *gophercloud.ServiceClient) string {
 	return c.ServiceURL("stacks", "preview")
 }
 
+func updatePreviewURL(c *gophercloud.ServiceClient, name, id string) string {
+	return c.ServiceURL("stacks", name, id, "preview")
+}
+
 func abandonURL(c *gophercloud.ServiceClient, name, id string) string {
 	return c.ServiceURL("stacks", name, id, "abandon")
 }
@@ -0,0 +1,35 @@
+package stacks
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// WaitForStatus will continually poll a stack until it reaches a specified
+// status, e.g. "CREATE_COMPLETE". It returns an ErrStackFailed as soon as the
+// stack enters a failed status, e.g. "CREATE_FAILED". It will do this for at
+// most the number of seconds specified.
+func WaitForStatus(c *gophercloud.ServiceClient, stackName, stackID, status string, secs int) error {
+	return gophercloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, stackName, stackID).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		if strings.HasSuffix(current.Status, "_FAILED") {
+			return false, ErrStackFailed{
+				Name:   stackName,
+				ID:     stackID,
+				Status: current.Status,
+				Reason: current.StatusReason,
+			}
+		}
+
+		return false, nil
+	})
+}
@@ -16,8 +16,20 @@ type ValidateOptsBuilder interface {
 
 // ValidateOpts specifies the template validation parameters.
 type ValidateOpts struct {
-	Template    string `json:"template" or:"TemplateURL"`
-	TemplateURL string `json:"template_url" or:"Template"`
+	Template    string `json:"template,omitempty" or:"TemplateURL"`
+	TemplateURL string `json:"template_url,omitempty" or:"Template"`
+
+	// Environment is the contents of an environment file to validate the
+	// template against.
+	Environment string `json:"environment,omitempty"`
+
+	// Files maps the names of files referenced by the template or environment
+	// to their contents.
+	Files map[string]string `json:"files,omitempty"`
+
+	// Parameters are user-defined parameter values to validate the template
+	// with.
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
 }
 
 // ToStackTemplateValidateMap assembles a request body based on the contents of a ValidateOpts.
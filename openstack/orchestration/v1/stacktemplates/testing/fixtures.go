@@ -94,3 +94,59 @@ func HandleValidateSuccessfully(t *testing.T, output string) {
 		fmt.Fprintf(w, output)
 	})
 }
+
+// ValidateWithParametersRequest represents the request body of a Validate
+// request with an environment and parameters.
+const ValidateWithParametersRequest = `
+{
+	"template": "{\"heat_template_version\": \"2013-05-23\"}",
+	"environment": "{\"parameters\": {\"flavor\": \"m1.small\"}}",
+	"files": {
+		"my_nested.yaml": "heat_template_version: 2013-05-23"
+	},
+	"parameters": {
+		"image": "cirros"
+	}
+}`
+
+// ValidateWithParameterGroupsOutput represents the response body from a
+// Validate request for a template with parameter groups.
+const ValidateWithParameterGroupsOutput = `
+{
+	"Description": "Simple template to test heat commands",
+	"Parameters": {},
+	"ParameterGroups": [
+		{
+			"label": "Server Config",
+			"parameters": ["flavor", "image"]
+		}
+	]
+}`
+
+// ValidateWithParameterGroupsExpected represents the expected object from a
+// Validate request for a template with parameter groups.
+var ValidateWithParameterGroupsExpected = &stacktemplates.ValidatedTemplate{
+	Description: "Simple template to test heat commands",
+	Parameters:  map[string]interface{}{},
+	ParameterGroups: []map[string]interface{}{
+		{
+			"label":      "Server Config",
+			"parameters": []interface{}{"flavor", "image"},
+		},
+	},
+}
+
+// HandleValidateWithParametersSuccessfully creates an HTTP handler at
+// `/validate` on the test handler mux that checks the request body and
+// responds with a `Validate` response.
+func HandleValidateWithParametersSuccessfully(t *testing.T, output string) {
+	th.Mux.HandleFunc("/validate", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, ValidateWithParametersRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, output)
+	})
+}
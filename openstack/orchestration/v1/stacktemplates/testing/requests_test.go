@@ -56,3 +56,30 @@ func TestValidateTemplate(t *testing.T) {
 	expected := ValidateExpected
 	th.AssertDeepEquals(t, expected, actual)
 }
+
+func TestValidateTemplateWithParameters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleValidateWithParametersSuccessfully(t, ValidateWithParameterGroupsOutput)
+
+	opts := stacktemplates.ValidateOpts{
+		Template:    `{"heat_template_version": "2013-05-23"}`,
+		Environment: `{"parameters": {"flavor": "m1.small"}}`,
+		Files: map[string]string{
+			"my_nested.yaml": "heat_template_version: 2013-05-23",
+		},
+		Parameters: map[string]interface{}{
+			"image": "cirros",
+		},
+	}
+	actual, err := stacktemplates.Validate(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ValidateWithParameterGroupsExpected, actual)
+}
+
+func TestValidateTemplateRequiresTemplate(t *testing.T) {
+	res := stacktemplates.Validate(fake.ServiceClient(), stacktemplates.ValidateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
@@ -0,0 +1,28 @@
+/*
+Package templateversions provides information about the template versions
+supported by a Heat deployment and the intrinsic functions available in each
+of them.
+
+Example to list template versions
+
+    versions, err := templateversions.List(client).Extract()
+    if err != nil {
+        panic(err)
+    }
+
+    for _, version := range versions {
+        fmt.Println(version.Version, version.Type)
+    }
+
+Example to list the functions of a template version
+
+    functions, err := templateversions.ListFunctions(client, "heat_template_version.2016-10-14").Extract()
+    if err != nil {
+        panic(err)
+    }
+
+    for _, function := range functions {
+        fmt.Println(function.Name, function.Description)
+    }
+*/
+package templateversions
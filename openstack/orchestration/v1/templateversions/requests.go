@@ -0,0 +1,17 @@
+package templateversions
+
+import "github.com/gophercloud/gophercloud"
+
+// List makes a request against the API to list the available template
+// versions.
+func List(client *gophercloud.ServiceClient) (r ListResult) {
+	_, r.Err = client.Get(listURL(client), &r.Body, nil)
+	return
+}
+
+// ListFunctions makes a request against the API to list the functions
+// available in the given template version.
+func ListFunctions(client *gophercloud.ServiceClient, version string) (r ListFunctionsResult) {
+	_, r.Err = client.Get(listFunctionsURL(client, version), &r.Body, nil)
+	return
+}
@@ -0,0 +1,46 @@
+package templateversions
+
+import "github.com/gophercloud/gophercloud"
+
+// TemplateVersion represents a template version supported by Heat.
+type TemplateVersion struct {
+	Version string   `json:"version"`
+	Type    string   `json:"type"`
+	Aliases []string `json:"aliases"`
+}
+
+// TemplateFunction represents an intrinsic function of a template version.
+type TemplateFunction struct {
+	Name        string `json:"functions"`
+	Description string `json:"description"`
+}
+
+// ListResult represents the result of a List operation.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract returns a slice of TemplateVersion objects and is called after a
+// List operation.
+func (r ListResult) Extract() ([]TemplateVersion, error) {
+	var s struct {
+		TemplateVersions []TemplateVersion `json:"template_versions"`
+	}
+	err := r.ExtractInto(&s)
+	return s.TemplateVersions, err
+}
+
+// ListFunctionsResult represents the result of a ListFunctions operation.
+type ListFunctionsResult struct {
+	gophercloud.Result
+}
+
+// Extract returns a slice of TemplateFunction objects and is called after a
+// ListFunctions operation.
+func (r ListFunctionsResult) Extract() ([]TemplateFunction, error) {
+	var s struct {
+		TemplateFunctions []TemplateFunction `json:"template_functions"`
+	}
+	err := r.ExtractInto(&s)
+	return s.TemplateFunctions, err
+}
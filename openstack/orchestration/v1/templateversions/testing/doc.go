@@ -0,0 +1,2 @@
+// orchestration_templateversions_v1
+package testing
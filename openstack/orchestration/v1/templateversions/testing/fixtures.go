@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/templateversions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListExpected represents the expected object from a List request.
+var ListExpected = []templateversions.TemplateVersion{
+	{
+		Version: "HeatTemplateFormatVersion.2012-12-12",
+		Type:    "cfn",
+		Aliases: []string{},
+	},
+	{
+		Version: "heat_template_version.2016-10-14",
+		Type:    "hot",
+		Aliases: []string{"heat_template_version.newton"},
+	},
+}
+
+// ListOutput represents the response body from a List request.
+const ListOutput = `
+{
+  "template_versions": [
+    {
+      "version": "HeatTemplateFormatVersion.2012-12-12",
+      "type": "cfn",
+      "aliases": []
+    },
+    {
+      "version": "heat_template_version.2016-10-14",
+      "type": "hot",
+      "aliases": ["heat_template_version.newton"]
+    }
+  ]
+}`
+
+// HandleListSuccessfully creates an HTTP handler at `/template_versions`
+// on the test handler mux that responds with a `List` response.
+func HandleListSuccessfully(t *testing.T, output string) {
+	th.Mux.HandleFunc("/template_versions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, output)
+	})
+}
+
+// ListFunctionsExpected represents the expected object from a ListFunctions
+// request.
+var ListFunctionsExpected = []templateversions.TemplateFunction{
+	{
+		Name:        "get_attr",
+		Description: "A function for resolving resource attributes.",
+	},
+	{
+		Name:        "get_param",
+		Description: "A function for resolving parameter references.",
+	},
+}
+
+// ListFunctionsOutput represents the response body from a ListFunctions
+// request.
+const ListFunctionsOutput = `
+{
+  "template_functions": [
+    {
+      "functions": "get_attr",
+      "description": "A function for resolving resource attributes."
+    },
+    {
+      "functions": "get_param",
+      "description": "A function for resolving parameter references."
+    }
+  ]
+}`
+
+// HandleListFunctionsSuccessfully creates an HTTP handler at
+// `/template_versions/heat_template_version.2016-10-14/functions`
+// on the test handler mux that responds with a `ListFunctions` response.
+func HandleListFunctionsSuccessfully(t *testing.T, output string) {
+	th.Mux.HandleFunc("/template_versions/heat_template_version.2016-10-14/functions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, output)
+	})
+}
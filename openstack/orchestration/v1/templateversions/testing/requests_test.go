@@ -0,0 +1,29 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/templateversions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListTemplateVersions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t, ListOutput)
+
+	actual, err := templateversions.List(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ListExpected, actual)
+}
+
+func TestListTemplateFunctions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListFunctionsSuccessfully(t, ListFunctionsOutput)
+
+	actual, err := templateversions.ListFunctions(fake.ServiceClient(), "heat_template_version.2016-10-14").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ListFunctionsExpected, actual)
+}
@@ -0,0 +1,11 @@
+package templateversions
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("template_versions")
+}
+
+func listFunctionsURL(c *gophercloud.ServiceClient, version string) string {
+	return c.ServiceURL("template_versions", version, "functions")
+}
@@ -0,0 +1,24 @@
+/*
+Package allocationcandidates lists the resource providers able to serve a
+set of resources from the OpenStack Placement service. It requires
+microversion 1.12 or above.
+
+Example to list allocation candidates
+
+	placementClient.Microversion = "1.29"
+
+	opts := allocationcandidates.ListOpts{
+		Resources: "VCPU:4,MEMORY_MB:2048,DISK_GB:64",
+		Required:  "HW_CPU_X86_AVX2",
+	}
+
+	candidates, err := allocationcandidates.List(placementClient, opts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for rpUUID, summary := range candidates.ProviderSummaries {
+		fmt.Printf("%s: %+v\n", rpUUID, summary.Resources)
+	}
+*/
+package allocationcandidates
@@ -0,0 +1,63 @@
+package allocationcandidates
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAllocationCandidatesListQuery() (string, error)
+}
+
+// ListOpts describes the resources an allocation candidate must be able to
+// serve.
+type ListOpts struct {
+	// Resources is a comma-separated list of strings indicating an amount of
+	// resource of a specified class that a provider must have the capacity to
+	// serve, e.g. "VCPU:4,DISK_GB:64,MEMORY_MB:2048".
+	Resources string `q:"resources" required:"true"`
+
+	// Required is a comma-separated list of traits that a provider must have,
+	// or must not have when prefixed with "!". Requires microversion 1.17 or
+	// above.
+	Required string `q:"required"`
+
+	// MemberOf is a string representing aggregate uuids the providers must
+	// be associated with. Requires microversion 1.21 or above.
+	MemberOf string `q:"member_of"`
+
+	// InTree is a resource provider UUID. The returned candidates will only
+	// include providers in the same tree. Requires microversion 1.31 or above.
+	InTree string `q:"in_tree"`
+
+	// Limit is the maximum number of allocation requests to return.
+	// Requires microversion 1.16 or above.
+	Limit int `q:"limit"`
+}
+
+// ToAllocationCandidatesListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAllocationCandidatesListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List makes a request against the API to list the allocation candidates
+// able to serve the requested resources. Requires microversion 1.12 or above.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToAllocationCandidatesListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
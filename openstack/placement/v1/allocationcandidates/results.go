@@ -0,0 +1,74 @@
+package allocationcandidates
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// AllocationCandidates contains the possible allocations able to serve the
+// requested resources, along with a summary of each involved resource
+// provider.
+type AllocationCandidates struct {
+	// AllocationRequests is a list of objects which can be used to claim the
+	// requested resources.
+	AllocationRequests []AllocationRequest `json:"allocation_requests"`
+
+	// ProviderSummaries contains information about the resource providers
+	// involved in the allocation requests, keyed by resource provider UUID.
+	ProviderSummaries map[string]ProviderSummary `json:"provider_summaries"`
+}
+
+// AllocationRequest is a single possible allocation of the requested
+// resources.
+type AllocationRequest struct {
+	// Allocations contains the resources to allocate, keyed by resource
+	// provider UUID.
+	Allocations map[string]Allocation `json:"allocations"`
+
+	// Mappings maps request group suffixes to the resource provider UUIDs
+	// satisfying them. Requires microversion 1.34 or above.
+	Mappings map[string][]string `json:"mappings"`
+}
+
+// Allocation contains the amounts of resources to allocate from a single
+// resource provider, keyed by resource class.
+type Allocation struct {
+	Resources map[string]int `json:"resources"`
+}
+
+// ProviderSummary describes the capacity and usage of a resource provider.
+type ProviderSummary struct {
+	// Resources contains the capacity and usage of the resource provider,
+	// keyed by resource class.
+	Resources map[string]ResourceSummary `json:"resources"`
+
+	// Traits is a list of traits of the resource provider.
+	// Requires microversion 1.17 or above.
+	Traits []string `json:"traits"`
+
+	// ParentProviderUUID is the UUID of the immediate parent of the resource
+	// provider. Requires microversion 1.29 or above.
+	ParentProviderUUID string `json:"parent_provider_uuid"`
+
+	// RootProviderUUID is the UUID of the top-most provider in the provider
+	// tree. Requires microversion 1.29 or above.
+	RootProviderUUID string `json:"root_provider_uuid"`
+}
+
+// ResourceSummary contains the capacity and usage of a resource class.
+type ResourceSummary struct {
+	Capacity int `json:"capacity"`
+	Used     int `json:"used"`
+}
+
+// ListResult is the response of a List operation. Call its Extract method
+// to interpret it as AllocationCandidates.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListResult as AllocationCandidates.
+func (r ListResult) Extract() (*AllocationCandidates, error) {
+	var s AllocationCandidates
+	err := r.ExtractInto(&s)
+	return &s, err
+}
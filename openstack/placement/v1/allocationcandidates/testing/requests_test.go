@@ -0,0 +1,33 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/placement/v1/allocationcandidates"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListAllocationCandidates(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleAllocationCandidatesList(t)
+
+	opts := allocationcandidates.ListOpts{
+		Resources: "VCPU:1,MEMORY_MB:1024,DISK_GB:100",
+		Required:  "HW_CPU_X86_AVX2",
+		Limit:     1,
+	}
+
+	actual, err := allocationcandidates.List(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedAllocationCandidates, *actual)
+}
+
+func TestListAllocationCandidatesRequiresResources(t *testing.T) {
+	res := allocationcandidates.List(fake.ServiceClient(), allocationcandidates.ListOpts{})
+	if res.Err == nil {
+		t.Fatal("Expected error when resources are not set")
+	}
+}
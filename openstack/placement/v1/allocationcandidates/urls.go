@@ -0,0 +1,11 @@
+package allocationcandidates
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	apiName = "allocation_candidates"
+)
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(apiName)
+}
@@ -1,5 +1,6 @@
 /*
-Package resourceproviders creates and lists all resource providers from the OpenStack Placement service.
+Package resourceproviders manages resource providers and retrieves their
+inventories, usages and traits from the OpenStack Placement service.
 
 Example to list resource providers
 
@@ -29,5 +30,50 @@ Example to create resource providers
 		panic(err)
 	}
 
+Example to get a resource provider
+
+	rp, err := resourceproviders.Get(placementClient, resourceProviderID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to update a resource provider
+
+	opts := resourceproviders.UpdateOpts{
+		Name: "renamed-rp",
+	}
+
+	rp, err := resourceproviders.Update(placementClient, resourceProviderID, opts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a resource provider
+
+	err := resourceproviders.Delete(placementClient, resourceProviderID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to get resource provider usages
+
+	rp, err := resourceproviders.GetUsages(placementClient, resourceProviderID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to get resource provider inventories
+
+	rp, err := resourceproviders.GetInventories(placementClient, resourceProviderID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to get resource provider traits
+
+	rp, err := resourceproviders.GetTraits(placementClient, resourceProviderID).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package resourceproviders
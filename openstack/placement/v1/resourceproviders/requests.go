@@ -102,3 +102,71 @@ func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r Create
 
 	return
 }
+
+// Get retrieves a specific resource provider based on its unique ID.
+func Get(client *gophercloud.ServiceClient, resourceProviderID string) (r GetResult) {
+	_, r.Err = client.Get(getResourceProviderURL(client, resourceProviderID), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToResourceProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents options used to update a resource provider.
+type UpdateOpts struct {
+	// Name is the name of the resource provider. It must always be provided.
+	Name string `json:"name" required:"true"`
+
+	// ParentProviderUUID is the UUID of the immediate parent of the resource
+	// provider. Requires microversion 1.14 or above.
+	ParentProviderUUID *string `json:"parent_provider_uuid,omitempty"`
+}
+
+// ToResourceProviderUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToResourceProviderUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update makes a request against the API to update a resource provider.
+func Update(client *gophercloud.ServiceClient, resourceProviderID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToResourceProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(updateResourceProviderURL(client, resourceProviderID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete accepts a unique ID and deletes the resource provider associated
+// with it.
+func Delete(client *gophercloud.ServiceClient, resourceProviderID string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteResourceProviderURL(client, resourceProviderID), nil)
+	return
+}
+
+// GetUsages retrieves the resources consumed from a specific resource
+// provider.
+func GetUsages(client *gophercloud.ServiceClient, resourceProviderID string) (r GetUsagesResult) {
+	_, r.Err = client.Get(getResourceProviderUsagesURL(client, resourceProviderID), &r.Body, nil)
+	return
+}
+
+// GetInventories retrieves the inventories of a specific resource provider.
+func GetInventories(client *gophercloud.ServiceClient, resourceProviderID string) (r GetInventoriesResult) {
+	_, r.Err = client.Get(getResourceProviderInventoriesURL(client, resourceProviderID), &r.Body, nil)
+	return
+}
+
+// GetTraits retrieves the traits of a specific resource provider.
+// Requires microversion 1.6 or above.
+func GetTraits(client *gophercloud.ServiceClient, resourceProviderID string) (r GetTraitsResult) {
+	_, r.Err = client.Get(getResourceProviderTraitsURL(client, resourceProviderID), &r.Body, nil)
+	return
+}
@@ -33,6 +33,52 @@ type ResourceProvider struct {
 	RootProviderUUID string `json:"root_provider_uuid"`
 }
 
+// ResourceProviderUsage contains the resources consumed from a resource
+// provider, keyed by resource class.
+type ResourceProviderUsage struct {
+	ResourceProviderGeneration int            `json:"resource_provider_generation"`
+	Usages                     map[string]int `json:"usages"`
+}
+
+// Inventory describes the amount of a resource class a resource provider
+// makes available.
+type Inventory struct {
+	// AllocationRatio is the overcommit ratio of the resource class.
+	AllocationRatio float32 `json:"allocation_ratio"`
+
+	// MaxUnit is the maximum amount of the resource a single allocation can
+	// request.
+	MaxUnit int `json:"max_unit"`
+
+	// MinUnit is the minimum amount of the resource a single allocation can
+	// request.
+	MinUnit int `json:"min_unit"`
+
+	// Reserved is the amount of the resource which is not available for
+	// allocations.
+	Reserved int `json:"reserved"`
+
+	// StepSize is the granularity in which the resource can be allocated.
+	StepSize int `json:"step_size"`
+
+	// Total is the actual amount of the resource the provider has.
+	Total int `json:"total"`
+}
+
+// ResourceProviderInventories contains the inventories of a resource
+// provider, keyed by resource class.
+type ResourceProviderInventories struct {
+	ResourceProviderGeneration int                  `json:"resource_provider_generation"`
+	Inventories                map[string]Inventory `json:"inventories"`
+}
+
+// ResourceProviderTraits contains the traits associated with a resource
+// provider.
+type ResourceProviderTraits struct {
+	ResourceProviderGeneration int      `json:"resource_provider_generation"`
+	Traits                     []string `json:"traits"`
+}
+
 // resourceProviderResult is the resposne of a base ResourceProvider result.
 type resourceProviderResult struct {
 	gophercloud.Result
@@ -52,6 +98,63 @@ type CreateResult struct {
 	resourceProviderResult
 }
 
+// GetResult is the result of a Get operation. Call its Extract
+// method to interpret it as a ResourceProvider.
+type GetResult struct {
+	resourceProviderResult
+}
+
+// UpdateResult is the result of an Update operation. Call its Extract
+// method to interpret it as a ResourceProvider.
+type UpdateResult struct {
+	resourceProviderResult
+}
+
+// DeleteResult represents the result of a Delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// GetUsagesResult is the response of a GetUsages operation. Call its Extract
+// method to interpret it as a ResourceProviderUsage.
+type GetUsagesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetUsagesResult as a ResourceProviderUsage.
+func (r GetUsagesResult) Extract() (*ResourceProviderUsage, error) {
+	var s ResourceProviderUsage
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetInventoriesResult is the response of a GetInventories operation. Call
+// its Extract method to interpret it as a ResourceProviderInventories.
+type GetInventoriesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetInventoriesResult as a ResourceProviderInventories.
+func (r GetInventoriesResult) Extract() (*ResourceProviderInventories, error) {
+	var s ResourceProviderInventories
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetTraitsResult is the response of a GetTraits operation. Call its Extract
+// method to interpret it as a ResourceProviderTraits.
+type GetTraitsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetTraitsResult as a ResourceProviderTraits.
+func (r GetTraitsResult) Extract() (*ResourceProviderTraits, error) {
+	var s ResourceProviderTraits
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
 // ResourceProvidersPage contains a single page of all resource providers from a List call.
 type ResourceProvidersPage struct {
 	pagination.SinglePageBase
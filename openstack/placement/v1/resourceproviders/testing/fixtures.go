@@ -11,6 +11,8 @@ import (
 	fake "github.com/gophercloud/gophercloud/testhelper/client"
 )
 
+const ResourceProviderTestID = "99c09379-6e52-4ef8-9a95-b9ce6f68452e"
+
 const ResourceProvidersBody = `
 {
   "resource_providers": [
@@ -60,6 +62,75 @@ const ResourceProviderCreateBody = `
 }
 `
 
+const ResourceProviderUpdateBody = `
+{
+  "generation": 1,
+  "uuid": "99c09379-6e52-4ef8-9a95-b9ce6f68452e",
+  "links": [
+	{
+	  "href": "/resource_providers/99c09379-6e52-4ef8-9a95-b9ce6f68452e",
+	  "rel": "self"
+	}
+  ],
+  "name": "renamed.localdomain",
+  "parent_provider_uuid": "542df8ed-9be2-49b9-b4db-6d3183ff8ec8",
+  "root_provider_uuid": "542df8ed-9be2-49b9-b4db-6d3183ff8ec8"
+}
+`
+
+const UsagesBody = `
+{
+  "resource_provider_generation": 1,
+  "usages": {
+    "DISK_GB": 1,
+    "MEMORY_MB": 512,
+    "VCPU": 1
+  }
+}
+`
+
+const InventoriesBody = `
+{
+  "inventories": {
+    "DISK_GB": {
+      "allocation_ratio": 1.0,
+      "max_unit": 35,
+      "min_unit": 1,
+      "reserved": 0,
+      "step_size": 1,
+      "total": 35
+    },
+    "MEMORY_MB": {
+      "allocation_ratio": 1.5,
+      "max_unit": 5825,
+      "min_unit": 1,
+      "reserved": 512,
+      "step_size": 1,
+      "total": 5825
+    },
+    "VCPU": {
+      "allocation_ratio": 16.0,
+      "max_unit": 4,
+      "min_unit": 1,
+      "reserved": 0,
+      "step_size": 1,
+      "total": 4
+    }
+  },
+  "resource_provider_generation": 7
+}
+`
+
+const TraitsBody = `
+{
+  "resource_provider_generation": 1,
+  "traits": [
+    "CUSTOM_HW_FPGA_CLASS1",
+    "CUSTOM_HW_FPGA_CLASS3"
+  ]
+}
+`
+
 var ExpectedResourceProvider1 = resourceproviders.ResourceProvider{
 	Generation: 1,
 	UUID:       "99c09379-6e52-4ef8-9a95-b9ce6f68452e",
@@ -88,6 +159,67 @@ var ExpectedResourceProvider2 = resourceproviders.ResourceProvider{
 	RootProviderUUID:   "d0b381e9-8761-42de-8e6c-bba99a96d5f5",
 }
 
+var ExpectedUpdatedResourceProvider = resourceproviders.ResourceProvider{
+	Generation: 1,
+	UUID:       "99c09379-6e52-4ef8-9a95-b9ce6f68452e",
+	Links: []resourceproviders.ResourceProviderLinks{
+		{
+			Href: "/resource_providers/99c09379-6e52-4ef8-9a95-b9ce6f68452e",
+			Rel:  "self",
+		},
+	},
+	Name:               "renamed.localdomain",
+	ParentProviderUUID: "542df8ed-9be2-49b9-b4db-6d3183ff8ec8",
+	RootProviderUUID:   "542df8ed-9be2-49b9-b4db-6d3183ff8ec8",
+}
+
+var ExpectedUsages = resourceproviders.ResourceProviderUsage{
+	ResourceProviderGeneration: 1,
+	Usages: map[string]int{
+		"DISK_GB":   1,
+		"MEMORY_MB": 512,
+		"VCPU":      1,
+	},
+}
+
+var ExpectedInventories = resourceproviders.ResourceProviderInventories{
+	ResourceProviderGeneration: 7,
+	Inventories: map[string]resourceproviders.Inventory{
+		"DISK_GB": {
+			AllocationRatio: 1.0,
+			MaxUnit:         35,
+			MinUnit:         1,
+			Reserved:        0,
+			StepSize:        1,
+			Total:           35,
+		},
+		"MEMORY_MB": {
+			AllocationRatio: 1.5,
+			MaxUnit:         5825,
+			MinUnit:         1,
+			Reserved:        512,
+			StepSize:        1,
+			Total:           5825,
+		},
+		"VCPU": {
+			AllocationRatio: 16.0,
+			MaxUnit:         4,
+			MinUnit:         1,
+			Reserved:        0,
+			StepSize:        1,
+			Total:           4,
+		},
+	},
+}
+
+var ExpectedTraits = resourceproviders.ResourceProviderTraits{
+	ResourceProviderGeneration: 1,
+	Traits: []string{
+		"CUSTOM_HW_FPGA_CLASS1",
+		"CUSTOM_HW_FPGA_CLASS3",
+	},
+}
+
 var ExpectedResourceProviders = []resourceproviders.ResourceProvider{
 	ExpectedResourceProvider1,
 	ExpectedResourceProvider2,
@@ -117,3 +249,85 @@ func HandleResourceProviderCreate(t *testing.T) {
 		fmt.Fprintf(w, ResourceProviderCreateBody)
 	})
 }
+
+func HandleResourceProviderGet(t *testing.T) {
+	resourceProviderURL := fmt.Sprintf("/resource_providers/%s", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(resourceProviderURL, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ResourceProviderCreateBody)
+	})
+}
+
+func HandleResourceProviderUpdate(t *testing.T) {
+	resourceProviderURL := fmt.Sprintf("/resource_providers/%s", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(resourceProviderURL, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"name": "renamed.localdomain"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ResourceProviderUpdateBody)
+	})
+}
+
+func HandleResourceProviderDelete(t *testing.T) {
+	resourceProviderURL := fmt.Sprintf("/resource_providers/%s", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(resourceProviderURL, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func HandleResourceProviderGetUsages(t *testing.T) {
+	usageTestURL := fmt.Sprintf("/resource_providers/%s/usages", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(usageTestURL, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UsagesBody)
+	})
+}
+
+func HandleResourceProviderGetInventories(t *testing.T) {
+	inventoriesTestURL := fmt.Sprintf("/resource_providers/%s/inventories", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(inventoriesTestURL, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, InventoriesBody)
+	})
+}
+
+func HandleResourceProviderGetTraits(t *testing.T) {
+	traitsTestURL := fmt.Sprintf("/resource_providers/%s/traits", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(traitsTestURL, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, TraitsBody)
+	})
+}
@@ -55,3 +55,72 @@ func TestCreateResourceProvider(t *testing.T) {
 
 	th.AssertDeepEquals(t, &expected, actual)
 }
+
+func TestGetResourceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGet(t)
+
+	actual, err := resourceproviders.Get(fake.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedResourceProvider1, actual)
+}
+
+func TestUpdateResourceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderUpdate(t)
+
+	opts := resourceproviders.UpdateOpts{
+		Name: "renamed.localdomain",
+	}
+
+	actual, err := resourceproviders.Update(fake.ServiceClient(), ResourceProviderTestID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedUpdatedResourceProvider, actual)
+}
+
+func TestDeleteResourceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderDelete(t)
+
+	err := resourceproviders.Delete(fake.ServiceClient(), ResourceProviderTestID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetResourceProvidersUsages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetUsages(t)
+
+	actual, err := resourceproviders.GetUsages(fake.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedUsages, *actual)
+}
+
+func TestGetResourceProvidersInventories(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetInventories(t)
+
+	actual, err := resourceproviders.GetInventories(fake.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedInventories, *actual)
+}
+
+func TestGetResourceProvidersTraits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetTraits(t)
+
+	actual, err := resourceproviders.GetTraits(fake.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedTraits, *actual)
+}
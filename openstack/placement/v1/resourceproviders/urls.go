@@ -9,3 +9,27 @@ const (
 func resourceProvidersListURL(client *gophercloud.ServiceClient) string {
 	return client.ServiceURL(apiName)
 }
+
+func getResourceProviderURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID)
+}
+
+func updateResourceProviderURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID)
+}
+
+func deleteResourceProviderURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID)
+}
+
+func getResourceProviderUsagesURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "usages")
+}
+
+func getResourceProviderInventoriesURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "inventories")
+}
+
+func getResourceProviderTraitsURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "traits")
+}
@@ -0,0 +1,55 @@
+/*
+Package hosts manages the compute hosts which can be reserved through the
+OpenStack Reservation service (Blazar).
+
+Example to List Hosts
+
+	allPages, err := hosts.List(client).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allHosts, err := hosts.ExtractHosts(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, host := range allHosts {
+		fmt.Printf("%+v\n", host)
+	}
+
+Example to Add a Host
+
+	createOpts := hosts.CreateOpts{
+		Name: "compute-1",
+		ExtraCapabilities: map[string]string{
+			"gpu": "true",
+		},
+	}
+
+	host, err := hosts.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update the Extra Capabilities of a Host
+
+	updateOpts := hosts.UpdateOpts{
+		ExtraCapabilities: map[string]string{
+			"gpu": "false",
+		},
+	}
+
+	host, err := hosts.Update(client, hostID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove a Host
+
+	err := hosts.Delete(client, hostID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package hosts
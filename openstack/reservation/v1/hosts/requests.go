@@ -0,0 +1,100 @@
+package hosts
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List returns a Pager which allows you to iterate over the hosts available
+// for reservation.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
+		return HostPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific host based on its unique ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToHostCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters to add a compute host to the reservation
+// service.
+type CreateOpts struct {
+	// Name is the name of the compute host.
+	Name string `json:"name" required:"true"`
+
+	// ExtraCapabilities are arbitrary properties of the host which can be
+	// used to select it in reservations.
+	ExtraCapabilities map[string]string `json:"-"`
+}
+
+// ToHostCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToHostCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range opts.ExtraCapabilities {
+		b[k] = v
+	}
+
+	return b, nil
+}
+
+// Create adds a compute host to the reservation service.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToHostCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToHostUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the extra capabilities to set on a host.
+type UpdateOpts struct {
+	// ExtraCapabilities are the extra capabilities to add or change.
+	ExtraCapabilities map[string]string `json:"values" required:"true"`
+}
+
+// ToHostUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToHostUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update sets the extra capabilities of a host.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToHostUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete removes a host from the reservation service.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
@@ -0,0 +1,152 @@
+package hosts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Host represents a compute host managed by the reservation service.
+type Host struct {
+	// ID is the unique ID of the host.
+	ID string `json:"id"`
+
+	// HypervisorHostname is the hostname of the hypervisor.
+	HypervisorHostname string `json:"hypervisor_hostname"`
+
+	// HypervisorType is the type of the hypervisor.
+	HypervisorType string `json:"hypervisor_type"`
+
+	// HypervisorVersion is the version of the hypervisor.
+	HypervisorVersion int `json:"hypervisor_version"`
+
+	// VCPUs is the number of VCPUs of the host.
+	VCPUs int `json:"vcpus"`
+
+	// CPUInfo describes the CPU of the host.
+	CPUInfo string `json:"cpu_info"`
+
+	// MemoryMB is the amount of memory of the host.
+	MemoryMB int `json:"memory_mb"`
+
+	// LocalGB is the size of the local disk of the host.
+	LocalGB int `json:"local_gb"`
+
+	// ServiceName is the name of the compute service of the host.
+	ServiceName string `json:"service_name"`
+
+	// Reservable indicates whether the host can be reserved.
+	Reservable bool `json:"reservable"`
+
+	// TrustID is the ID of the trust used to manage the host.
+	TrustID string `json:"trust_id"`
+
+	// CreatedAt is the date the host was added.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the host was last updated.
+	UpdatedAt time.Time `json:"-"`
+
+	// ExtraCapabilities are the arbitrary properties of the host.
+	ExtraCapabilities map[string]interface{} `json:"-"`
+}
+
+// hostAttributes are the keys of a host which are not extra capabilities.
+var hostAttributes = []string{
+	"id", "hypervisor_hostname", "hypervisor_type", "hypervisor_version",
+	"vcpus", "cpu_info", "memory_mb", "local_gb", "service_name",
+	"reservable", "trust_id", "created_at", "updated_at", "status",
+}
+
+func (r *Host) UnmarshalJSON(b []byte) error {
+	type tmp Host
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Host(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	var extra map[string]interface{}
+	err = json.Unmarshal(b, &extra)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range hostAttributes {
+		delete(extra, k)
+	}
+
+	if len(extra) > 0 {
+		r.ExtraCapabilities = extra
+	}
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Host.
+func (r commonResult) Extract() (*Host, error) {
+	var s struct {
+		Host *Host `json:"host"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Host, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Host.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Host.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Host.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// HostPage is a single page of Host results.
+type HostPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a HostPage contains any results.
+func (r HostPage) IsEmpty() (bool, error) {
+	hosts, err := ExtractHosts(r)
+	return len(hosts) == 0, err
+}
+
+// ExtractHosts returns a slice of Hosts contained in a single page of
+// results.
+func ExtractHosts(r pagination.Page) ([]Host, error) {
+	var s struct {
+		Hosts []Host `json:"hosts"`
+	}
+	err := (r.(HostPage)).ExtractInto(&s)
+	return s.Hosts, err
+}
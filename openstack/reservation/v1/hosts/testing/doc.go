@@ -0,0 +1,2 @@
+// reservation_hosts_v1
+package testing
@@ -0,0 +1,141 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/reservation/v1/hosts"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const HostID = "1"
+
+// HostBody is the JSON representation of a single host.
+const HostBody = `
+{
+  "id": "1",
+  "hypervisor_hostname": "compute-1",
+  "hypervisor_type": "QEMU",
+  "hypervisor_version": 2010001,
+  "vcpus": 2,
+  "cpu_info": "{\"vendor\": \"Intel\"}",
+  "memory_mb": 8192,
+  "local_gb": 10,
+  "service_name": "compute-1",
+  "reservable": true,
+  "trust_id": "5f18b4c9aa8d4f9a8e1a6ab2bd2e94fb",
+  "created_at": "2017-12-27T10:00:00.000000",
+  "updated_at": null,
+  "gpu": "true"
+}
+`
+
+// GetResponse is a sample response to a Get call.
+var GetResponse = fmt.Sprintf(`{"host": %s}`, HostBody)
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`{"hosts": [%s]}`, HostBody)
+
+// CreateRequest is a sample request to add a host.
+const CreateRequest = `
+{
+  "name": "compute-1",
+  "gpu": "true"
+}
+`
+
+// UpdateRequest is a sample request to update a host.
+const UpdateRequest = `
+{
+  "values": {
+    "gpu": "true"
+  }
+}
+`
+
+// FirstHost is the host described by HostBody.
+var FirstHost = hosts.Host{
+	ID:                 HostID,
+	HypervisorHostname: "compute-1",
+	HypervisorType:     "QEMU",
+	HypervisorVersion:  2010001,
+	VCPUs:              2,
+	CPUInfo:            `{"vendor": "Intel"}`,
+	MemoryMB:           8192,
+	LocalGB:            10,
+	ServiceName:        "compute-1",
+	Reservable:         true,
+	TrustID:            "5f18b4c9aa8d4f9a8e1a6ab2bd2e94fb",
+	CreatedAt:          time.Date(2017, 12, 27, 10, 0, 0, 0, time.UTC),
+	ExtraCapabilities: map[string]interface{}{
+		"gpu": "true",
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hosts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hosts/"+HostID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hosts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hosts/"+HostID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hosts/"+HostID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/reservation/v1/hosts"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListHosts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := hosts.List(fake.ServiceClient()).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := hosts.ExtractHosts(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []hosts.Host{FirstHost}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetHost(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := hosts.Get(fake.ServiceClient(), HostID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstHost, actual)
+}
+
+func TestCreateHost(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := hosts.CreateOpts{
+		Name: "compute-1",
+		ExtraCapabilities: map[string]string{
+			"gpu": "true",
+		},
+	}
+
+	actual, err := hosts.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstHost, actual)
+}
+
+func TestUpdateHost(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := hosts.UpdateOpts{
+		ExtraCapabilities: map[string]string{
+			"gpu": "true",
+		},
+	}
+
+	actual, err := hosts.Update(fake.ServiceClient(), HostID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstHost, actual)
+}
+
+func TestDeleteHost(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := hosts.Delete(fake.ServiceClient(), HostID)
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,33 @@
+package hosts
+
+import "github.com/gophercloud/gophercloud"
+
+const hostsPath = "os-hosts"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(hostsPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, hostID string) string {
+	return c.ServiceURL(hostsPath, hostID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, hostID string) string {
+	return resourceURL(c, hostID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, hostID string) string {
+	return resourceURL(c, hostID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, hostID string) string {
+	return resourceURL(c, hostID)
+}
@@ -0,0 +1,63 @@
+/*
+Package leases manages leases of the OpenStack Reservation service (Blazar).
+A lease reserves hosts or instance capacity for a period of time.
+
+Example to List Leases
+
+	allPages, err := leases.List(client).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allLeases, err := leases.ExtractLeases(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, lease := range allLeases {
+		fmt.Printf("%+v\n", lease)
+	}
+
+Example to Reserve a Host
+
+	start := time.Now().Add(time.Hour)
+	end := start.Add(24 * time.Hour)
+
+	createOpts := leases.CreateOpts{
+		Name:      "batch",
+		StartDate: start.UTC().Format(leases.DateFormat),
+		EndDate:   end.UTC().Format(leases.DateFormat),
+		Reservations: []leases.ReservationOpts{
+			{
+				ResourceType:         leases.ResourceTypeHost,
+				Min:                  1,
+				Max:                  2,
+				HypervisorProperties: `[">=", "$vcpus", "16"]`,
+			},
+		},
+	}
+
+	lease, err := leases.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Prolong a Lease
+
+	updateOpts := leases.UpdateOpts{
+		ProlongFor: "1d",
+	}
+
+	lease, err := leases.Update(client, leaseID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Lease
+
+	err := leases.Delete(client, leaseID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package leases
@@ -0,0 +1,225 @@
+package leases
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Resource types that can be reserved through a lease.
+const (
+	ResourceTypeHost     = "physical:host"
+	ResourceTypeInstance = "virtual:instance"
+)
+
+// DateFormat is the format of the dates sent in lease requests.
+const DateFormat = "2006-01-02 15:04"
+
+// List returns a Pager which allows you to iterate over the leases of the
+// current project.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
+		return LeasePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific lease based on its unique ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// ReservationOpts represents a resource request of a lease.
+type ReservationOpts struct {
+	// ResourceType is the type of the reserved resource, either
+	// ResourceTypeHost or ResourceTypeInstance.
+	ResourceType string `json:"resource_type" required:"true"`
+
+	// Min is the minimum number of hosts to reserve.
+	Min int `json:"min,omitempty"`
+
+	// Max is the maximum number of hosts to reserve.
+	Max int `json:"max,omitempty"`
+
+	// HypervisorProperties is a JSON filter on hypervisor properties used
+	// to select the reserved hosts, e.g. `[">=", "$vcpus", "4"]`.
+	HypervisorProperties string `json:"hypervisor_properties"`
+
+	// ResourceProperties is a JSON filter on the extra capabilities used to
+	// select the reserved hosts or instance slots.
+	ResourceProperties string `json:"resource_properties"`
+
+	// BeforeEnd is the action taken before the end of the reservation, one
+	// of "default" or "snapshot".
+	BeforeEnd string `json:"before_end,omitempty"`
+
+	// Amount is the number of instances to reserve.
+	Amount int `json:"amount,omitempty"`
+
+	// VCPUs is the number of VCPUs of each reserved instance.
+	VCPUs int `json:"vcpus,omitempty"`
+
+	// MemoryMB is the amount of memory of each reserved instance.
+	MemoryMB int `json:"memory_mb,omitempty"`
+
+	// DiskGB is the size of the root disk of each reserved instance.
+	DiskGB int `json:"disk_gb,omitempty"`
+
+	// Affinity sets the placement policy of the reserved instances.
+	Affinity *bool `json:"affinity,omitempty"`
+}
+
+// EventOpts represents a custom event of a lease.
+type EventOpts struct {
+	// EventType is the type of the event, e.g. "before_end_lease".
+	EventType string `json:"event_type" required:"true"`
+
+	// EventDate is the date of the event, formatted using DateFormat.
+	EventDate string `json:"event_date" required:"true"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToLeaseCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new lease.
+type CreateOpts struct {
+	// Name is the name of the lease.
+	Name string `json:"name" required:"true"`
+
+	// StartDate is the date the lease starts, formatted using DateFormat,
+	// or "now".
+	StartDate string `json:"start_date" required:"true"`
+
+	// EndDate is the date the lease ends, formatted using DateFormat.
+	EndDate string `json:"end_date" required:"true"`
+
+	// BeforeEndDate is the date the before end action is triggered,
+	// formatted using DateFormat.
+	BeforeEndDate string `json:"before_end_date,omitempty"`
+
+	// Reservations are the resources requested by the lease.
+	Reservations []ReservationOpts `json:"reservations" required:"true"`
+
+	// Events are custom events of the lease.
+	Events []EventOpts `json:"events,omitempty"`
+}
+
+// ToLeaseCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToLeaseCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// The API requires the events to always be present.
+	if _, ok := b["events"]; !ok {
+		b["events"] = []interface{}{}
+	}
+
+	return b, nil
+}
+
+// Create requests the creation of a new lease.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLeaseCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateReservationOpts represents an update to a reservation of a lease.
+type UpdateReservationOpts struct {
+	// ID is the ID of the reservation to update.
+	ID string `json:"id" required:"true"`
+
+	// Min is the minimum number of hosts to reserve.
+	Min int `json:"min,omitempty"`
+
+	// Max is the maximum number of hosts to reserve.
+	Max int `json:"max,omitempty"`
+
+	// HypervisorProperties is a JSON filter on hypervisor properties.
+	HypervisorProperties *string `json:"hypervisor_properties,omitempty"`
+
+	// ResourceProperties is a JSON filter on the extra capabilities.
+	ResourceProperties *string `json:"resource_properties,omitempty"`
+
+	// Amount is the number of instances to reserve.
+	Amount int `json:"amount,omitempty"`
+
+	// VCPUs is the number of VCPUs of each reserved instance.
+	VCPUs int `json:"vcpus,omitempty"`
+
+	// MemoryMB is the amount of memory of each reserved instance.
+	MemoryMB int `json:"memory_mb,omitempty"`
+
+	// DiskGB is the size of the root disk of each reserved instance.
+	DiskGB int `json:"disk_gb,omitempty"`
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToLeaseUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters to update a lease.
+type UpdateOpts struct {
+	// Name is the new name of the lease.
+	Name string `json:"name,omitempty"`
+
+	// StartDate is the new start date, formatted using DateFormat.
+	StartDate string `json:"start_date,omitempty"`
+
+	// EndDate is the new end date, formatted using DateFormat.
+	EndDate string `json:"end_date,omitempty"`
+
+	// BeforeEndDate is the new before end date, formatted using DateFormat.
+	BeforeEndDate string `json:"before_end_date,omitempty"`
+
+	// ProlongFor extends the lease by the given duration, e.g. "1d".
+	ProlongFor string `json:"prolong_for,omitempty"`
+
+	// ReduceBy shortens the lease by the given duration, e.g. "1h".
+	ReduceBy string `json:"reduce_by,omitempty"`
+
+	// DeferBy moves the lease later by the given duration.
+	DeferBy string `json:"defer_by,omitempty"`
+
+	// AdvanceBy moves the lease earlier by the given duration.
+	AdvanceBy string `json:"advance_by,omitempty"`
+
+	// Reservations are updates to the reservations of the lease.
+	Reservations []UpdateReservationOpts `json:"reservations,omitempty"`
+}
+
+// ToLeaseUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToLeaseUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update requests the update of a lease.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLeaseUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete requests the deletion of a lease.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
@@ -0,0 +1,232 @@
+package leases
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Lease represents a reservation of resources for a period of time.
+type Lease struct {
+	// ID is the unique ID of the lease.
+	ID string `json:"id"`
+
+	// Name is the name of the lease.
+	Name string `json:"name"`
+
+	// StartDate is the date the lease starts.
+	StartDate time.Time `json:"-"`
+
+	// EndDate is the date the lease ends.
+	EndDate time.Time `json:"-"`
+
+	// Status is the status of the lease, e.g. "PENDING" or "ACTIVE".
+	Status string `json:"status"`
+
+	// Degraded indicates that some reserved resources are unavailable.
+	Degraded bool `json:"degraded"`
+
+	// UserID is the ID of the user owning the lease.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project owning the lease.
+	ProjectID string `json:"project_id"`
+
+	// TrustID is the ID of the trust used by the lease.
+	TrustID string `json:"trust_id"`
+
+	// Reservations are the reservations of the lease.
+	Reservations []Reservation `json:"reservations"`
+
+	// Events are the events of the lease.
+	Events []Event `json:"events"`
+
+	// CreatedAt is the date the lease was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the lease was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+func (r *Lease) UnmarshalJSON(b []byte) error {
+	type tmp Lease
+	var s struct {
+		tmp
+		StartDate gophercloud.JSONRFC3339MilliNoZ `json:"start_date"`
+		EndDate   gophercloud.JSONRFC3339MilliNoZ `json:"end_date"`
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Lease(s.tmp)
+
+	r.StartDate = time.Time(s.StartDate)
+	r.EndDate = time.Time(s.EndDate)
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// Reservation represents a resource request of a lease.
+type Reservation struct {
+	// ID is the unique ID of the reservation.
+	ID string `json:"id"`
+
+	// LeaseID is the ID of the lease the reservation belongs to.
+	LeaseID string `json:"lease_id"`
+
+	// ResourceID is the ID of the reserved resource pool.
+	ResourceID string `json:"resource_id"`
+
+	// ResourceType is the type of the reserved resource.
+	ResourceType string `json:"resource_type"`
+
+	// Status is the status of the reservation.
+	Status string `json:"status"`
+
+	// MissingResources indicates that some resources could not be
+	// allocated.
+	MissingResources bool `json:"missing_resources"`
+
+	// ResourcesChanged indicates that some allocated resources changed.
+	ResourcesChanged bool `json:"resources_changed"`
+
+	// Min is the minimum number of reserved hosts.
+	Min int `json:"min"`
+
+	// Max is the maximum number of reserved hosts.
+	Max int `json:"max"`
+
+	// HypervisorProperties is the filter on hypervisor properties.
+	HypervisorProperties string `json:"hypervisor_properties"`
+
+	// ResourceProperties is the filter on extra capabilities.
+	ResourceProperties string `json:"resource_properties"`
+
+	// BeforeEnd is the action taken before the end of the reservation.
+	BeforeEnd string `json:"before_end"`
+
+	// Amount is the number of reserved instances.
+	Amount int `json:"amount"`
+
+	// VCPUs is the number of VCPUs of each reserved instance.
+	VCPUs int `json:"vcpus"`
+
+	// MemoryMB is the amount of memory of each reserved instance.
+	MemoryMB int `json:"memory_mb"`
+
+	// DiskGB is the size of the root disk of each reserved instance.
+	DiskGB int `json:"disk_gb"`
+
+	// Affinity is the placement policy of the reserved instances.
+	Affinity *bool `json:"affinity"`
+
+	// FlavorID is the ID of the flavor created for reserved instances.
+	FlavorID string `json:"flavor_id"`
+
+	// AggregateID is the ID of the aggregate holding the reserved
+	// resources.
+	AggregateID int `json:"aggregate_id"`
+
+	// ServerGroupID is the ID of the server group of reserved instances.
+	ServerGroupID string `json:"server_group_id"`
+}
+
+// Event represents an event of a lease.
+type Event struct {
+	// ID is the unique ID of the event.
+	ID string `json:"id"`
+
+	// LeaseID is the ID of the lease the event belongs to.
+	LeaseID string `json:"lease_id"`
+
+	// EventType is the type of the event, e.g. "start_lease".
+	EventType string `json:"event_type"`
+
+	// Time is the date the event is triggered.
+	Time time.Time `json:"-"`
+
+	// Status is the status of the event.
+	Status string `json:"status"`
+}
+
+func (r *Event) UnmarshalJSON(b []byte) error {
+	type tmp Event
+	var s struct {
+		tmp
+		Time gophercloud.JSONRFC3339MilliNoZ `json:"time"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Event(s.tmp)
+
+	r.Time = time.Time(s.Time)
+
+	return nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Lease.
+func (r commonResult) Extract() (*Lease, error) {
+	var s struct {
+		Lease *Lease `json:"lease"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Lease, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Lease.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Lease.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Lease.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// LeasePage is a single page of Lease results.
+type LeasePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a LeasePage contains any results.
+func (r LeasePage) IsEmpty() (bool, error) {
+	leases, err := ExtractLeases(r)
+	return len(leases) == 0, err
+}
+
+// ExtractLeases returns a slice of Leases contained in a single page of
+// results.
+func ExtractLeases(r pagination.Page) ([]Lease, error) {
+	var s struct {
+		Leases []Lease `json:"leases"`
+	}
+	err := (r.(LeasePage)).ExtractInto(&s)
+	return s.Leases, err
+}
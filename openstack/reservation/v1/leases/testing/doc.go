@@ -0,0 +1,2 @@
+// reservation_leases_v1
+package testing
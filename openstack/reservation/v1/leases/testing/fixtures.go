@@ -0,0 +1,196 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/reservation/v1/leases"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const LeaseID = "6ee55c78-ac52-41a6-99af-2d2d73bcc466"
+
+// LeaseBody is the JSON representation of a single lease.
+const LeaseBody = `
+{
+  "id": "6ee55c78-ac52-41a6-99af-2d2d73bcc466",
+  "name": "lease_foo",
+  "start_date": "2017-12-26T12:00:00.000000",
+  "end_date": "2017-12-27T12:00:00.000000",
+  "status": "PENDING",
+  "degraded": false,
+  "user_id": "5434f637520d4c17bbf254af034b0320",
+  "project_id": "aa45f56901ef45ee95e3d211097c0ea3",
+  "trust_id": "b442a580b9504ababf305bf2b4c49512",
+  "created_at": "2017-12-27T10:00:00.000000",
+  "updated_at": null,
+  "reservations": [
+    {
+      "id": "087bc740-6d2d-410b-9d47-c7b2b55a9d36",
+      "lease_id": "6ee55c78-ac52-41a6-99af-2d2d73bcc466",
+      "resource_id": "5e6c0e6e-f1e6-490b-baaf-50deacbbe371",
+      "resource_type": "physical:host",
+      "status": "pending",
+      "missing_resources": false,
+      "resources_changed": false,
+      "min": 1,
+      "max": 2,
+      "hypervisor_properties": "[\">=\", \"$vcpus\", \"2\"]",
+      "resource_properties": "",
+      "before_end": "default"
+    }
+  ],
+  "events": [
+    {
+      "id": "188a8584-f832-4df9-9a4a-51e6364420ff",
+      "lease_id": "6ee55c78-ac52-41a6-99af-2d2d73bcc466",
+      "event_type": "start_lease",
+      "time": "2017-12-26T12:00:00.000000",
+      "status": "UNDONE"
+    }
+  ]
+}
+`
+
+// GetResponse is a sample response to a Get call.
+var GetResponse = fmt.Sprintf(`{"lease": %s}`, LeaseBody)
+
+// ListResponse is a sample response to a List call.
+var ListResponse = fmt.Sprintf(`{"leases": [%s]}`, LeaseBody)
+
+// CreateRequest is a sample request to create a lease.
+const CreateRequest = `
+{
+  "name": "lease_foo",
+  "start_date": "2017-12-26 12:00",
+  "end_date": "2017-12-27 12:00",
+  "reservations": [
+    {
+      "resource_type": "physical:host",
+      "min": 1,
+      "max": 2,
+      "hypervisor_properties": "[\">=\", \"$vcpus\", \"2\"]",
+      "resource_properties": "",
+      "before_end": "default"
+    }
+  ],
+  "events": []
+}
+`
+
+// UpdateRequest is a sample request to update a lease.
+const UpdateRequest = `
+{
+  "name": "lease_foo",
+  "prolong_for": "1d",
+  "reservations": [
+    {
+      "id": "087bc740-6d2d-410b-9d47-c7b2b55a9d36",
+      "max": 3
+    }
+  ]
+}
+`
+
+// FirstLease is the lease described by LeaseBody.
+var FirstLease = leases.Lease{
+	ID:        LeaseID,
+	Name:      "lease_foo",
+	StartDate: time.Date(2017, 12, 26, 12, 0, 0, 0, time.UTC),
+	EndDate:   time.Date(2017, 12, 27, 12, 0, 0, 0, time.UTC),
+	Status:    "PENDING",
+	UserID:    "5434f637520d4c17bbf254af034b0320",
+	ProjectID: "aa45f56901ef45ee95e3d211097c0ea3",
+	TrustID:   "b442a580b9504ababf305bf2b4c49512",
+	CreatedAt: time.Date(2017, 12, 27, 10, 0, 0, 0, time.UTC),
+	Reservations: []leases.Reservation{
+		{
+			ID:                   "087bc740-6d2d-410b-9d47-c7b2b55a9d36",
+			LeaseID:              LeaseID,
+			ResourceID:           "5e6c0e6e-f1e6-490b-baaf-50deacbbe371",
+			ResourceType:         "physical:host",
+			Status:               "pending",
+			Min:                  1,
+			Max:                  2,
+			HypervisorProperties: `[">=", "$vcpus", "2"]`,
+			BeforeEnd:            "default",
+		},
+	},
+	Events: []leases.Event{
+		{
+			ID:        "188a8584-f832-4df9-9a4a-51e6364420ff",
+			LeaseID:   LeaseID,
+			EventType: "start_lease",
+			Time:      time.Date(2017, 12, 26, 12, 0, 0, 0, time.UTC),
+			Status:    "UNDONE",
+		},
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/leases", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/leases/"+LeaseID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a
+// Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/leases", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/leases/"+LeaseID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/leases/"+LeaseID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
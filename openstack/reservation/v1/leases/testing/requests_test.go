@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/reservation/v1/leases"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListLeases(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := leases.List(fake.ServiceClient()).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := leases.ExtractLeases(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []leases.Lease{FirstLease}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGetLease(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := leases.Get(fake.ServiceClient(), LeaseID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstLease, actual)
+}
+
+func TestCreateLease(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := leases.CreateOpts{
+		Name:      "lease_foo",
+		StartDate: "2017-12-26 12:00",
+		EndDate:   "2017-12-27 12:00",
+		Reservations: []leases.ReservationOpts{
+			{
+				ResourceType:         leases.ResourceTypeHost,
+				Min:                  1,
+				Max:                  2,
+				HypervisorProperties: `[">=", "$vcpus", "2"]`,
+				BeforeEnd:            "default",
+			},
+		},
+	}
+
+	actual, err := leases.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstLease, actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	createOpts := leases.CreateOpts{
+		Name:      "lease_foo",
+		StartDate: "now",
+		EndDate:   "2017-12-27 12:00",
+	}
+	_, err := createOpts.ToLeaseCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdateLease(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := leases.UpdateOpts{
+		Name:       "lease_foo",
+		ProlongFor: "1d",
+		Reservations: []leases.UpdateReservationOpts{
+			{
+				ID:  "087bc740-6d2d-410b-9d47-c7b2b55a9d36",
+				Max: 3,
+			},
+		},
+	}
+
+	actual, err := leases.Update(fake.ServiceClient(), LeaseID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstLease, actual)
+}
+
+func TestDeleteLease(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	res := leases.Delete(fake.ServiceClient(), LeaseID)
+	th.AssertNoErr(t, res.Err)
+}
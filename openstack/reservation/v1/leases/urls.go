@@ -0,0 +1,33 @@
+package leases
+
+import "github.com/gophercloud/gophercloud"
+
+const leasesPath = "leases"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(leasesPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, leaseID string) string {
+	return c.ServiceURL(leasesPath, leaseID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, leaseID string) string {
+	return resourceURL(c, leaseID)
+}
+
+func updateURL(c *gophercloud.ServiceClient, leaseID string) string {
+	return resourceURL(c, leaseID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, leaseID string) string {
+	return resourceURL(c, leaseID)
+}
@@ -192,11 +192,11 @@ type GrantAccessOptsBuilder interface {
 // Share Actions, Grant Access documentation
 type GrantAccessOpts struct {
 	// The access rule type that can be "ip", "cert" or "user".
-	AccessType string `json:"access_type"`
+	AccessType string `json:"access_type" required:"true"`
 	// The value that defines the access that can be a valid format of IP, cert or user.
-	AccessTo string `json:"access_to"`
-	// The access level to the share is either "rw" or "ro".
-	AccessLevel string `json:"access_level"`
+	AccessTo string `json:"access_to" required:"true"`
+	// The access level to the share is either "rw" or "ro". Defaults to "rw".
+	AccessLevel string `json:"access_level,omitempty"`
 }
 
 // ToGrantAccessMap assembles a request body based on the contents of a
@@ -230,7 +230,7 @@ type RevokeAccessOptsBuilder interface {
 // For more information about these parameters, please, refer to the shared file systems API v2,
 // Share Actions, Revoke Access documentation
 type RevokeAccessOpts struct {
-	AccessID string `json:"access_id"`
+	AccessID string `json:"access_id" required:"true"`
 }
 
 // ToRevokeAccessMap assembles a request body based on the contents of a
@@ -342,6 +342,43 @@ func Shrink(client *gophercloud.ServiceClient, id string, opts ShrinkOptsBuilder
 	return
 }
 
+// RevertOptsBuilder allows extensions to add additional parameters to the
+// Revert request.
+type RevertOptsBuilder interface {
+	ToShareRevertMap() (map[string]interface{}, error)
+}
+
+// RevertOpts contains options for reverting a Share to a snapshot.
+// For more information about these parameters, please, refer to the shared file systems API v2,
+// Share Actions, Revert share documentation
+type RevertOpts struct {
+	// SnapshotID is the UUID of the most recent snapshot of the share.
+	SnapshotID string `json:"snapshot_id" required:"true"`
+}
+
+// ToShareRevertMap assembles a request body based on the contents of a
+// RevertOpts.
+func (opts RevertOpts) ToShareRevertMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "revert")
+}
+
+// Revert will revert an existing share to its most recent snapshot. RevertResult contains only
+// the error. To extract it, call the ExtractErr method on the RevertResult.
+// Client must have Microversion set; minimum supported microversion for Revert is 2.27.
+func Revert(client *gophercloud.ServiceClient, id string, opts RevertOptsBuilder) (r RevertResult) {
+	b, err := opts.ToShareRevertMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(revertURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -325,6 +325,11 @@ type ShrinkResult struct {
 	gophercloud.ErrResult
 }
 
+// RevertResult contains the response body and error from a Revert request.
+type RevertResult struct {
+	gophercloud.ErrResult
+}
+
 // GetMetadatumResult contains the response body and error from a GetMetadatum request.
 type GetMetadatumResult struct {
 	gophercloud.Result
@@ -419,6 +419,25 @@ func MockShrinkResponse(t *testing.T) {
 	})
 }
 
+var revertRequest = `{
+		"revert": {
+			"snapshot_id": "6a2c3b67-b5f9-4a4c-a81e-0ddeaf7e4a13"
+		}
+	}`
+
+// MockRevertResponse creates a mock revert share response
+func MockRevertResponse(t *testing.T) {
+	th.Mux.HandleFunc(shareEndpoint+"/"+shareID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, revertRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
 var getMetadataResponse = `{
 		"metadata": {
 			"foo": "bar"
@@ -214,6 +214,27 @@ func TestGrantAcessSuccess(t *testing.T) {
 	})
 }
 
+func TestGrantAccessRequiredFields(t *testing.T) {
+	_, err := shares.GrantAccessOpts{AccessTo: "0.0.0.0/0"}.ToGrantAccessMap()
+	if err == nil {
+		t.Fatal("expected error when AccessType is not set")
+	}
+
+	_, err = shares.GrantAccessOpts{AccessType: "user"}.ToGrantAccessMap()
+	if err == nil {
+		t.Fatal("expected error when AccessTo is not set")
+	}
+
+	b, err := shares.GrantAccessOpts{AccessType: "cert", AccessTo: "client.example.com"}.ToGrantAccessMap()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]interface{}{
+		"allow_access": map[string]interface{}{
+			"access_type": "cert",
+			"access_to":   "client.example.com",
+		},
+	}, b)
+}
+
 func TestRevokeAccessSuccess(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -347,3 +368,17 @@ func TestUnsetMetadataSuccess(t *testing.T) {
 	err := shares.DeleteMetadatum(c, shareID, "foo").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestRevertSuccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockRevertResponse(t)
+
+	c := client.ServiceClient()
+	// Client c must have Microversion set; minimum supported microversion for Revert is 2.27
+	c.Microversion = "2.27"
+
+	err := shares.Revert(c, shareID, shares.RevertOpts{SnapshotID: "6a2c3b67-b5f9-4a4c-a81e-0ddeaf7e4a13"}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -93,6 +93,12 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 	})
 }
 
+// Get will retrieve a single ShareType with the given ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
 // GetDefault will retrieve the default ShareType.
 func GetDefault(client *gophercloud.ServiceClient) (r GetDefaultResult) {
 	_, r.Err = client.Get(getDefaultURL(client), &r.Body, nil)
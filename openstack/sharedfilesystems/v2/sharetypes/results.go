@@ -98,6 +98,11 @@ func ExtractShareTypes(r pagination.Page) ([]ShareType, error) {
 	return s.ShareTypes, err
 }
 
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
 // GetDefaultResult contains the response body and error from a Get Default request.
 type GetDefaultResult struct {
 	commonResult
@@ -101,6 +101,25 @@ func TestList(t *testing.T) {
 	th.CheckDeepEquals(t, expected, actual)
 }
 
+// Verifies that it is possible to get a share type
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetResponse(t)
+
+	expected := sharetypes.ShareType{
+		ID:                 "be27425c-f807-4500-a056-d00721db45cf",
+		Name:               "my_new_share_type",
+		ExtraSpecs:         map[string]interface{}{"snapshot_support": "True", "driver_handles_share_servers": "True"},
+		RequiredExtraSpecs: map[string]interface{}{"driver_handles_share_servers": "True"},
+	}
+
+	actual, err := sharetypes.Get(client.ServiceClient(), "be27425c-f807-4500-a056-d00721db45cf").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
 // Verifies that it is possible to get the default share type
 func TestGetDefault(t *testing.T) {
 	th.SetupHTTP()
@@ -14,6 +14,10 @@ func listURL(c *gophercloud.ServiceClient) string {
 	return createURL(c)
 }
 
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("types", id)
+}
+
 func getDefaultURL(c *gophercloud.ServiceClient) string {
 	return c.ServiceURL("types", "default")
 }
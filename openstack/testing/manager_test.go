@@ -0,0 +1,98 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func handleManagerAuth(t *testing.T, count *int) {
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "User-Agent", "dr-tool "+gophercloud.DefaultUserAgent)
+		*count++
+
+		w.Header().Add("X-Subject-Token", ID)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `
+			{
+				"token": {
+					"expires_at": "2013-02-02T18:30:59.000000Z",
+					"catalog": [
+						{
+							"type": "compute",
+							"name": "nova",
+							"endpoints": [
+								{"interface": "public", "region": "RegionOne", "url": "https://compute.one.example.com/v2.1/"},
+								{"interface": "internal", "region": "RegionOne", "url": "https://compute.one.internal/v2.1/"},
+								{"interface": "public", "region": "RegionTwo", "url": "https://compute.two.example.com/v2.1/"}
+							]
+						}
+					]
+				}
+			}
+		`)
+	})
+}
+
+func TestManager(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	count := 0
+	handleManagerAuth(t, &count)
+
+	authOptions := gophercloud.AuthOptions{
+		Username:         "me",
+		Password:         "secret",
+		DomainName:       "default",
+		TenantName:       "project",
+		IdentityEndpoint: th.Endpoint() + "v3/",
+	}
+
+	manager := openstack.NewManager(map[string]openstack.Cloud{
+		"primary": {
+			AuthOptions: authOptions,
+			Region:      "RegionOne",
+		},
+	})
+	manager.UserAgent = "dr-tool"
+	manager.AddCloud("secondary", openstack.Cloud{
+		AuthOptions:  authOptions,
+		Region:       "RegionOne",
+		Availability: gophercloud.AvailabilityInternal,
+	})
+	th.AssertDeepEquals(t, []string{"primary", "secondary"}, manager.Clouds())
+
+	// No cloud is authenticated until a client is requested.
+	th.CheckEquals(t, 0, count)
+
+	client, err := manager.ServiceClient("primary", "", openstack.NewComputeV2)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://compute.one.example.com/v2.1/", client.Endpoint)
+	th.CheckEquals(t, ID, client.ProviderClient.TokenID)
+
+	client, err = manager.ServiceClient("primary", "RegionTwo", openstack.NewComputeV2)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://compute.two.example.com/v2.1/", client.Endpoint)
+	th.CheckEquals(t, 1, count)
+
+	client, err = manager.ServiceClient("secondary", "", openstack.NewComputeV2)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://compute.one.internal/v2.1/", client.Endpoint)
+	th.CheckEquals(t, 2, count)
+
+	_, err = manager.ServiceClient("primary", "RegionThree", openstack.NewComputeV2)
+	if _, ok := err.(*gophercloud.ErrEndpointNotFound); !ok {
+		t.Fatalf("Expected an ErrEndpointNotFound, got %v", err)
+	}
+
+	_, err = manager.ProviderClient("unknown")
+	if _, ok := err.(gophercloud.ErrResourceNotFound); !ok {
+		t.Fatalf("Expected an ErrResourceNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,221 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ServiceVersion is a major API version listed in the versions document of a
+// service.
+type ServiceVersion struct {
+	// ID is the ID of the version, e.g. "v2.1".
+	ID string
+
+	// Status is the status of the version, e.g. "CURRENT" or "DEPRECATED".
+	Status string
+
+	// Version is the highest microversion supported by the version, if the
+	// service uses microversions.
+	Version string
+
+	// MinVersion is the lowest microversion supported by the version, if the
+	// service uses microversions.
+	MinVersion string
+
+	// Endpoint is the URL of the version, ending with a /. It is empty if the
+	// document does not link to the version.
+	Endpoint string
+}
+
+// IsDeprecated reports whether the service marks the version as deprecated.
+func (v ServiceVersion) IsDeprecated() bool {
+	return strings.EqualFold(v.Status, "DEPRECATED")
+}
+
+type versionLink struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+type versionValue struct {
+	ID         string        `json:"id"`
+	Status     string        `json:"status"`
+	Version    string        `json:"version"`
+	MinVersion string        `json:"min_version"`
+	Links      []versionLink `json:"links"`
+}
+
+// versionValues accepts both a list of versions and the Identity service
+// style of an object with a "values" list.
+type versionValues []versionValue
+
+func (v *versionValues) UnmarshalJSON(b []byte) error {
+	var list []versionValue
+	if err := json.Unmarshal(b, &list); err == nil {
+		*v = list
+		return nil
+	}
+
+	var wrapped struct {
+		Values []versionValue `json:"values"`
+	}
+	if err := json.Unmarshal(b, &wrapped); err != nil {
+		return err
+	}
+	*v = wrapped.Values
+	return nil
+}
+
+// GetServiceVersions fetches the versions document of a service. endpoint
+// may be the unversioned root of the service or a versioned endpoint from the
+// service catalog, e.g. "https://compute.example.com/v2.1/": the version is
+// stripped from the URL before the document is requested.
+func GetServiceVersions(client *gophercloud.ProviderClient, endpoint string) ([]ServiceVersion, error) {
+	base, err := BaseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	baseURL, err := url.Parse(normalizeEndpoint(base))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Versions versionValues `json:"versions"`
+		Version  *versionValue `json:"version"`
+	}
+	_, err = client.Request("GET", baseURL.String(), &gophercloud.RequestOpts{
+		JSONResponse: &resp,
+		OkCodes:      []int{200, 300},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values := resp.Versions
+	if len(values) == 0 && resp.Version != nil {
+		values = versionValues{*resp.Version}
+	}
+
+	versions := make([]ServiceVersion, 0, len(values))
+	for _, value := range values {
+		v := ServiceVersion{
+			ID:         value.ID,
+			Status:     value.Status,
+			Version:    value.Version,
+			MinVersion: value.MinVersion,
+		}
+		for _, link := range value.Links {
+			if link.Rel != "self" || link.Href == "" {
+				continue
+			}
+			href, err := url.Parse(link.Href)
+			if err != nil {
+				return nil, err
+			}
+			v.Endpoint = normalizeEndpoint(baseURL.ResolveReference(href).String())
+		}
+		versions = append(versions, v)
+	}
+
+	return versions, nil
+}
+
+// NegotiateVersion chooses the highest-Priority Version among the recognized
+// ones which the service at endpoint supports, and returns it along with its
+// endpoint URL. A Version is matched if the ID of a listed version starts with
+// the ID of the Version, so "v2" matches "v2.0" and "v2.1". Deprecated and
+// experimental versions are skipped.
+//
+// endpoint may be versioned or unversioned. If it is the endpoint of one of
+// the recognized versions, that version is chosen, so that versioned catalog
+// entries are respected:
+//
+//	v2 := &utils.Version{ID: "v2", Priority: 20}
+//	v1 := &utils.Version{ID: "v1", Priority: 10}
+//
+//	version, endpoint, err := utils.NegotiateVersion(provider, catalogURL, []*utils.Version{v1, v2})
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	client := &gophercloud.ServiceClient{
+//		ProviderClient: provider,
+//		Endpoint:       endpoint,
+//	}
+func NegotiateVersion(client *gophercloud.ProviderClient, endpoint string, recognized []*Version) (*Version, string, error) {
+	versions, err := GetServiceVersions(client, endpoint)
+	if err != nil {
+		return nil, "", err
+	}
+
+	endpoint = normalizeEndpoint(endpoint)
+
+	var highest *Version
+	var highestID, highestEndpoint string
+	for _, value := range versions {
+		for _, version := range recognized {
+			if !strings.HasPrefix(value.ID, version.ID) {
+				continue
+			}
+
+			// Prefer the version the given endpoint points at.
+			if value.Endpoint != "" && strings.HasPrefix(endpoint, value.Endpoint) {
+				if value.IsDeprecated() {
+					reportDeprecatedVersion(client, endpoint, value)
+				}
+				return version, endpoint, nil
+			}
+
+			if !goodStatus[strings.ToLower(value.Status)] {
+				continue
+			}
+			if highest == nil || version.Priority > highest.Priority {
+				highest = version
+				highestID = value.ID
+				highestEndpoint = value.Endpoint
+			}
+		}
+	}
+
+	if highest == nil {
+		return nil, "", fmt.Errorf("No supported version available from endpoint %s", endpoint)
+	}
+	if highestEndpoint == "" {
+		base, err := BaseEndpoint(endpoint)
+		if err != nil {
+			return nil, "", err
+		}
+		highestEndpoint = normalizeEndpoint(base) + highestID + "/"
+	}
+
+	return highest, highestEndpoint, nil
+}
+
+// reportDeprecatedVersion tells the DeprecationHandler and Logger of client
+// that a deprecated version was chosen.
+func reportDeprecatedVersion(client *gophercloud.ProviderClient, endpoint string, v ServiceVersion) {
+	msg := fmt.Sprintf("API version %s is deprecated", v.ID)
+	if client.Logger != nil {
+		client.Logger.Log(gophercloud.LogWarn, msg, "url", endpoint)
+	}
+	if client.DeprecationHandler != nil {
+		client.DeprecationHandler(gophercloud.DeprecationNotice{
+			Method:     "GET",
+			URL:        endpoint,
+			Warnings:   []string{msg},
+			Deprecated: true,
+		})
+	}
+}
+
+func normalizeEndpoint(endpoint string) string {
+	if !strings.HasSuffix(endpoint, "/") {
+		return endpoint + "/"
+	}
+	return endpoint
+}
@@ -0,0 +1,126 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func setupComputeVersionsHandler() {
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultipleChoices)
+		fmt.Fprintf(w, `
+			{
+				"versions": [
+					{
+						"id": "v2.0",
+						"status": "DEPRECATED",
+						"version": "",
+						"min_version": "",
+						"links": [{"href": "%s/v2/", "rel": "self"}]
+					},
+					{
+						"id": "v2.1",
+						"status": "CURRENT",
+						"version": "2.79",
+						"min_version": "2.1",
+						"links": [{"href": "%s/v2.1/", "rel": "self"}]
+					},
+					{
+						"id": "v3.0",
+						"status": "EXPERIMENTAL",
+						"links": [{"href": "/v3/", "rel": "self"}]
+					}
+				]
+			}
+		`, th.Server.URL, th.Server.URL)
+	})
+}
+
+func TestGetServiceVersions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupComputeVersionsHandler()
+
+	client := &gophercloud.ProviderClient{}
+	actual, err := utils.GetServiceVersions(client, th.Endpoint()+"v2.1/")
+	th.AssertNoErr(t, err)
+
+	expected := []utils.ServiceVersion{
+		{ID: "v2.0", Status: "DEPRECATED", Endpoint: th.Endpoint() + "v2/"},
+		{ID: "v2.1", Status: "CURRENT", Version: "2.79", MinVersion: "2.1", Endpoint: th.Endpoint() + "v2.1/"},
+		{ID: "v3.0", Status: "EXPERIMENTAL", Endpoint: th.Endpoint() + "v3/"},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestGetServiceVersionsIdentityStyle(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupVersionHandler()
+
+	client := &gophercloud.ProviderClient{}
+	actual, err := utils.GetServiceVersions(client, th.Endpoint())
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(actual))
+	th.CheckEquals(t, "v3.0", actual[0].ID)
+	th.CheckEquals(t, th.Endpoint()+"v3.0/", actual[0].Endpoint)
+}
+
+func TestNegotiateVersion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupComputeVersionsHandler()
+
+	v2 := &utils.Version{ID: "v2", Priority: 2}
+	v3 := &utils.Version{ID: "v3", Priority: 3}
+	client := &gophercloud.ProviderClient{}
+
+	// The experimental v3.0 is skipped.
+	v, endpoint, err := utils.NegotiateVersion(client, th.Endpoint(), []*utils.Version{v2, v3})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, v2, v)
+	th.CheckEquals(t, th.Endpoint()+"v2.1/", endpoint)
+
+	// A versioned endpoint is kept, even if it is deprecated.
+	var notices []gophercloud.DeprecationNotice
+	client.DeprecationHandler = func(n gophercloud.DeprecationNotice) {
+		notices = append(notices, n)
+	}
+
+	v, endpoint, err = utils.NegotiateVersion(client, th.Endpoint()+"v2/", []*utils.Version{v2, v3})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, v2, v)
+	th.CheckEquals(t, th.Endpoint()+"v2/", endpoint)
+	th.AssertEquals(t, 1, len(notices))
+	th.CheckDeepEquals(t, []string{"API version v2.0 is deprecated"}, notices[0].Warnings)
+
+	_, _, err = utils.NegotiateVersion(client, th.Endpoint(), []*utils.Version{{ID: "v1", Priority: 1}})
+	if err == nil {
+		t.Errorf("Expected an error for unsupported versions")
+	}
+}
+
+func TestNegotiateVersionSingle(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": {"id": "v1", "status": "CURRENT", "version": "1.87", "min_version": "1.1", "links": []}}`)
+	})
+
+	client := &gophercloud.ProviderClient{}
+	v1 := &utils.Version{ID: "v1", Priority: 1}
+
+	v, endpoint, err := utils.NegotiateVersion(client, th.Endpoint(), []*utils.Version{v1})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, v1, v)
+	th.CheckEquals(t, th.Endpoint()+"v1/", endpoint)
+}
@@ -0,0 +1,74 @@
+/*
+Package actionexecutions provides interaction with the action executions API in the OpenStack Mistral service.
+
+An action execution is the run of a single Mistral action. Actions are usually run as part of a workflow task,
+but they can also be run directly, e.g. to call "std.echo" or "std.http" without defining a workflow.
+
+List action executions
+
+	listOpts := actionexecutions.ListOpts{
+		WorkflowName:  "my_workflow",
+		IncludeOutput: true,
+	}
+
+	allPages, err := actionexecutions.List(mistralClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allActionExecutions, err := actionexecutions.ExtractActionExecutions(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, ex := range allActionExecutions {
+		fmt.Printf("%+v\n", ex)
+	}
+
+Run an action
+
+	createOpts := &actionexecutions.CreateOpts{
+		Name: "std.echo",
+		Input: map[string]interface{}{
+			"output": "Hello",
+		},
+		Params: map[string]interface{}{
+			"save_result": true,
+		},
+	}
+
+	actionExecution, err := actionexecutions.Create(mistralClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Get an action execution
+
+	actionExecution, err := actionexecutions.Get(mistralClient, "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3").Extract()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%+v\n", actionExecution)
+
+Update an action execution
+
+	updateOpts := &actionexecutions.UpdateOpts{
+		State: "SUCCESS",
+		Output: map[string]interface{}{
+			"result": "done",
+		},
+	}
+
+	actionExecution, err := actionexecutions.Update(mistralClient, "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Delete an action execution
+
+	res := actionexecutions.Delete(mistralClient, "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3")
+	if res.Err != nil {
+		panic(res.Err)
+	}
+*/
+package actionexecutions
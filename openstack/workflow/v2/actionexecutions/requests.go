@@ -0,0 +1,161 @@
+package actionexecutions
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// CreateOptsBuilder allows extension to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToActionExecutionCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters used to run an action.
+type CreateOpts struct {
+	// Name is the name of the action to run, e.g. "std.echo".
+	Name string `json:"name" required:"true"`
+
+	// Input contains the action input values.
+	Input map[string]interface{} `json:"input,omitempty"`
+
+	// Params define action specific parameters, e.g. "run_sync" or "save_result".
+	Params map[string]interface{} `json:"params,omitempty"`
+
+	// Description is the description of the action execution.
+	Description string `json:"description,omitempty"`
+}
+
+// ToActionExecutionCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToActionExecutionCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create runs the given action and returns the resulting action execution.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToActionExecutionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(createURL(client), b, &r.Body, nil)
+
+	return
+}
+
+// Get retrieves details of a single action execution.
+// Use Extract to convert its result into an ActionExecution.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extension to add additional parameters to the Update request.
+type UpdateOptsBuilder interface {
+	ToActionExecutionUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters used to update an action execution.
+type UpdateOpts struct {
+	// State is the new state of the action execution.
+	// It can be one of RUNNING, SUCCESS, ERROR, CANCELLED.
+	State string `json:"state,omitempty"`
+
+	// Output contains the action output values.
+	Output map[string]interface{} `json:"output,omitempty"`
+}
+
+// ToActionExecutionUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToActionExecutionUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update updates the state or the output of the specified action execution.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToActionExecutionUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return
+}
+
+// Delete deletes the specified action execution.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
+
+// ListOptsBuilder allows extension to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToActionExecutionListQuery() (string, error)
+}
+
+// ListOpts filters the result returned by the List() function.
+type ListOpts struct {
+	// Name allows to filter by action name.
+	Name string `q:"name"`
+	// WorkflowName allows to filter by workflow name.
+	WorkflowName string `q:"workflow_name"`
+	// TaskName allows to filter by task name.
+	TaskName string `q:"task_name"`
+	// TaskExecutionID allows to filter with a specific task execution id.
+	TaskExecutionID string `q:"task_execution_id"`
+	// State allows to filter by action execution state.
+	// Possible values are IDLE, RUNNING, SUCCESS, ERROR, CANCELLED.
+	State string `q:"state"`
+	// IncludeOutput requests to include the output for all action executions in the list.
+	IncludeOutput bool
+	// SortDir allows to select sort direction.
+	// It can be "asc" or "desc" (default).
+	SortDirs string `q:"sort_dirs"`
+	// SortKey allows to sort by one of the action execution attributes.
+	SortKeys string `q:"sort_keys"`
+	// Marker and Limit control paging.
+	// Marker instructs List where to start listing from.
+	Marker string `q:"marker"`
+	// Limit instructs List to refrain from sending excessively large lists of
+	// action executions.
+	Limit int `q:"limit"`
+}
+
+// ToActionExecutionListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToActionExecutionListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+
+	if opts.IncludeOutput {
+		params.Add("include_output", "1")
+	}
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// List performs a call to list action executions.
+// You may provide options to filter the action executions.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToActionExecutionListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ActionExecutionPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
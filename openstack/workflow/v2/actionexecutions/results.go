@@ -0,0 +1,180 @@
+package actionexecutions
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// CreateResult is the response of a Post operations. Call its Extract method to interpret it as an ActionExecution.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the response of Get operations. Call its Extract method to interpret it as an ActionExecution.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the response of Update operations. Call its Extract method to interpret it as an ActionExecution.
+type UpdateResult struct {
+	commonResult
+}
+
+// Extract helps to get an ActionExecution struct from a Get, a Create or an Update function.
+func (r commonResult) Extract() (*ActionExecution, error) {
+	var s ActionExecution
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// DeleteResult is the result from a Delete operation. Call its ExtractErr method to determine the success of the call.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ActionExecution represents an action execution on OpenStack mistral API.
+type ActionExecution struct {
+	// ID is the action execution's unique ID.
+	ID string `json:"id"`
+
+	// Name is the name of the executed action.
+	Name string `json:"name"`
+
+	// CreatedAt contains the action execution creation date.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the last update of the action execution.
+	UpdatedAt time.Time `json:"-"`
+
+	// WorkflowName is the name of the workflow which ran the action, if any.
+	WorkflowName string `json:"workflow_name"`
+
+	// TaskName is the name of the task which ran the action, if any.
+	TaskName string `json:"task_name"`
+
+	// TaskExecutionID is the task execution ID, if any.
+	TaskExecutionID *string `json:"task_execution_id"`
+
+	// Description is the description of the action execution.
+	Description string `json:"description"`
+
+	// Accepted indicates whether the result of the action was accepted.
+	Accepted bool `json:"accepted"`
+
+	// Tags is a list of tags of the action execution.
+	Tags []string `json:"tags"`
+
+	// Input contains the action input values.
+	Input map[string]interface{} `json:"-"`
+
+	// Ouput contains the action output values.
+	Output map[string]interface{} `json:"-"`
+
+	// Params contains action specific parameters.
+	Params map[string]interface{} `json:"-"`
+
+	// ProjectID is the project id owner of the action execution.
+	ProjectID string `json:"project_id"`
+
+	// State is the current state of the action execution. State can be one of: IDLE, RUNNING, SUCCESS, ERROR, CANCELLED.
+	State string `json:"state"`
+
+	// StateInfo contains an optional state information string.
+	StateInfo *string `json:"state_info"`
+}
+
+// UnmarshalJSON implements unmarshalling custom types
+func (r *ActionExecution) UnmarshalJSON(b []byte) error {
+	type tmp ActionExecution
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"updated_at"`
+		Input     json.RawMessage                `json:"input"`
+		Output    json.RawMessage                `json:"output"`
+		Params    json.RawMessage                `json:"params"`
+	}
+
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = ActionExecution(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	if r.Input, err = unmarshalJSONField(s.Input); err != nil {
+		return err
+	}
+
+	if r.Output, err = unmarshalJSONField(s.Output); err != nil {
+		return err
+	}
+
+	if r.Params, err = unmarshalJSONField(s.Params); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// unmarshalJSONField decodes a field which depending on the Mistral version
+// is either a JSON object or a JSON object serialized as a string.
+func unmarshalJSONField(raw json.RawMessage) (map[string]interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		if str == "" {
+			return nil, nil
+		}
+		raw = json.RawMessage(str)
+	}
+
+	var m map[string]interface{}
+	err := json.Unmarshal(raw, &m)
+	return m, err
+}
+
+// ActionExecutionPage contains a single page of all action executions from a List call.
+type ActionExecutionPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty checks if an ActionExecutionPage contains any results.
+func (r ActionExecutionPage) IsEmpty() (bool, error) {
+	exec, err := ExtractActionExecutions(r)
+	return len(exec) == 0, err
+}
+
+// NextPageURL finds the next page URL in a page in order to navigate to the next page of results.
+func (r ActionExecutionPage) NextPageURL() (string, error) {
+	var s struct {
+		Next string `json:"next"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Next, nil
+}
+
+// ExtractActionExecutions get the list of action executions from a page acquired from the List call.
+func ExtractActionExecutions(r pagination.Page) ([]ActionExecution, error) {
+	var s struct {
+		ActionExecutions []ActionExecution `json:"action_executions"`
+	}
+	err := (r.(ActionExecutionPage)).ExtractInto(&s)
+	return s.ActionExecutions, err
+}
@@ -0,0 +1,278 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/workflow/v2/actionexecutions"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const actionExecutionBody = `
+{
+	"accepted": true,
+	"created_at": "2018-09-12 14:48:49",
+	"description": "description",
+	"id": "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3",
+	"input": "{\"output\": \"Hello\"}",
+	"name": "std.echo",
+	"output": "{\"result\": \"Hello\"}",
+	"params": "{\"save_result\": true}",
+	"project_id": "778c0f25df0d492a9a868ee9e2fbb513",
+	"state": "SUCCESS",
+	"state_info": null,
+	"tags": null,
+	"task_execution_id": null,
+	"task_name": null,
+	"updated_at": "2018-09-12 14:48:49",
+	"workflow_name": null
+}
+`
+
+var expectedActionExecution = &actionexecutions.ActionExecution{
+	ID:          "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3",
+	Name:        "std.echo",
+	Description: "description",
+	Accepted:    true,
+	Input: map[string]interface{}{
+		"output": "Hello",
+	},
+	Output: map[string]interface{}{
+		"result": "Hello",
+	},
+	Params: map[string]interface{}{
+		"save_result": true,
+	},
+	ProjectID: "778c0f25df0d492a9a868ee9e2fbb513",
+	State:     "SUCCESS",
+	CreatedAt: time.Date(2018, time.September, 12, 14, 48, 49, 0, time.UTC),
+	UpdatedAt: time.Date(2018, time.September, 12, 14, 48, 49, 0, time.UTC),
+}
+
+func TestCreateActionExecution(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/action_executions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"name": "std.echo",
+				"input": {"output": "Hello"},
+				"params": {"save_result": true},
+				"description": "description"
+			}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, actionExecutionBody)
+	})
+
+	opts := &actionexecutions.CreateOpts{
+		Name: "std.echo",
+		Input: map[string]interface{}{
+			"output": "Hello",
+		},
+		Params: map[string]interface{}{
+			"save_result": true,
+		},
+		Description: "description",
+	}
+
+	actual, err := actionexecutions.Create(fake.ServiceClient(), opts).Extract()
+	if err != nil {
+		t.Fatalf("Unable to create action execution: %v", err)
+	}
+
+	if !reflect.DeepEqual(expectedActionExecution, actual) {
+		t.Errorf("Expected %#v, but was %#v", expectedActionExecution, actual)
+	}
+}
+
+func TestCreateActionExecutionRequiresName(t *testing.T) {
+	res := actionexecutions.Create(fake.ServiceClient(), &actionexecutions.CreateOpts{})
+	if res.Err == nil {
+		t.Fatal("Expected an error when creating an action execution without name")
+	}
+}
+
+func TestGetActionExecution(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/action_executions/a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, actionExecutionBody)
+	})
+
+	actual, err := actionexecutions.Get(fake.ServiceClient(), "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3").Extract()
+	if err != nil {
+		t.Fatalf("Unable to get action execution: %v", err)
+	}
+
+	if !reflect.DeepEqual(expectedActionExecution, actual) {
+		t.Errorf("Expected %#v, but was %#v", expectedActionExecution, actual)
+	}
+}
+
+func TestGetActionExecutionObjectFields(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/action_executions/a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+			{
+				"accepted": true,
+				"created_at": "2018-09-12 14:48:49",
+				"description": "description",
+				"id": "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3",
+				"input": {"output": "Hello"},
+				"name": "std.echo",
+				"output": {"result": "Hello"},
+				"params": {"save_result": true},
+				"project_id": "778c0f25df0d492a9a868ee9e2fbb513",
+				"state": "SUCCESS",
+				"updated_at": "2018-09-12 14:48:49"
+			}
+		`)
+	})
+
+	actual, err := actionexecutions.Get(fake.ServiceClient(), "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3").Extract()
+	if err != nil {
+		t.Fatalf("Unable to get action execution: %v", err)
+	}
+
+	if !reflect.DeepEqual(expectedActionExecution, actual) {
+		t.Errorf("Expected %#v, but was %#v", expectedActionExecution, actual)
+	}
+}
+
+func TestUpdateActionExecution(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/action_executions/a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"state": "SUCCESS",
+				"output": {"result": "Hello"}
+			}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, actionExecutionBody)
+	})
+
+	opts := &actionexecutions.UpdateOpts{
+		State: "SUCCESS",
+		Output: map[string]interface{}{
+			"result": "Hello",
+		},
+	}
+
+	actual, err := actionexecutions.Update(fake.ServiceClient(), "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3", opts).Extract()
+	if err != nil {
+		t.Fatalf("Unable to update action execution: %v", err)
+	}
+
+	if !reflect.DeepEqual(expectedActionExecution, actual) {
+		t.Errorf("Expected %#v, but was %#v", expectedActionExecution, actual)
+	}
+}
+
+func TestDeleteActionExecution(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/action_executions/a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	res := actionexecutions.Delete(fake.ServiceClient(), "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListActionExecutions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/action_executions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, `{"action_executions": [%s], "next": "%s/action_executions?marker=a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3"}`, actionExecutionBody, th.Server.URL)
+		case "a4b5c7b6-a93d-4ea2-a7e0-c9c0fdbb0ff3":
+			fmt.Fprint(w, `{"action_executions": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+
+	pages := 0
+	err := actionexecutions.List(fake.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := actionexecutions.ExtractActionExecutions(page)
+		if err != nil {
+			return false, err
+		}
+
+		expected := []actionexecutions.ActionExecution{*expectedActionExecution}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected %#v, but was %#v", expected, actual)
+		}
+		return true, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pages != 1 {
+		t.Errorf("Expected one page, got %d", pages)
+	}
+}
+
+func TestToActionExecutionListQuery(t *testing.T) {
+	for expected, opts := range map[string]*actionexecutions.ListOpts{
+		newValue("name", "std.echo"): &actionexecutions.ListOpts{
+			Name: "std.echo",
+		},
+		newValue("include_output", "1"): &actionexecutions.ListOpts{
+			IncludeOutput: true,
+		},
+	} {
+		actual, _ := opts.ToActionExecutionListQuery()
+
+		th.AssertEquals(t, expected, actual)
+	}
+}
+
+func newValue(param, value string) string {
+	v := url.Values{}
+	v.Add(param, value)
+
+	return "?" + v.Encode()
+}
@@ -0,0 +1,23 @@
+package actionexecutions
+
+import "github.com/gophercloud/gophercloud"
+
+func createURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("action_executions")
+}
+
+func getURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("action_executions", id)
+}
+
+func updateURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("action_executions", id)
+}
+
+func deleteURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("action_executions", id)
+}
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("action_executions")
+}
@@ -107,7 +107,7 @@ type ListOpts struct {
 	// UpdatedAt allows to filter by last execution update date.
 	UpdatedAt *ListDateFilter `q:"-"`
 	// IncludeOutput requests to include the output for all executions in the list.
-	IncludeOutput bool `q:"-"`
+	IncludeOutput bool
 	// ProjectID allows to filter by given project id. Admin required.
 	ProjectID string `q:"project_id"`
 	// AllProjects requests to get executions of all projects. Admin required.
@@ -252,6 +252,9 @@ func TestToExecutionListQuery(t *testing.T) {
 				Value:  time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
+		newValue("include_output", "1"): &executions.ListOpts{
+			IncludeOutput: true,
+		},
 	} {
 		actual, _ := opts.ToExecutionListQuery()
 
@@ -0,0 +1,41 @@
+package pagination
+
+// PagerState is the position of an iteration over a Pager. It can be stored,
+// e.g. as JSON, to resume the iteration with Pager.Resume after the process
+// restarts. The zero PagerState is the position before the first page, so a
+// process which stops before its first checkpoint starts over.
+type PagerState struct {
+	// URL is the URL of the next page to fetch. It is empty before the first
+	// page and once the iteration is complete.
+	URL string `json:"url"`
+
+	// Done is set once the iteration is complete, i.e. the last page has been
+	// handled.
+	Done bool `json:"done"`
+}
+
+// Checkpoint returns the state of an iteration which has handled page, to
+// be called from the handler of EachPage once the page has been processed.
+// Resuming from it continues with the page after page.
+func Checkpoint(page Page) (PagerState, error) {
+	url, err := page.NextPageURL()
+	if err != nil {
+		return PagerState{}, err
+	}
+	return PagerState{URL: url, Done: url == ""}, nil
+}
+
+// Resume returns a Pager which continues an iteration from state rather
+// than from the first page. The Pager should come from the same List
+// function as the one the state was taken from. A state without a URL which
+// is not Done starts from the first page. If the iteration was complete,
+// the Pager has no pages left: First, EachPage and AllPages return
+// ErrPageNotAvailable.
+func (p Pager) Resume(state PagerState) Pager {
+	if state.URL != "" {
+		p.initialURL = state.URL
+	}
+	p.firstPage = nil
+	p.done = state.Done
+	return p
+}
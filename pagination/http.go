@@ -1,6 +1,7 @@
 package pagination
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -58,3 +59,13 @@ func Request(client *gophercloud.ServiceClient, headers map[string]string, url s
 		OkCodes:     []int{200, 204, 300},
 	})
 }
+
+// RequestWithContext performs an HTTP request bound to ctx and extracts the
+// http.Response from the result.
+func RequestWithContext(ctx context.Context, client *gophercloud.ServiceClient, headers map[string]string, url string) (*http.Response, error) {
+	return client.Get(url, nil, &gophercloud.RequestOpts{
+		MoreHeaders: headers,
+		OkCodes:     []int{200, 204, 300},
+		Context:     ctx,
+	})
+}
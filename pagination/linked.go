@@ -16,20 +16,37 @@ type LinkedPageBase struct {
 	// If any link results in an unexpected value type, an error will be returned.
 	// When left as "nil", []string{"links", "next"} will be used as a default.
 	LinkPath []string
+
+	// PreviousLinkPath lists the keys that should be traversed within a response to arrive at the "previous"
+	// pointer, like LinkPath does for the "next" one.
+	// When left as "nil", []string{"links", "previous"} will be used as a default.
+	PreviousLinkPath []string
 }
 
 // NextPageURL extracts the pagination structure from a JSON response and returns the "next" link, if one is present.
 // It assumes that the links are available in a "links" element of the top-level response object.
-// If this is not the case, override NextPageURL on your result type.
+// If this is not the case, override NextPageURL on your result type, along with PreviousPageURL.
 func (current LinkedPageBase) NextPageURL() (string, error) {
-	var path []string
-	var key string
-
 	if current.LinkPath == nil {
-		path = []string{"links", "next"}
-	} else {
-		path = current.LinkPath
+		return current.link([]string{"links", "next"})
 	}
+	return current.link(current.LinkPath)
+}
+
+// PreviousPageURL extracts the pagination structure from a JSON response and returns the "previous" link, if one is
+// present. It satisfies the PreviousPage interface. Result types which override NextPageURL should override
+// PreviousPageURL as well, e.g. with gophercloud.ExtractPreviousURL; otherwise it reads the default path, and
+// Pager.Previous returns ErrPageNotAvailable.
+func (current LinkedPageBase) PreviousPageURL() (string, error) {
+	if current.PreviousLinkPath == nil {
+		return current.link([]string{"links", "previous"})
+	}
+	return current.link(current.PreviousLinkPath)
+}
+
+// link returns the link at path within the response.
+func (current LinkedPageBase) link(path []string) (string, error) {
+	var key string
 
 	submap, ok := current.Body.(map[string]interface{})
 	if !ok {
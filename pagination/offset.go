@@ -0,0 +1,59 @@
+package pagination
+
+import (
+	"strconv"
+)
+
+// OffsetPage is a stricter Page interface that describes additional functionality required for use with OffsetPageBase.
+type OffsetPage interface {
+	Page
+
+	// ItemCount returns the number of items on this page.
+	ItemCount() (int, error)
+}
+
+// OffsetPageBase is a page in a collection that's paginated by "limit" and "offset" query parameters.
+// The offset of the next page is the offset of this page plus the number of items on it. Iteration stops
+// with an empty page, or with a page holding fewer items than the requested limit.
+type OffsetPageBase struct {
+	PageResult
+
+	// Owner is a reference to the embedding struct.
+	Owner OffsetPage
+}
+
+// NextPageURL generates the URL for the page of results after this one.
+func (current OffsetPageBase) NextPageURL() (string, error) {
+	count, err := current.Owner.ItemCount()
+	if err != nil {
+		return "", err
+	}
+	if count == 0 {
+		return "", nil
+	}
+
+	currentURL := current.URL
+	q := currentURL.Query()
+
+	if limit, err := strconv.Atoi(q.Get("limit")); err == nil && count < limit {
+		return "", nil
+	}
+
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	q.Set("offset", strconv.Itoa(offset+count))
+	currentURL.RawQuery = q.Encode()
+
+	return currentURL.String(), nil
+}
+
+// IsEmpty satisifies the IsEmpty method of the Page interface.
+func (current OffsetPageBase) IsEmpty() (bool, error) {
+	count, err := current.Owner.ItemCount()
+	return count == 0, err
+}
+
+// GetBody returns the offset page's body. This method is needed to satisfy the
+// Page interface.
+func (current OffsetPageBase) GetBody() interface{} {
+	return current.Body
+}
@@ -1,9 +1,11 @@
 package pagination
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strings"
 
@@ -33,6 +35,17 @@ type Page interface {
 	GetBody() interface{}
 }
 
+// PreviousPage is implemented by pages which know the URL of the page before them, such as the ones embedding
+// LinkedPageBase. It allows Pager.Previous to walk backwards through a collection. Services which only return a
+// "next" link, such as the Compute and Block Storage ones, never have a previous page.
+type PreviousPage interface {
+	Page
+
+	// PreviousPageURL generates the URL for the page of data that precedes this collection.
+	// Return "" if no such page exists.
+	PreviousPageURL() (string, error)
+}
+
 // Pager knows how to advance through a specific resource collection, one page at a time.
 type Pager struct {
 	client *gophercloud.ServiceClient
@@ -47,6 +60,27 @@ type Pager struct {
 
 	// Headers supplies additional HTTP headers to populate on each paged request.
 	Headers map[string]string
+
+	// UnknownFieldHandler is set as the UnknownFieldHandler of each page, to
+	// enable strict decoding of the pages. See gophercloud.Result.
+	UnknownFieldHandler func(gophercloud.ErrUnknownField) error
+
+	// Prefetch enables read-ahead in EachPage and AllPages: the next pages
+	// are fetched in the background while the handler processes the current
+	// one, and up to Prefetch fetched pages are buffered. Pages are still
+	// passed to the handler one at a time and in order. Prefetching is not
+	// concurrent fetching: as the URL of a page is only known from the page
+	// before it, there is at most one page request in flight, so it only hides
+	// the latency of the requests behind the time spent in the handler. Zero
+	// disables prefetching.
+	Prefetch int
+
+	// ctx is the context of the page requests, if set by EachPageWithContext
+	// or AllPagesWithContext.
+	ctx context.Context
+
+	// done is set by Resume for a completed iteration.
+	done bool
 }
 
 // NewPager constructs a manually-configured pager.
@@ -70,7 +104,20 @@ func (p Pager) WithPageCreator(createPage func(r PageResult) Page) Pager {
 }
 
 func (p Pager) fetchNextPage(url string) (Page, error) {
-	resp, err := Request(p.client, p.Headers, url)
+	if p.client.Logger != nil {
+		p.client.Logger.Log(gophercloud.LogDebug, "fetching page", "url", url)
+	}
+
+	var resp *http.Response
+	var err error
+	if p.ctx != nil {
+		if err := p.ctx.Err(); err != nil {
+			return nil, err
+		}
+		resp, err = RequestWithContext(p.ctx, p.client, p.Headers, url)
+	} else {
+		resp, err = Request(p.client, p.Headers, url)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -79,16 +126,69 @@ func (p Pager) fetchNextPage(url string) (Page, error) {
 	if err != nil {
 		return nil, err
 	}
+	remembered.UnknownFieldHandler = p.UnknownFieldHandler
 
 	return p.createPage(remembered), nil
 }
 
+// First fetches the first page of the collection.
+func (p Pager) First() (Page, error) {
+	if p.done {
+		return nil, ErrPageNotAvailable
+	}
+	return p.FetchPage(p.initialURL)
+}
+
+// Next fetches the page after the given one. It returns ErrPageNotAvailable if page is the last one.
+func (p Pager) Next(page Page) (Page, error) {
+	url, err := page.NextPageURL()
+	if err != nil {
+		return nil, err
+	}
+	if url == "" {
+		return nil, ErrPageNotAvailable
+	}
+	return p.FetchPage(url)
+}
+
+// Previous fetches the page before the given one. It returns ErrPageNotAvailable if page is the first one, or
+// if it does not implement PreviousPage.
+func (p Pager) Previous(page Page) (Page, error) {
+	previous, ok := page.(PreviousPage)
+	if !ok {
+		return nil, ErrPageNotAvailable
+	}
+	url, err := previous.PreviousPageURL()
+	if err != nil {
+		return nil, err
+	}
+	if url == "" {
+		return nil, ErrPageNotAvailable
+	}
+	return p.FetchPage(url)
+}
+
+// FetchPage fetches the page at url directly, e.g. a URL kept from an earlier NextPageURL or PreviousPageURL,
+// with the Headers of the Pager.
+func (p Pager) FetchPage(url string) (Page, error) {
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	return p.fetchNextPage(url)
+}
+
 // EachPage iterates over each page returned by a Pager, yielding one at a time to a handler function.
 // Return "false" from the handler to prematurely stop iterating.
 func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 	if p.Err != nil {
 		return p.Err
 	}
+	if p.done {
+		return ErrPageNotAvailable
+	}
+	if p.Prefetch > 0 {
+		return p.eachPagePrefetched(handler)
+	}
 	currentURL := p.initialURL
 	for {
 		var currentPage Page
@@ -131,6 +231,166 @@ func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 	}
 }
 
+// fetchedPage is a page, or the error fetching it, sent by prefetch.
+type fetchedPage struct {
+	page Page
+	err  error
+}
+
+// eachPagePrefetched is EachPage with the pages fetched by prefetch. Pages
+// still in flight are cancelled when the iteration stops.
+func (p Pager) eachPagePrefetched(handler func(Page) (bool, error)) error {
+	parent := p.ctx
+	if parent == nil {
+		parent = p.client.Context
+	}
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	p.ctx = ctx
+
+	pages := make(chan fetchedPage, p.Prefetch)
+	go p.prefetch(pages)
+
+	for fetched := range pages {
+		if fetched.err != nil {
+			return fetched.err
+		}
+
+		ok, err := handler(fetched.page)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+	return nil
+}
+
+// prefetch fetches the pages one after another and sends the non-empty ones
+// to pages, until the last page, an error or the cancellation of p.ctx.
+func (p Pager) prefetch(pages chan<- fetchedPage) {
+	defer close(pages)
+
+	send := func(page Page, err error) bool {
+		select {
+		case pages <- fetchedPage{page: page, err: err}:
+			return err == nil
+		case <-p.ctx.Done():
+			return false
+		}
+	}
+
+	currentURL := p.initialURL
+	for {
+		var currentPage Page
+
+		// if first page has already been fetched, no need to fetch it again
+		if p.firstPage != nil {
+			currentPage = p.firstPage
+			p.firstPage = nil
+		} else {
+			var err error
+			currentPage, err = p.fetchNextPage(currentURL)
+			if err != nil {
+				send(nil, err)
+				return
+			}
+		}
+
+		empty, err := currentPage.IsEmpty()
+		if err != nil {
+			send(nil, err)
+			return
+		}
+		if empty {
+			return
+		}
+
+		if !send(currentPage, nil) {
+			return
+		}
+
+		currentURL, err = currentPage.NextPageURL()
+		if err != nil {
+			send(nil, err)
+			return
+		}
+		if currentURL == "" {
+			return
+		}
+	}
+}
+
+// PageInfo describes a page passed to the handler of EachPageWithInfo.
+type PageInfo struct {
+	// Index is the zero-based position of the page in the iteration.
+	Index int
+
+	// URL is the URL the page was fetched from.
+	URL url.URL
+
+	// Header is the header of the response of the page, e.g. to read
+	// X-Container-Object-Count.
+	Header http.Header
+}
+
+// EachPageWithInfo is like EachPage, but the handler also receives the
+// index, URL and response header of each page, e.g. for logging or to
+// resume an iteration later with FetchPage.
+func (p Pager) EachPageWithInfo(handler func(Page, PageInfo) (bool, error)) error {
+	index := 0
+	return p.EachPage(func(page Page) (bool, error) {
+		info := PageInfo{Index: index}
+		if r, ok := pageResultOf(page); ok {
+			info.URL = r.URL
+			info.Header = r.Header
+		}
+		index++
+		return handler(page, info)
+	})
+}
+
+// pageResultOf returns the PageResult embedded in page, if any. All of the
+// PageBase structs embed one.
+func pageResultOf(page Page) (PageResult, bool) {
+	v := reflect.ValueOf(page)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return PageResult{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return PageResult{}, false
+	}
+
+	f := v.FieldByName("PageResult")
+	if !f.IsValid() || f.Type() != reflect.TypeOf(PageResult{}) {
+		return PageResult{}, false
+	}
+	return f.Interface().(PageResult), true
+}
+
+// EachPageWithContext is like EachPage, but the page requests are bound to
+// ctx: iteration stops with the error of ctx once it is cancelled or its
+// deadline is exceeded. ctx takes precedence over the Context of the
+// ProviderClient.
+func (p Pager) EachPageWithContext(ctx context.Context, handler func(Page) (bool, error)) error {
+	p.ctx = ctx
+	return p.EachPage(handler)
+}
+
+// AllPagesWithContext is like AllPages, but the page requests are bound to
+// ctx.
+func (p Pager) AllPagesWithContext(ctx context.Context) (Page, error) {
+	p.ctx = ctx
+	return p.AllPages()
+}
+
 // AllPages returns all the pages from a `List` operation in a single page,
 // allowing the user to retrieve all the pages at once.
 func (p Pager) AllPages() (Page, error) {
@@ -139,6 +399,10 @@ func (p Pager) AllPages() (Page, error) {
 	// body will contain the final concatenated Page body.
 	var body reflect.Value
 
+	if p.done {
+		return nil, ErrPageNotAvailable
+	}
+
 	// Grab a first page to ascertain the page body type.
 	firstPage, err := p.fetchNextPage(p.initialURL)
 	if err != nil {
@@ -245,6 +509,10 @@ func (p Pager) AllPages() (Page, error) {
 		h.Add(k, v)
 	}
 	page.Elem().FieldByName("Header").Set(reflect.ValueOf(h))
+	// Decode the concatenated pages as strictly as the pages themselves.
+	if handler := page.Elem().FieldByName("UnknownFieldHandler"); handler.IsValid() {
+		handler.Set(reflect.ValueOf(p.UnknownFieldHandler))
+	}
 	// Type assert the page to a Page interface so that the type assertion in the
 	// `Extract*` methods will work.
 	return page.Elem().Interface().(Page), err
@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestResumeMarker(t *testing.T) {
+	pager := createMarkerPaged(t)
+	defer testhelper.TeardownHTTP()
+
+	// Stop after the first page, as if the process was interrupted.
+	var saved []byte
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		state, err := pagination.Checkpoint(page)
+		if err != nil {
+			return false, err
+		}
+		saved, err = json.Marshal(state)
+		return false, err
+	})
+	testhelper.AssertNoErr(t, err)
+
+	var state pagination.PagerState
+	testhelper.AssertNoErr(t, json.Unmarshal(saved, &state))
+	testhelper.CheckEquals(t, false, state.Done)
+
+	var actual []string
+	err = pager.Resume(state).EachPage(func(page pagination.Page) (bool, error) {
+		s, err := ExtractMarkerStrings(page)
+		actual = append(actual, s...)
+		return true, err
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []string{"ddd", "eee", "fff", "ggg", "hhh", "iii"}, actual)
+}
+
+func TestResumeCompleted(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	var state pagination.PagerState
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		var err error
+		state, err = pagination.Checkpoint(page)
+		return true, err
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, true, state.Done)
+
+	resumed := pager.Resume(state)
+	err = resumed.EachPage(func(page pagination.Page) (bool, error) {
+		t.Errorf("Unexpected page after a completed iteration")
+		return false, nil
+	})
+	testhelper.CheckEquals(t, pagination.ErrPageNotAvailable, err)
+
+	_, err = resumed.AllPages()
+	testhelper.CheckEquals(t, pagination.ErrPageNotAvailable, err)
+}
+
+func TestResumeZeroState(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	// A process which stopped before its first checkpoint starts over.
+	var actual []int
+	err := pager.Resume(pagination.PagerState{}).EachPage(func(page pagination.Page) (bool, error) {
+		i, err := ExtractLinkedInts(page)
+		actual = append(actual, i...)
+		return true, err
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+}
@@ -1,11 +1,14 @@
 package testing
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
 	"github.com/gophercloud/gophercloud/testhelper"
 )
@@ -39,12 +42,12 @@ func createLinked(t *testing.T) pagination.Pager {
 
 	testhelper.Mux.HandleFunc("/page2", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Add("Content-Type", "application/json")
-		fmt.Fprintf(w, `{ "ints": [4, 5, 6], "links": { "next": "%s/page3" } }`, testhelper.Server.URL)
+		fmt.Fprintf(w, `{ "ints": [4, 5, 6], "links": { "next": "%s/page3", "previous": "%s/page1" } }`, testhelper.Server.URL, testhelper.Server.URL)
 	})
 
 	testhelper.Mux.HandleFunc("/page3", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Add("Content-Type", "application/json")
-		fmt.Fprintf(w, `{ "ints": [7, 8, 9], "links": { "next": null } }`)
+		fmt.Fprintf(w, `{ "ints": [7, 8, 9], "links": { "next": null, "previous": "%s/page2" } }`, testhelper.Server.URL)
 	})
 
 	client := createClient()
@@ -110,3 +113,147 @@ func TestAllPagesLinked(t *testing.T) {
 	testhelper.AssertNoErr(t, err)
 	testhelper.CheckDeepEquals(t, expected, actual)
 }
+
+func TestEnumerateLinkedWithContext(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	callCount := 0
+	err := pager.EachPageWithContext(ctx, func(page pagination.Page) (bool, error) {
+		callCount++
+		cancel()
+		return true, nil
+	})
+	if err != context.Canceled {
+		t.Errorf("Expected %v, but was %v", context.Canceled, err)
+	}
+
+	if callCount != 1 {
+		t.Errorf("Expected 1 call, but was %d", callCount)
+	}
+}
+
+func TestAllPagesLinkedWithContext(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	page, err := pager.AllPagesWithContext(context.Background())
+	testhelper.AssertNoErr(t, err)
+
+	actual, err := ExtractLinkedInts(page)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	_, err = pager.AllPagesWithContext(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected %v, but was %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestNavigateLinked(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	page, err := pager.First()
+	testhelper.AssertNoErr(t, err)
+	_, err = pager.Previous(page)
+	testhelper.CheckEquals(t, pagination.ErrPageNotAvailable, err)
+
+	for i := 0; i < 2; i++ {
+		page, err = pager.Next(page)
+		testhelper.AssertNoErr(t, err)
+	}
+	actual, err := ExtractLinkedInts(page)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{7, 8, 9}, actual)
+
+	_, err = pager.Next(page)
+	testhelper.CheckEquals(t, pagination.ErrPageNotAvailable, err)
+
+	page, err = pager.Previous(page)
+	testhelper.AssertNoErr(t, err)
+	actual, err = ExtractLinkedInts(page)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{4, 5, 6}, actual)
+}
+
+func TestFetchPageLinked(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	page, err := pager.FetchPage(testhelper.Server.URL + "/page3")
+	testhelper.AssertNoErr(t, err)
+
+	actual, err := ExtractLinkedInts(page)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{7, 8, 9}, actual)
+}
+
+func TestEnumerateLinkedWithInfo(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	var indexes []int
+	var paths []string
+	err := pager.EachPageWithInfo(func(page pagination.Page, info pagination.PageInfo) (bool, error) {
+		indexes = append(indexes, info.Index)
+		paths = append(paths, info.URL.Path)
+		testhelper.CheckEquals(t, "application/json", info.Header.Get("Content-Type"))
+		return true, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{0, 1, 2}, indexes)
+	testhelper.CheckDeepEquals(t, []string{"/page1", "/page2", "/page3"}, paths)
+}
+
+func TestLinkedUnknownFieldHandler(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	var unknown []string
+	pager.UnknownFieldHandler = func(err gophercloud.ErrUnknownField) error {
+		unknown = append(unknown, err.Field)
+		return nil
+	}
+
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		_, err := ExtractLinkedInts(page)
+		return err == nil, err
+	})
+	testhelper.AssertNoErr(t, err)
+
+	// ExtractLinkedInts does not know the links, and IsEmpty extracts each
+	// page once more.
+	testhelper.CheckDeepEquals(t, []string{"links", "links", "links", "links", "links", "links"}, unknown)
+}
+
+func TestLinkedAllPagesUnknownFieldHandler(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	var unknown []string
+	pager.UnknownFieldHandler = func(err gophercloud.ErrUnknownField) error {
+		unknown = append(unknown, err.Field)
+		return nil
+	}
+
+	page, err := pager.AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	// The concatenated page has no links, so only the ints are unknown to a
+	// result which does not expect them.
+	unknown = nil
+	var s struct {
+		Other []int `json:"other"`
+	}
+	err = page.(LinkedPageResult).ExtractInto(&s)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []string{"ints"}, unknown)
+}
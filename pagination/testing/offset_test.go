@@ -0,0 +1,95 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/gophercloud/gophercloud/testhelper"
+)
+
+// OffsetPager sample and test cases.
+
+type OffsetPageResult struct {
+	pagination.OffsetPageBase
+}
+
+func (r OffsetPageResult) ItemCount() (int, error) {
+	is, err := ExtractOffsetInts(r)
+	return len(is), err
+}
+
+func ExtractOffsetInts(r pagination.Page) ([]int, error) {
+	var s struct {
+		Ints []int `json:"ints"`
+	}
+	err := (r.(OffsetPageResult)).ExtractInto(&s)
+	return s.Ints, err
+}
+
+func createOffsetPaged(t *testing.T, query string) pagination.Pager {
+	testhelper.SetupHTTP()
+
+	testhelper.Mux.HandleFunc("/page", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		switch r.URL.Query().Get("offset") {
+		case "", "0":
+			fmt.Fprintf(w, `{ "ints": [1, 2, 3] }`)
+		case "3":
+			fmt.Fprintf(w, `{ "ints": [4, 5, 6] }`)
+		case "6":
+			fmt.Fprintf(w, `{ "ints": [7, 8] }`)
+		case "8":
+			if r.URL.Query().Get("limit") != "" {
+				t.Errorf("Unexpected request after a short page")
+			}
+			fmt.Fprintf(w, `{ "ints": [] }`)
+		default:
+			t.Errorf("Request with unexpected offset: %s", r.URL.Query().Get("offset"))
+		}
+	})
+
+	client := createClient()
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		p := OffsetPageResult{pagination.OffsetPageBase{PageResult: r}}
+		p.OffsetPageBase.Owner = p
+		return p
+	}
+
+	return pagination.NewPager(client, testhelper.Server.URL+"/page"+query, createPage)
+}
+
+func TestEnumerateOffset(t *testing.T) {
+	pager := createOffsetPaged(t, "")
+	defer testhelper.TeardownHTTP()
+
+	var actual []int
+	callCount := 0
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		ints, err := ExtractOffsetInts(page)
+		if err != nil {
+			return false, err
+		}
+		actual = append(actual, ints...)
+		callCount++
+		return true, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, actual)
+	testhelper.CheckEquals(t, 3, callCount)
+}
+
+func TestAllPagesOffsetWithLimit(t *testing.T) {
+	// The short last page ends the iteration without fetching an empty page.
+	pager := createOffsetPaged(t, "?limit=3")
+	defer testhelper.TeardownHTTP()
+
+	page, err := pager.AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	actual, err := ExtractOffsetInts(page)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, actual)
+}
@@ -0,0 +1,95 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/gophercloud/gophercloud/testhelper"
+)
+
+func createPrefetched(t *testing.T, fetched *int32) pagination.Pager {
+	testhelper.SetupHTTP()
+
+	for i := 1; i <= 3; i++ {
+		next := fmt.Sprintf(`"%s/page%d"`, testhelper.Server.URL, i+1)
+		if i == 3 {
+			next = "null"
+		}
+		body := fmt.Sprintf(`{ "ints": [%d], "links": { "next": %s } }`, i, next)
+
+		testhelper.Mux.HandleFunc(fmt.Sprintf("/page%d", i), func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(fetched, 1)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprint(w, body)
+		})
+	}
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return LinkedPageResult{pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	pager := pagination.NewPager(createClient(), testhelper.Server.URL+"/page1", createPage)
+	pager.Prefetch = 2
+	return pager
+}
+
+func TestEnumeratePrefetched(t *testing.T) {
+	var fetched int32
+	pager := createPrefetched(t, &fetched)
+	defer testhelper.TeardownHTTP()
+
+	var actual []int
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		if len(actual) == 0 {
+			// The following pages are fetched while the first one is handled.
+			deadline := time.Now().Add(5 * time.Second)
+			for atomic.LoadInt32(&fetched) < 3 {
+				if time.Now().After(deadline) {
+					t.Fatalf("Expected 3 pages to be fetched, but was %d", atomic.LoadInt32(&fetched))
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}
+
+		ints, err := ExtractLinkedInts(page)
+		if err != nil {
+			return false, err
+		}
+		actual = append(actual, ints...)
+		return true, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3}, actual)
+}
+
+func TestEnumeratePrefetchedStop(t *testing.T) {
+	var fetched int32
+	pager := createPrefetched(t, &fetched)
+	defer testhelper.TeardownHTTP()
+
+	callCount := 0
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		callCount++
+		return false, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, 1, callCount)
+}
+
+func TestAllPagesPrefetched(t *testing.T) {
+	var fetched int32
+	pager := createPrefetched(t, &fetched)
+	defer testhelper.TeardownHTTP()
+
+	page, err := pager.AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	actual, err := ExtractLinkedInts(page)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3}, actual)
+	testhelper.CheckEquals(t, int32(3), atomic.LoadInt32(&fetched))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -136,6 +137,10 @@ func BuildRequestBody(opts interface{}, parent string) (map[string]interface{},
 					}
 				}
 			}
+			// A Nullable marshals itself and has no fields to validate.
+			if v.Type() == reflect.TypeOf(Nullable{}) || v.Type() == reflect.TypeOf(&Nullable{}) {
+				continue
+			}
 			if v.Kind() == reflect.Struct || (v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct) {
 				if zero {
 					//fmt.Printf("value before change: %+v\n", optsValue.Field(i))
@@ -220,6 +225,79 @@ func IntToPointer(i int) *int {
 	return &i
 }
 
+// String returns a pointer to the given string. This is useful for setting
+// optional *string fields of options, where a nil pointer leaves the
+// attribute unchanged and a pointer to "" sets it to the empty string.
+func String(s string) *string {
+	return &s
+}
+
+// Int returns a pointer to the given int. It is the counterpart of String for
+// optional *int fields.
+func Int(i int) *int {
+	return &i
+}
+
+// Bool returns a pointer to the given bool. It is the counterpart of String
+// for optional *bool fields.
+func Bool(b bool) *bool {
+	return &b
+}
+
+/*
+Nullable is an option which can be set to a value or explicitly cleared. It
+is used in Update operations to distinguish between the three states of an
+attribute. Fields of this type are declared as pointers and omitted when
+empty:
+
+	type UpdateOpts struct {
+		Description *gophercloud.Nullable `json:"description,omitempty"`
+	}
+
+A nil *Nullable leaves the attribute unchanged, NullableValue sets it to the
+given value, including its zero value, and Null clears it by sending a JSON
+null:
+
+	// {"description": "web servers"}
+	updateOpts := UpdateOpts{Description: gophercloud.NullableValue("web servers")}
+
+	// {"description": null}
+	updateOpts := UpdateOpts{Description: gophercloud.Null()}
+*/
+type Nullable struct {
+	value interface{}
+	set   bool
+}
+
+// Null returns a Nullable which clears an attribute.
+func Null() *Nullable {
+	return &Nullable{}
+}
+
+// NullableValue returns a Nullable which sets an attribute to v.
+func NullableValue(v interface{}) *Nullable {
+	return &Nullable{value: v, set: true}
+}
+
+// IsNull reports whether n clears the attribute, either because it was
+// created by Null or because its value is nil.
+func (n Nullable) IsNull() bool {
+	return !n.set || n.value == nil
+}
+
+// Value returns the value the attribute is set to, or nil if n clears it.
+func (n Nullable) Value() interface{} {
+	return n.value
+}
+
+// MarshalJSON encodes n as its value or as a JSON null.
+func (n Nullable) MarshalJSON() ([]byte, error) {
+	if n.IsNull() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
 /*
 MaybeString is an internal function to be used by request methods in individual
 resource packages.
@@ -318,8 +396,17 @@ converted into query parameters based on a "q" tag. For example:
 
 will be converted into "?x_bar=AAA&lorem_ipsum=BBB".
 
-The struct's fields may be strings, integers, or boolean values. Fields left at
-their type's zero value will be omitted from the query.
+The struct's fields may be strings, integers, floats, boolean values, or
+pointers to them. Slices of these add one query parameter per element, and
+a map[string]string is encoded as "{'key':'value', ...}". A time.Time is
+formatted as RFC 3339, or with the layout of a "format" tag:
+
+	Since time.Time `q:"changes-since" format:"2006-01-02T15:04:05"`
+
+Types implementing QueryMarshaler encode themselves, which allows filters
+such as TimeRange. Other fields with a "q" tag of "-" are skipped.
+
+Fields left at their type's zero value will be omitted from the query.
 */
 func BuildQueryString(opts interface{}) (*url.URL, error) {
 	optsValue := reflect.ValueOf(opts)
@@ -341,42 +428,17 @@ func BuildQueryString(opts interface{}) (*url.URL, error) {
 			qTag := f.Tag.Get("q")
 
 			// if the field has a 'q' tag, it goes in the query string
-			if qTag != "" {
+			if qTag != "" && qTag != "-" {
 				tags := strings.Split(qTag, ",")
 
 				// if the field is set, add it to the slice of query pieces
 				if !isZero(v) {
-				loop:
-					switch v.Kind() {
-					case reflect.Ptr:
-						v = v.Elem()
-						goto loop
-					case reflect.String:
-						params.Add(tags[0], v.String())
-					case reflect.Int:
-						params.Add(tags[0], strconv.FormatInt(v.Int(), 10))
-					case reflect.Bool:
-						params.Add(tags[0], strconv.FormatBool(v.Bool()))
-					case reflect.Slice:
-						switch v.Type().Elem() {
-						case reflect.TypeOf(0):
-							for i := 0; i < v.Len(); i++ {
-								params.Add(tags[0], strconv.FormatInt(v.Index(i).Int(), 10))
-							}
-						default:
-							for i := 0; i < v.Len(); i++ {
-								params.Add(tags[0], v.Index(i).String())
-							}
-						}
-					case reflect.Map:
-						if v.Type().Key().Kind() == reflect.String && v.Type().Elem().Kind() == reflect.String {
-							var s []string
-							for _, k := range v.MapKeys() {
-								value := v.MapIndex(k).String()
-								s = append(s, fmt.Sprintf("'%s':'%s'", k.String(), value))
-							}
-							params.Add(tags[0], fmt.Sprintf("{%s}", strings.Join(s, ", ")))
-						}
+					values, err := queryValues(v, f.Tag.Get("format"))
+					if err != nil {
+						return nil, fmt.Errorf("Query parameter [%s]: %s", f.Name, err)
+					}
+					for _, value := range values {
+						params.Add(tags[0], value)
 					}
 				} else {
 					// if the field has a 'required' tag, it can't have a zero-value
@@ -393,6 +455,122 @@ func BuildQueryString(opts interface{}) (*url.URL, error) {
 	return nil, fmt.Errorf("Options type is not a struct.")
 }
 
+// QueryMarshaler is implemented by option types which encode themselves as
+// query string values. BuildQueryString adds one query parameter per value
+// returned by MarshalQuery.
+type QueryMarshaler interface {
+	MarshalQuery() ([]string, error)
+}
+
+// TimeRange filters a timestamp attribute by the interval from Start,
+// inclusive, to End, exclusive. It is encoded with the "gte:" and "lt:"
+// comparison operators understood by services such as the Image service:
+//
+//	CreatedAt gophercloud.TimeRange `q:"created_at"`
+//
+// Either bound may be left at its zero value for an open interval.
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// MarshalQuery implements QueryMarshaler.
+func (r TimeRange) MarshalQuery() ([]string, error) {
+	var values []string
+	if !r.Start.IsZero() {
+		values = append(values, "gte:"+r.Start.Format(time.RFC3339))
+	}
+	if !r.End.IsZero() {
+		values = append(values, "lt:"+r.End.Format(time.RFC3339))
+	}
+	return values, nil
+}
+
+// queryValues encodes a single non-zero field of a BuildQueryString struct.
+func queryValues(v reflect.Value, format string) ([]string, error) {
+	for {
+		if m, ok := v.Interface().(QueryMarshaler); ok {
+			return m.MarshalQuery()
+		}
+		if v.Kind() != reflect.Ptr {
+			break
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		var values []string
+		for i := 0; i < v.Len(); i++ {
+			value, err := queryValue(v.Index(i), format)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case reflect.Map:
+		if v.Type().Key().Kind() == reflect.String && v.Type().Elem().Kind() == reflect.String {
+			keys := make([]string, 0, v.Len())
+			for _, k := range v.MapKeys() {
+				keys = append(keys, k.String())
+			}
+			sort.Strings(keys)
+
+			var s []string
+			for _, k := range keys {
+				value := v.MapIndex(reflect.ValueOf(k).Convert(v.Type().Key())).String()
+				s = append(s, fmt.Sprintf("'%s':'%s'", k, value))
+			}
+			return []string{fmt.Sprintf("{%s}", strings.Join(s, ", "))}, nil
+		}
+		return nil, nil
+	case reflect.Struct:
+		if v.Type() != reflect.TypeOf(time.Time{}) {
+			return nil, nil
+		}
+	}
+
+	value, err := queryValue(v, format)
+	if err != nil {
+		return nil, err
+	}
+	return []string{value}, nil
+}
+
+// queryValue encodes a scalar value, or an element of a slice, of a
+// BuildQueryString struct.
+func queryValue(v reflect.Value, format string) (string, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", fmt.Errorf("nil element")
+		}
+		v = v.Elem()
+	}
+
+	if t, ok := v.Interface().(time.Time); ok {
+		if format == "" {
+			format = time.RFC3339
+		}
+		return t.Format(format), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
 /*
 BuildHeaders is an internal function to be used by request methods in
 individual resource packages.
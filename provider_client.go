@@ -8,8 +8,10 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 // DefaultUserAgent is the default User-Agent string set in the request header.
@@ -80,6 +82,36 @@ type ProviderClient struct {
 	// Context is the context passed to the HTTP request.
 	Context context.Context
 
+	// Logger, if set, receives messages about requests, re-authentication and
+	// pagination.
+	Logger Logger
+
+	// Observer, if set, is told about every HTTP request sent by the client,
+	// e.g. to collect metrics.
+	Observer RequestObserver
+
+	// DryRun, if set, puts the client into dry-run mode: requests which change
+	// resources, i.e. all requests but GET, HEAD and OPTIONS, are passed to
+	// DryRun and logged instead of being sent. Their options are still
+	// validated. They succeed with an empty JSON object as response body, so
+	// their results carry no data: Extract methods which return a pointer,
+	// such as servers.Create(...).Extract(), return nil and no error, and
+	// others return zero values. Callers must check for nil before using such
+	// results. Authentication requests are always sent. See RequestPlan.
+	DryRun func(PlannedRequest)
+
+	// Cache, if set, caches the responses of successful GET requests.
+	Cache *ResponseCache
+
+	// Retry, if set, makes the client retry requests which were throttled by the service, including
+	// the requests of pagination.
+	Retry *RetryOpts
+
+	// DeprecationHandler, if set, is called for every response with
+	// deprecation signals, such as a Warning, Deprecation or Sunset header.
+	// They are also logged to the Logger.
+	DeprecationHandler func(DeprecationNotice)
+
 	// mut is a mutex for the client. It protects read and write access to client attributes such as getting
 	// and setting the TokenID.
 	mut *sync.RWMutex
@@ -234,7 +266,12 @@ func (client *ProviderClient) Reauthenticate(previousToken string) error {
 	}
 
 	if client.reauthmut == nil {
-		return client.ReauthFunc()
+		client.logEvent(LogInfo, "re-authenticating")
+		err := client.ReauthFunc()
+		if err != nil {
+			client.logEvent(LogError, "re-authentication failed", "error", err)
+		}
+		return err
 	}
 
 	messages := make(chan (chan<- error))
@@ -257,8 +294,13 @@ func (client *ProviderClient) Reauthenticate(previousToken string) error {
 	// Perform the actual reauthentication.
 	var err error
 	if previousToken == "" || client.TokenID == previousToken {
+		client.logEvent(LogInfo, "re-authenticating")
 		err = client.ReauthFunc()
+		if err != nil {
+			client.logEvent(LogError, "re-authentication failed", "error", err)
+		}
 	} else {
+		client.logEvent(LogDebug, "skipping re-authentication, the token was already renewed")
 		err = nil
 	}
 
@@ -305,6 +347,44 @@ type RequestOpts struct {
 	// ErrorContext specifies the resource error type to return if an error is encountered.
 	// This lets resources override default error messages based on the response status code.
 	ErrorContext error
+	// IgnoreDryRun sends the request even if the client is in dry-run mode. It is set by
+	// authentication requests, which do not change resources.
+	IgnoreDryRun bool
+	// Context, if set, is the context of this request. It takes precedence over the Context of
+	// the ProviderClient, e.g. to cancel a single request or to give it a deadline.
+	Context context.Context
+
+	// serviceType is the type of the ServiceClient sending the request, if any.
+	serviceType string
+}
+
+// RequestEvent describes an HTTP request sent by a ProviderClient.
+type RequestEvent struct {
+	// Service is the type of the service client which sent the request, e.g.
+	// "compute". It is empty for requests sent by the ProviderClient directly.
+	Service string
+
+	// Method is the HTTP method of the request.
+	Method string
+
+	// URL is the URL of the request.
+	URL string
+
+	// StatusCode is the HTTP status code of the response. It is 0 if no
+	// response was received.
+	StatusCode int
+
+	// Err is the error which prevented a response from being received, if any.
+	Err error
+
+	// Duration is the time taken until the response headers were received.
+	Duration time.Duration
+}
+
+// RequestObserver is told about the HTTP requests sent by a ProviderClient.
+// It must be safe for concurrent use.
+type RequestObserver interface {
+	ObserveRequest(RequestEvent)
 }
 
 // requestState contains temporary state for a single ProviderClient.Request() call.
@@ -314,6 +394,9 @@ type requestState struct {
 	// reauthenticate, but keep getting 401 responses with the fresh token, reauthenticating some more
 	// will just get us into an infinite loop.
 	hasReauthenticated bool
+
+	// retries is the number of times this request has been retried after being throttled.
+	retries int
 }
 
 var applicationJSON = "application/json"
@@ -328,6 +411,7 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 
 func (client *ProviderClient) doRequest(method, url string, options *RequestOpts, state *requestState) (*http.Response, error) {
 	var body io.Reader
+	var rendered []byte
 	var contentType *string
 
 	// Derive the content body by either encoding an arbitrary object as JSON, or by taking a provided
@@ -337,7 +421,8 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 			return nil, errors.New("please provide only one of JSONBody or RawBody to gophercloud.Request()")
 		}
 
-		rendered, err := json.Marshal(options.JSONBody)
+		var err error
+		rendered, err = json.Marshal(options.JSONBody)
 		if err != nil {
 			return nil, err
 		}
@@ -355,7 +440,9 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	if err != nil {
 		return nil, err
 	}
-	if client.Context != nil {
+	if options.Context != nil {
+		req = req.WithContext(options.Context)
+	} else if client.Context != nil {
 		req = req.WithContext(client.Context)
 	}
 
@@ -379,6 +466,21 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 		}
 	}
 
+	// http.Request ignores the Content-Length and Transfer-Encoding headers, so move them to the
+	// fields it uses. This allows a RawBody to be streamed with a known length or chunked.
+	if v := req.Header.Get("Content-Length"); v != "" {
+		length, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		req.ContentLength = length
+		req.Header.Del("Content-Length")
+	}
+	if v := req.Header.Get("Transfer-Encoding"); v != "" {
+		req.TransferEncoding = []string{v}
+		req.Header.Del("Transfer-Encoding")
+	}
+
 	// get latest token from client
 	for k, v := range client.AuthenticatedHeaders() {
 		req.Header.Set(k, v)
@@ -389,11 +491,51 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 
 	prereqtok := req.Header.Get("X-Auth-Token")
 
+	if client.DryRun != nil && !options.IgnoreDryRun && isMutating(method) {
+		return client.dryRun(req, options, rendered)
+	}
+
+	var cacheID string
+	var cacheTTL time.Duration
+	if client.Cache != nil && method == "GET" {
+		cacheTTL = client.Cache.ttl(req.URL.Path)
+		if cacheTTL > 0 {
+			cacheID = cacheKey(req)
+			if resp, ok := client.Cache.get(cacheID, req); ok {
+				client.logEvent(LogDebug, "cached response", "method", method, "url", url)
+				if options.JSONResponse != nil {
+					defer resp.Body.Close()
+					if err := json.NewDecoder(resp.Body).Decode(options.JSONResponse); err != nil {
+						return nil, err
+					}
+				}
+				return resp, nil
+			}
+		}
+	}
+
 	// Issue the request.
+	start := time.Now()
 	resp, err := client.HTTPClient.Do(req)
+	if client.Observer != nil {
+		event := RequestEvent{
+			Service:  options.serviceType,
+			Method:   method,
+			URL:      url,
+			Err:      err,
+			Duration: time.Since(start),
+		}
+		if resp != nil {
+			event.StatusCode = resp.StatusCode
+		}
+		client.Observer.ObserveRequest(event)
+	}
 	if err != nil {
+		client.logEvent(LogDebug, "request failed", "method", method, "url", url, "error", err)
 		return nil, err
 	}
+	client.logEvent(LogDebug, "request", "method", method, "url", url, "status", resp.StatusCode)
+	client.reportDeprecation(method, url, resp.Header)
 
 	// Allow default OkCodes if none explicitly set
 	okc := options.OkCodes
@@ -413,6 +555,22 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	if !ok {
 		body, _ := ioutil.ReadAll(resp.Body)
 		resp.Body.Close()
+
+		// A body which cannot be rewound is not sent again; the response is
+		// returned as an error instead.
+		if delay, retry := client.Retry.retryDelay(resp, options, state.retries); retry && rewindBody(options.RawBody) {
+			client.logEvent(LogWarn, "request throttled, retrying", "method", method, "url", url, "status", resp.StatusCode, "delay", delay)
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-req.Context().Done():
+				timer.Stop()
+				return nil, req.Context().Err()
+			}
+			state.retries++
+			return client.doRequest(method, url, options, state)
+		}
+
 		respErr := ErrUnexpectedResponseCode{
 			URL:      url,
 			Method:   method,
@@ -430,6 +588,7 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 			}
 		case http.StatusUnauthorized:
 			if client.ReauthFunc != nil && !state.hasReauthenticated {
+				client.logEvent(LogInfo, "token rejected, re-authenticating before retrying the request", "method", method, "url", url)
 				err = client.Reauthenticate(prereqtok)
 				if err != nil {
 					e := &ErrUnableToReauthenticate{}
@@ -510,6 +669,12 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 		return resp, err
 	}
 
+	if cacheID != "" {
+		if err := client.Cache.put(cacheID, resp, cacheTTL); err != nil {
+			return nil, err
+		}
+	}
+
 	// Parse the response body as JSON, if requested to do so.
 	if options.JSONResponse != nil {
 		defer resp.Body.Close()
@@ -521,6 +686,13 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	return resp, nil
 }
 
+// logEvent passes a message to the Logger of the client, if any.
+func (client *ProviderClient) logEvent(level LogLevel, msg string, keysAndValues ...interface{}) {
+	if client.Logger != nil {
+		client.Logger.Log(level, msg, keysAndValues...)
+	}
+}
+
 func defaultOkCodes(method string) []int {
 	switch {
 	case method == "GET":
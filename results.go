@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -36,6 +38,29 @@ type Result struct {
 	// Err is an error that occurred during the operation. It's deferred until
 	// extraction to make it easier to chain the Extract call.
 	Err error
+
+	// UnknownFieldHandler enables strict decoding of Body. It is nil by
+	// default, in which case fields of the response body which the result
+	// type does not know are silently dropped.
+	//
+	// When set, ExtractInto and the Extract methods built on it call
+	// UnknownFieldHandler with an ErrUnknownField for the first unknown field
+	// of the body. If it returns an error, the extraction fails with that
+	// error. If it returns nil, for example after logging the field, the body
+	// is extracted as usual. This helps to detect API changes early:
+	//
+	//	r := servers.Get(client, id)
+	//	r.UnknownFieldHandler = func(err gophercloud.ErrUnknownField) error {
+	//		return err
+	//	}
+	//	server, err := r.Extract()
+	//
+	// Pages of a pagination.Pager take it from Pager.UnknownFieldHandler.
+	//
+	// Fields of types which implement json.Unmarshaler are not checked. Types
+	// which embed a json.Unmarshaler, such as results composed with
+	// extensions, are not checked at all.
+	UnknownFieldHandler func(ErrUnknownField) error
 }
 
 // ExtractInto allows users to provide an object into which `Extract` will extract
@@ -50,18 +75,100 @@ func (r Result) ExtractInto(to interface{}) error {
 		if readCloser, ok := reader.(io.Closer); ok {
 			defer readCloser.Close()
 		}
-		return json.NewDecoder(reader).Decode(to)
+		if r.UnknownFieldHandler == nil {
+			return json.NewDecoder(reader).Decode(to)
+		}
+
+		b, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		if err := r.checkUnknownFields(b, to); err != nil {
+			return err
+		}
+		return json.NewDecoder(bytes.NewReader(b)).Decode(to)
 	}
 
 	b, err := json.Marshal(r.Body)
 	if err != nil {
 		return err
 	}
+	if err := r.checkUnknownFields(b, to); err != nil {
+		return err
+	}
 	err = json.Unmarshal(b, to)
 
 	return err
 }
 
+// checkUnknownFields decodes b strictly into a new value of the type of to
+// and passes the first unknown field to r.UnknownFieldHandler.
+func (r Result) checkUnknownFields(b []byte, to interface{}) error {
+	if r.UnknownFieldHandler == nil {
+		return nil
+	}
+
+	v := reflect.ValueOf(to)
+	for v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Interface && !v.Elem().IsNil() {
+		v = v.Elem().Elem()
+	}
+	if v.Kind() != reflect.Ptr || !decodesStrictly(v.Type().Elem(), map[reflect.Type]bool{}) {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(reflect.New(v.Type().Elem()).Interface())
+	if err == nil {
+		return nil
+	}
+
+	// Other decoding errors are returned by the regular extraction.
+	const prefix = `json: unknown field "`
+	msg := err.Error()
+	if !strings.HasPrefix(msg, prefix) {
+		return nil
+	}
+
+	return r.UnknownFieldHandler(ErrUnknownField{
+		Field: strings.TrimSuffix(strings.TrimPrefix(msg, prefix), `"`),
+	})
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// decodesStrictly reports whether t can be checked for unknown fields. A
+// struct which embeds json.Unmarshalers without implementing it itself hides
+// the fields those handle from the decoder, so they would be reported as
+// unknown.
+func decodesStrictly(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return true
+	}
+	seen[t] = true
+
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return decodesStrictly(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Anonymous && reflect.PtrTo(f.Type).Implements(unmarshalerType) {
+				return false
+			}
+			if !decodesStrictly(f.Type, seen) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func (r Result) extractIntoPtr(to interface{}, label string) error {
 	if label == "" {
 		return r.ExtractInto(&to)
@@ -149,6 +256,10 @@ func (r Result) extractIntoPtr(to interface{}, label string) error {
 		}
 	}
 
+	if err := r.checkUnknownFields(b, to); err != nil {
+		return err
+	}
+
 	err = json.Unmarshal(b, &to)
 	return err
 }
@@ -411,6 +522,54 @@ func (jt *JSONRFC3339ZNoTNoZ) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// timestampLayouts are the timestamp formats accepted by ParseTimestamp.
+// Fractional seconds are accepted by all of them.
+var timestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05Z0700",
+	RFC3339NoZ,
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05Z0700",
+	RFC3339ZNoTNoZ,
+}
+
+// ParseTimestamp parses a timestamp in any of the formats used by OpenStack
+// services: with a "T" or a space between date and time, with or without
+// fractional seconds, and with a "Z", a numeric offset, or no time zone at
+// all. Timestamps without a time zone are interpreted as UTC.
+func ParseTimestamp(s string) (time.Time, error) {
+	var err error
+	for _, layout := range timestampLayouts {
+		var t time.Time
+		t, err = time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// JSONRFC3339Lenient is a time which accepts any of the timestamp formats
+// handled by ParseTimestamp. It is useful for fields whose format differs
+// between services or releases. Empty and null values result in a zero time.
+type JSONRFC3339Lenient time.Time
+
+func (jt *JSONRFC3339Lenient) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		return nil
+	}
+	t, err := ParseTimestamp(s)
+	if err != nil {
+		return err
+	}
+	*jt = JSONRFC3339Lenient(t)
+	return nil
+}
+
 /*
 Link is an internal type to be used in packages of collection resources that are
 paginated in a certain way.
@@ -446,3 +605,19 @@ func ExtractNextURL(links []Link) (string, error) {
 
 	return url, nil
 }
+
+/*
+ExtractPreviousURL is an internal function useful for packages of collection
+resources that are paginated in a certain way.
+
+It attempts to extract the "previous" URL from slice of Link structs, or
+"" if no such URL is present.
+*/
+func ExtractPreviousURL(links []Link) (string, error) {
+	for _, l := range links {
+		if l.Rel == "previous" {
+			return l.Href, nil
+		}
+	}
+	return "", nil
+}
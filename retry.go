@@ -0,0 +1,112 @@
+package gophercloud
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryOpts configures how a ProviderClient retries throttled requests, i.e.
+// requests answered with 429 Too Many Requests, or with 413 and a Retry-After
+// header as older services do. The delay before a retry is taken from the
+// Retry-After header of the response if present, and from Backoff otherwise.
+//
+// Requests with a RawBody which is not an io.Seeker are not retried, as their
+// body cannot be sent again.
+type RetryOpts struct {
+	// MaxRetries is the maximum number of times a request is retried.
+	MaxRetries int
+
+	// Backoff returns the delay before the given retry, starting at 1, when
+	// the response has no Retry-After header. Defaults to
+	// ExponentialBackoff(time.Second, time.Minute).
+	Backoff func(retry int) time.Duration
+
+	// MaxDelay caps the delay before a retry, including one requested by a
+	// Retry-After header. Zero means no cap.
+	MaxDelay time.Duration
+}
+
+// ExponentialBackoff returns a Backoff function for RetryOpts which doubles
+// the delay after every retry, starting at base and capped at max.
+func ExponentialBackoff(base, max time.Duration) func(retry int) time.Duration {
+	return func(retry int) time.Duration {
+		delay := base
+		for i := 1; i < retry && delay < max; i++ {
+			delay *= 2
+		}
+		if delay > max {
+			delay = max
+		}
+		return delay
+	}
+}
+
+// retryDelay reports whether a throttled request is retried, and after which
+// delay.
+func (opts *RetryOpts) retryDelay(resp *http.Response, options *RequestOpts, retries int) (time.Duration, bool) {
+	if opts == nil || retries >= opts.MaxRetries {
+		return 0, false
+	}
+	if options.RawBody != nil {
+		if _, ok := options.RawBody.(io.Seeker); !ok {
+			return 0, false
+		}
+	}
+
+	after, hasRetryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+	case resp.StatusCode == http.StatusRequestEntityTooLarge && hasRetryAfter:
+	default:
+		return 0, false
+	}
+
+	delay := after
+	if !hasRetryAfter {
+		backoff := opts.Backoff
+		if backoff == nil {
+			backoff = ExponentialBackoff(time.Second, time.Minute)
+		}
+		delay = backoff(retries + 1)
+	}
+	if opts.MaxDelay > 0 && delay > opts.MaxDelay {
+		delay = opts.MaxDelay
+	}
+	return delay, true
+}
+
+// rewindBody seeks a request body back to its start before the request is
+// sent again, and reports whether it succeeded. Bodies which are not an
+// io.Seeker, including none, are left as they are.
+func rewindBody(body io.Reader) bool {
+	seeker, ok := body.(io.Seeker)
+	if !ok {
+		return true
+	}
+	_, err := seeker.Seek(0, io.SeekStart)
+	return err == nil
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			seconds = 0
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
@@ -142,9 +142,13 @@ func (client *ServiceClient) setMicroversionHeader(opts *RequestOpts) {
 
 // Request carries out the HTTP operation for the service client
 func (client *ServiceClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	if options == nil {
+		options = new(RequestOpts)
+	}
+	options.serviceType = client.Type
 	if len(client.MoreHeaders) > 0 {
-		if options == nil {
-			options = new(RequestOpts)
+		if options.MoreHeaders == nil {
+			options.MoreHeaders = make(map[string]string)
 		}
 		for k, v := range client.MoreHeaders {
 			options.MoreHeaders[k] = v
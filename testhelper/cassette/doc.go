@@ -0,0 +1,49 @@
+/*
+Package cassette records the HTTP interactions of Gophercloud with a real
+cloud into fixture files, called cassettes, and replays them in tests. This
+allows fast, offline regression tests against realistic payloads.
+
+A Recorder is an http.RoundTripper. In ModeRecord, it sends requests to the
+cloud and records them along with their responses; Stop writes them to the
+cassette file. In ModeReplay, it answers requests from the cassette without
+any network access. ModeAuto replays if the cassette exists and records it
+otherwise.
+
+Credentials are removed from recorded interactions: the values of the
+headers in SensitiveHeaders, of the JSON fields in SensitiveFields and of the
+JSON paths in SensitivePaths, such as the token ids of the Identity service,
+are replaced with "REDACTED". Further sanitizing, e.g. of host names, can be
+done with Recorder.Filters.
+
+Example to Record and Replay a Test
+
+	func TestListServers(t *testing.T) {
+		rec, err := cassette.NewRecorder("fixtures/list_servers.json", cassette.ModeAuto, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := rec.Stop(); err != nil {
+				t.Fatal(err)
+			}
+		}()
+
+		provider, err := openstack.NewClient(authOpts.IdentityEndpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		provider.HTTPClient = http.Client{Transport: rec}
+
+		err = openstack.Authenticate(provider, authOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Use the provider as usual.
+	}
+
+When replaying, the authentication options are only sent to the recorder, so
+placeholder credentials can be used as long as the identity endpoint is the
+recorded one.
+*/
+package cassette
@@ -0,0 +1,213 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// Mode selects whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// ModeReplay answers requests from the cassette. Requests which were not
+	// recorded fail.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the cloud and records them. An existing
+	// cassette is overwritten.
+	ModeRecord
+
+	// ModeAuto replays the cassette if it exists, and records it otherwise.
+	ModeAuto
+)
+
+// ErrInteractionNotFound is returned in ModeReplay for a request which is
+// not in the cassette, or whose recorded interactions were all replayed.
+type ErrInteractionNotFound struct {
+	Method string
+	URL    string
+}
+
+func (e ErrInteractionNotFound) Error() string {
+	return fmt.Sprintf("No recorded interaction for %s %s", e.Method, e.URL)
+}
+
+// Recorder is an http.RoundTripper which records or replays interactions.
+// It is safe for concurrent use.
+type Recorder struct {
+	// Filters are applied to every recorded interaction after the
+	// credentials were redacted, e.g. to remove further data.
+	Filters []func(*Interaction)
+
+	// Matcher reports whether a recorded request matches a request in
+	// ModeReplay. body is the body of the request. By default, the method and
+	// URL are compared. Recorded interactions are replayed in order, and each
+	// only once.
+	Matcher func(req *http.Request, body string, recorded Request) bool
+
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mut      sync.Mutex
+	cassette *Cassette
+	replayed []bool
+}
+
+// NewRecorder creates a Recorder for the cassette file at path. transport
+// sends the requests in ModeRecord, and defaults to http.DefaultTransport.
+func NewRecorder(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if mode == ModeAuto {
+		mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			mode = ModeReplay
+		}
+	}
+
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: transport,
+		cassette:  new(Cassette),
+	}
+
+	if mode == ModeReplay {
+		c, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+		r.replayed = make([]bool, len(c.Interactions))
+	}
+
+	return r, nil
+}
+
+// Mode returns whether the Recorder records or replays. It is never
+// ModeAuto.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, body)
+	}
+	return r.record(req, body)
+}
+
+// Stop writes the recorded interactions to the cassette file in ModeRecord.
+// It does nothing in ModeReplay.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+func (r *Recorder) replay(req *http.Request, body string) (*http.Response, error) {
+	match := r.Matcher
+	if match == nil {
+		match = matchMethodAndURL
+	}
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !match(req, body, interaction.Request) {
+			continue
+		}
+		r.replayed[i] = true
+
+		resp := interaction.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+			StatusCode:    resp.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        cloneHeader(resp.Header),
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(resp.Body))),
+			ContentLength: int64(len(resp.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, ErrInteractionNotFound{Method: req.Method, URL: req.URL.String()}
+}
+
+func (r *Recorder) record(req *http.Request, body string) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: req.Header,
+			Body:   body,
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+			Body:       string(respBody),
+		},
+	}
+	sanitize(&interaction)
+	for _, filter := range r.Filters {
+		filter(&interaction)
+	}
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	return resp, nil
+}
+
+// readRequestBody reads the body of req and replaces it with an in-memory
+// copy, so that it can still be sent.
+func readRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+
+	b, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return string(b), nil
+}
+
+func matchMethodAndURL(req *http.Request, body string, recorded Request) bool {
+	return req.Method == recorded.Method && req.URL.String() == recorded.URL
+}
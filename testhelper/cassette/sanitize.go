@@ -0,0 +1,166 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Redacted replaces the values of sensitive headers and fields.
+const Redacted = "REDACTED"
+
+// SensitiveHeaders are the headers whose values are redacted from recorded
+// requests and responses.
+var SensitiveHeaders = []string{
+	"Authorization",
+	"X-Account-Meta-Temp-Url-Key",
+	"X-Account-Meta-Temp-Url-Key-2",
+	"X-Auth-Token",
+	"X-Container-Meta-Temp-Url-Key",
+	"X-Container-Meta-Temp-Url-Key-2",
+	"X-Service-Token",
+	"X-Subject-Token",
+}
+
+// SensitiveFields are the JSON fields whose values are redacted from recorded
+// request and response bodies, at any depth.
+var SensitiveFields = []string{
+	"adminPass",
+	"passcode",
+	"password",
+	"payload",
+	"private_key",
+	"secret",
+}
+
+// SensitivePaths are the JSON fields whose values are redacted from recorded
+// request and response bodies, given by their dot-separated path from the
+// root of the body. They cover fields such as the token ids of the Identity
+// service, whose names are too common to be redacted at any depth.
+var SensitivePaths = []string{
+	// Identity v2 token responses and token authentication requests.
+	"access.token.id",
+	"auth.token.id",
+	// Identity v3 token authentication requests.
+	"auth.identity.token.id",
+}
+
+// sanitize redacts the credentials from an interaction.
+func sanitize(i *Interaction) {
+	i.Request.Header = sanitizeHeader(i.Request.Header)
+	i.Response.Header = sanitizeHeader(i.Response.Header)
+	i.Request.Body = sanitizeBody(i.Request.Body)
+	i.Response.Body = sanitizeBody(i.Response.Body)
+}
+
+func sanitizeHeader(h http.Header) http.Header {
+	h = cloneHeader(h)
+	for _, name := range SensitiveHeaders {
+		if _, ok := h[http.CanonicalHeaderKey(name)]; ok {
+			h.Set(name, Redacted)
+		}
+	}
+	return h
+}
+
+// cloneHeader returns a deep copy of h, or nil if h is nil. It replaces
+// http.Header.Clone, which needs Go 1.13.
+func cloneHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	h2 := make(http.Header, len(h))
+	for k, v := range h {
+		h2[k] = append([]string(nil), v...)
+	}
+	return h2
+}
+
+// sanitizeBody redacts the sensitive fields of a JSON body. Other bodies are
+// returned unchanged.
+func sanitizeBody(body string) string {
+	if body == "" {
+		return body
+	}
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(body)))
+	dec.UseNumber()
+	var data interface{}
+	if err := dec.Decode(&data); err != nil {
+		return body
+	}
+	redacted := redactFields(data)
+	for _, path := range SensitivePaths {
+		if redactPath(data, strings.Split(path, ".")) {
+			redacted = true
+		}
+	}
+	if !redacted {
+		return body
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return body
+	}
+	return string(b)
+}
+
+// redactFields redacts the sensitive fields of a decoded JSON value and
+// reports whether any were found.
+func redactFields(data interface{}) bool {
+	redacted := false
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveField(key) {
+				if _, ok := value.(string); ok {
+					v[key] = Redacted
+					redacted = true
+					continue
+				}
+			}
+			if redactFields(value) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if redactFields(value) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+// redactPath redacts the string value at the given path of a decoded JSON
+// value and reports whether it was found.
+func redactPath(data interface{}, path []string) bool {
+	m, ok := data.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	value, ok := m[path[0]]
+	if !ok {
+		return false
+	}
+	if len(path) > 1 {
+		return redactPath(value, path[1:])
+	}
+	if _, ok := value.(string); !ok {
+		return false
+	}
+	m[path[0]] = Redacted
+	return true
+}
+
+func isSensitiveField(key string) bool {
+	for _, field := range SensitiveFields {
+		if key == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/cassette"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func handleServer() {
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "real-token")
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"server": {"id": "1234", "adminPass": "s3cr3t"}}`)
+		default:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"servers": [{"id": "1234"}]}`)
+		}
+	})
+}
+
+func useRecorder(t *testing.T, rec *cassette.Recorder, endpoint string) (created, listed map[string]interface{}) {
+	sc := client.ServiceClient()
+	sc.Endpoint = endpoint
+	sc.HTTPClient = http.Client{Transport: rec}
+
+	_, err := sc.Post(sc.ServiceURL("servers"), map[string]interface{}{
+		"server": map[string]string{"name": "web", "adminPass": "s3cr3t"},
+	}, &created, nil)
+	th.AssertNoErr(t, err)
+
+	_, err = sc.Get(sc.ServiceURL("servers"), &listed, nil)
+	th.AssertNoErr(t, err)
+	return
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fixtures", "servers.json")
+
+	th.SetupHTTP()
+	handleServer()
+	endpoint := th.Endpoint()
+
+	rec, err := cassette.NewRecorder(path, cassette.ModeAuto, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, cassette.ModeRecord, rec.Mode())
+
+	recordedCreate, recordedList := useRecorder(t, rec, endpoint)
+	th.AssertNoErr(t, rec.Stop())
+	th.TeardownHTTP()
+
+	// The caller sees the real response.
+	th.CheckEquals(t, "s3cr3t", recordedCreate["server"].(map[string]interface{})["adminPass"])
+
+	// The cassette does not contain credentials.
+	b, err := ioutil.ReadFile(path)
+	th.AssertNoErr(t, err)
+	for _, secret := range []string{"s3cr3t", "real-token", client.TokenID} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("Expected %q to be redacted from the cassette", secret)
+		}
+	}
+
+	c, err := cassette.Load(path)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(c.Interactions))
+	th.CheckEquals(t, "POST", c.Interactions[0].Request.Method)
+	th.CheckEquals(t, cassette.Redacted, c.Interactions[0].Request.Header.Get("X-Auth-Token"))
+	th.CheckEquals(t, http.StatusAccepted, c.Interactions[0].Response.StatusCode)
+
+	// Replay with the server gone.
+	rec, err = cassette.NewRecorder(path, cassette.ModeAuto, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, cassette.ModeReplay, rec.Mode())
+
+	replayedCreate, replayedList := useRecorder(t, rec, endpoint)
+	th.CheckEquals(t, cassette.Redacted, replayedCreate["server"].(map[string]interface{})["adminPass"])
+	th.CheckDeepEquals(t, recordedList, replayedList)
+
+	// Each interaction is replayed once.
+	sc := client.ServiceClient()
+	sc.Endpoint = endpoint
+	sc.HTTPClient = http.Client{Transport: rec}
+	_, err = sc.Get(sc.ServiceURL("servers"), nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "No recorded interaction for GET") {
+		t.Errorf("Expected ErrInteractionNotFound, got %v", err)
+	}
+	th.AssertNoErr(t, rec.Stop())
+}
+
+func TestRecordTokens(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tokens.json")
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access": {"token": {"id": "v2-token-id", "expires": "2030-01-01T00:00:00Z"}, "user": {"id": "1234"}}}`)
+	})
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "v3-new-token-id")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"token": {"methods": ["token"], "user": {"id": "1234"}}}`)
+	})
+
+	rec, err := cassette.NewRecorder(path, cassette.ModeRecord, nil)
+	th.AssertNoErr(t, err)
+
+	sc := client.ServiceClient()
+	sc.Endpoint = th.Endpoint()
+	sc.HTTPClient = http.Client{Transport: rec}
+
+	var v2 map[string]interface{}
+	_, err = sc.Post(sc.ServiceURL("v2.0", "tokens"), map[string]interface{}{
+		"auth": map[string]interface{}{
+			"passwordCredentials": map[string]string{"username": "admin", "password": "v2-password"},
+		},
+	}, &v2, &gophercloud.RequestOpts{OkCodes: []int{200}})
+	th.AssertNoErr(t, err)
+
+	_, err = sc.Post(sc.ServiceURL("v3", "auth", "tokens"), map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"token"},
+				"token":   map[string]string{"id": "v3-token-id"},
+			},
+		},
+	}, nil, &gophercloud.RequestOpts{OkCodes: []int{201}})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, rec.Stop())
+
+	b, err := ioutil.ReadFile(path)
+	th.AssertNoErr(t, err)
+	for _, secret := range []string{"v2-token-id", "v2-password", "v3-token-id", "v3-new-token-id"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("Expected %q to be redacted from the cassette", secret)
+		}
+	}
+
+	// Other ids are kept.
+	c, err := cassette.Load(path)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, strings.Contains(c.Interactions[0].Response.Body, `"1234"`))
+}
+
+func TestRecorderFilters(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "servers.json")
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleServer()
+
+	rec, err := cassette.NewRecorder(path, cassette.ModeRecord, nil)
+	th.AssertNoErr(t, err)
+	rec.Filters = append(rec.Filters, func(i *cassette.Interaction) {
+		i.Request.URL = strings.Replace(i.Request.URL, th.Endpoint(), "https://compute.example.com/", 1)
+	})
+
+	useRecorder(t, rec, th.Endpoint())
+	th.AssertNoErr(t, rec.Stop())
+
+	c, err := cassette.Load(path)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://compute.example.com/servers", c.Interactions[1].Request.URL)
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = cassette.NewRecorder(filepath.Join(dir, "missing.json"), cassette.ModeReplay, nil)
+	if err == nil {
+		t.Errorf("Expected an error for a missing cassette")
+	}
+}
+
+var _ http.RoundTripper = (*cassette.Recorder)(nil)
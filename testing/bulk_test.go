@@ -0,0 +1,112 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func bulkIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("id-%d", i)
+	}
+	return ids
+}
+
+func TestRunBulk(t *testing.T) {
+	var mut sync.Mutex
+	var running, maxRunning int
+	done := make(map[string]bool)
+
+	err := gophercloud.RunBulk(gophercloud.BulkOpts{Concurrency: 3}, bulkIDs(20), func(id string) error {
+		mut.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mut.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mut.Lock()
+		running--
+		done[id] = true
+		mut.Unlock()
+		return nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 20, len(done))
+	th.AssertEquals(t, true, maxRunning <= 3)
+}
+
+func TestRunBulkRetries(t *testing.T) {
+	var mut sync.Mutex
+	attempts := make(map[string]int)
+	errFlaky := errors.New("flaky")
+	errFatal := errors.New("fatal")
+
+	opts := gophercloud.BulkOpts{
+		Retries:       2,
+		RetryInterval: time.Millisecond,
+		IsRetryable: func(err error) bool {
+			return err == errFlaky
+		},
+	}
+
+	err := gophercloud.RunBulk(opts, []string{"ok", "flaky", "broken", "fatal"}, func(id string) error {
+		mut.Lock()
+		attempts[id]++
+		attempt := attempts[id]
+		mut.Unlock()
+
+		switch {
+		case id == "flaky" && attempt < 3:
+			return errFlaky
+		case id == "broken":
+			return errFlaky
+		case id == "fatal":
+			return errFatal
+		}
+		return nil
+	})
+
+	bulkErr, ok := err.(gophercloud.ErrBulk)
+	if !ok {
+		t.Fatalf("Expected an ErrBulk, got %v", err)
+	}
+	th.AssertEquals(t, 4, bulkErr.Total)
+	th.AssertDeepEquals(t, []gophercloud.BulkItemError{
+		{ID: "broken", Err: errFlaky},
+		{ID: "fatal", Err: errFatal},
+	}, bulkErr.Errors)
+	th.AssertEquals(t, "2 of 4 operations failed: [broken]: flaky; [fatal]: fatal", err.Error())
+
+	th.AssertDeepEquals(t, map[string]int{"ok": 1, "flaky": 3, "broken": 3, "fatal": 1}, attempts)
+}
+
+func TestRunBulkCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := gophercloud.RunBulk(gophercloud.BulkOpts{Context: ctx, Concurrency: 1}, bulkIDs(5), func(id string) error {
+		if id == "id-1" {
+			cancel()
+		}
+		return nil
+	})
+
+	bulkErr, ok := err.(gophercloud.ErrBulk)
+	if !ok {
+		t.Fatalf("Expected an ErrBulk, got %v", err)
+	}
+	th.AssertEquals(t, 3, len(bulkErr.Errors))
+	for _, item := range bulkErr.Errors {
+		th.AssertEquals(t, context.Canceled, item.Err)
+	}
+}
@@ -0,0 +1,223 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func handleCounted(path string, counter *int) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		*counter++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"count": %d}`, *counter)
+	})
+}
+
+func getCount(t *testing.T, sc *gophercloud.ServiceClient, url string, opts *gophercloud.RequestOpts) int {
+	var body struct {
+		Count int `json:"count"`
+	}
+	_, err := sc.Get(url, &body, opts)
+	th.AssertNoErr(t, err)
+	return body.Count
+}
+
+func TestResponseCache(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var flavors, servers int
+	handleCounted("/flavors/detail", &flavors)
+	handleCounted("/servers", &servers)
+
+	cache := gophercloud.NewResponseCache(0)
+	cache.SetTTL("/flavors", time.Hour)
+
+	sc := client.ServiceClient()
+	sc.Cache = cache
+
+	th.CheckEquals(t, 1, getCount(t, sc, sc.ServiceURL("flavors", "detail"), nil))
+	th.CheckEquals(t, 1, getCount(t, sc, sc.ServiceURL("flavors", "detail"), nil))
+	th.CheckEquals(t, 1, flavors)
+
+	// Other URLs, query strings and headers are cached separately.
+	th.CheckEquals(t, 2, getCount(t, sc, sc.ServiceURL("flavors", "detail")+"?minRam=512", nil))
+	th.CheckEquals(t, 3, getCount(t, sc, sc.ServiceURL("flavors", "detail"), &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{"X-OpenStack-Nova-API-Version": "2.61"},
+	}))
+	th.CheckEquals(t, 3, cache.Len())
+
+	// URLs without a TTL are not cached.
+	th.CheckEquals(t, 1, getCount(t, sc, sc.ServiceURL("servers"), nil))
+	th.CheckEquals(t, 2, getCount(t, sc, sc.ServiceURL("servers"), nil))
+
+	cache.Invalidate(sc.ServiceURL("flavors"))
+	th.CheckEquals(t, 0, cache.Len())
+	th.CheckEquals(t, 4, getCount(t, sc, sc.ServiceURL("flavors", "detail"), nil))
+
+	cache.Purge()
+	th.CheckEquals(t, 5, getCount(t, sc, sc.ServiceURL("flavors", "detail"), nil))
+}
+
+func TestResponseCacheExpiry(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var images int
+	handleCounted("/images", &images)
+
+	cache := gophercloud.NewResponseCache(time.Millisecond)
+	cache.SetTTL("/flavors", time.Hour)
+
+	sc := client.ServiceClient()
+	sc.Cache = cache
+
+	th.CheckEquals(t, 1, getCount(t, sc, sc.ServiceURL("images"), nil))
+	time.Sleep(5 * time.Millisecond)
+	th.CheckEquals(t, 2, getCount(t, sc, sc.ServiceURL("images"), nil))
+}
+
+func TestResponseCacheMaxEntries(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var networks int
+	handleCounted("/networks", &networks)
+
+	cache := gophercloud.NewResponseCache(time.Hour)
+	cache.MaxEntries = 2
+
+	sc := client.ServiceClient()
+	sc.Cache = cache
+
+	for i := 0; i < 3; i++ {
+		getCount(t, sc, fmt.Sprintf("%s?page=%d", sc.ServiceURL("networks"), i), nil)
+	}
+	th.CheckEquals(t, 2, cache.Len())
+
+	// The first page expired first, so it was evicted.
+	th.CheckEquals(t, 4, getCount(t, sc, sc.ServiceURL("networks")+"?page=0", nil))
+}
+
+func TestResponseCacheSkipsErrors(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	cache := gophercloud.NewResponseCache(time.Hour)
+	sc := client.ServiceClient()
+	sc.Cache = cache
+
+	_, err := sc.Get(sc.ServiceURL("flavors", "missing"), nil, nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	th.CheckEquals(t, 0, cache.Len())
+}
+
+func TestResponseCacheSeparatesTokens(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var flavors int
+	handleCounted("/flavors", &flavors)
+
+	cache := gophercloud.NewResponseCache(time.Hour)
+
+	sc := client.ServiceClient()
+	sc.Cache = cache
+	other := client.ServiceClient()
+	other.TokenID = "another-project-token"
+	other.Cache = cache
+
+	th.CheckEquals(t, 1, getCount(t, sc, sc.ServiceURL("flavors"), nil))
+	th.CheckEquals(t, 2, getCount(t, other, other.ServiceURL("flavors"), nil))
+	th.CheckEquals(t, 1, getCount(t, sc, sc.ServiceURL("flavors"), nil))
+	th.CheckEquals(t, 2, getCount(t, other, other.ServiceURL("flavors"), nil))
+}
+
+func TestResponseCacheMatchesSegments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var images, image, file, oldImages int
+	handleCounted("/images", &images)
+	handleCounted("/images/1", &image)
+	handleCounted("/images/1/file", &file)
+	handleCounted("/old-images", &oldImages)
+
+	cache := gophercloud.NewResponseCache(0)
+	cache.SetTTL("/images", time.Hour)
+
+	sc := client.ServiceClient()
+	sc.Cache = cache
+
+	for i := 0; i < 2; i++ {
+		getCount(t, sc, sc.ServiceURL("images"), nil)
+		getCount(t, sc, sc.ServiceURL("images", "1"), nil)
+		getCount(t, sc, sc.ServiceURL("images", "1", "file"), nil)
+		getCount(t, sc, sc.ServiceURL("old-images"), nil)
+	}
+	th.CheckEquals(t, 1, images)
+	th.CheckEquals(t, 1, image)
+	th.CheckEquals(t, 2, file)
+	th.CheckEquals(t, 2, oldImages)
+}
+
+func TestResponseCacheSkipsLargeAndNonJSON(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var files int
+	th.Mux.HandleFunc("/files", func(w http.ResponseWriter, r *http.Request) {
+		files++
+		w.Header().Set("Content-Type", "application/octet-stream")
+		fmt.Fprint(w, "data")
+	})
+	large := `{"items": ["` + strings.Repeat("x", 100) + `"]}`
+	var lists int
+	th.Mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		lists++
+		w.Header().Set("Content-Type", "application/json")
+		// Stream the body, so that its length is not known upfront.
+		w.(http.Flusher).Flush()
+		fmt.Fprint(w, large)
+	})
+
+	cache := gophercloud.NewResponseCache(time.Hour)
+	cache.MaxBodySize = 64
+
+	sc := client.ServiceClient()
+	sc.Cache = cache
+
+	for i := 0; i < 2; i++ {
+		resp, err := sc.Get(sc.ServiceURL("files"), nil, nil)
+		th.AssertNoErr(t, err)
+		b, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, "data", string(b))
+
+		resp, err = sc.Get(sc.ServiceURL("large"), nil, nil)
+		th.AssertNoErr(t, err)
+		b, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, large, string(b))
+	}
+	th.CheckEquals(t, 2, files)
+	th.CheckEquals(t, 2, lists)
+	th.CheckEquals(t, 0, cache.Len())
+}
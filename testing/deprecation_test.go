@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestParseDeprecationNotice(t *testing.T) {
+	h := http.Header{}
+	h.Add("Warning", `299 - "Deprecated API" "Wed, 21 Oct 2015 07:28:00 GMT", 199 nova "Use \"v2.1\" instead"`)
+	h.Add("Warning", `not a warning`)
+	h.Set("Deprecation", "@1688169599")
+	h.Set("Sunset", "Sat, 31 Dec 2033 23:59:59 GMT")
+	h.Set("Link", `<https://example.com/docs>; rel="alternate", <https://example.com/deprecation>; rel="deprecation"`)
+
+	notice, ok := gophercloud.ParseDeprecationNotice(h)
+	th.AssertEquals(t, true, ok)
+
+	expected := gophercloud.DeprecationNotice{
+		Warnings:     []string{"Deprecated API", `Use "v2.1" instead`, "not a warning"},
+		Deprecated:   true,
+		DeprecatedAt: time.Date(2023, 6, 30, 23, 59, 59, 0, time.UTC),
+		Sunset:       time.Date(2033, 12, 31, 23, 59, 59, 0, time.UTC),
+		Link:         "https://example.com/deprecation",
+	}
+	th.CheckDeepEquals(t, expected, notice)
+
+	_, ok = gophercloud.ParseDeprecationNotice(http.Header{"Content-Type": {"application/json"}})
+	th.CheckEquals(t, false, ok)
+
+	notice, ok = gophercloud.ParseDeprecationNotice(http.Header{"Deprecation": {"true"}})
+	th.CheckEquals(t, true, ok)
+	th.CheckEquals(t, true, notice.DeprecatedAt.IsZero())
+}
+
+func TestDeprecationHandler(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-floating-ips", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Sunset", "Sat, 31 Dec 2033 23:59:59 GMT")
+		w.WriteHeader(http.StatusOK)
+	})
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var notices []gophercloud.DeprecationNotice
+	sc := client.ServiceClient()
+	sc.DeprecationHandler = func(n gophercloud.DeprecationNotice) {
+		notices = append(notices, n)
+	}
+
+	_, err := sc.Get(sc.ServiceURL("servers"), nil, nil)
+	th.AssertNoErr(t, err)
+	resp, err := sc.Get(sc.ServiceURL("os-floating-ips"), nil, nil)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(notices))
+	th.CheckEquals(t, "GET", notices[0].Method)
+	th.CheckEquals(t, sc.ServiceURL("os-floating-ips"), notices[0].URL)
+	th.CheckEquals(t, 2033, notices[0].Sunset.Year())
+
+	r := gophercloud.Result{Header: resp.Header}
+	notice, ok := r.Deprecation()
+	th.CheckEquals(t, true, ok)
+	th.CheckEquals(t, true, notice.Deprecated)
+}
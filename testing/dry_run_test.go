@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestDryRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	sent := map[string]int{}
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		sent[r.Method]++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"servers": []}`))
+	})
+
+	plan := new(gophercloud.RequestPlan)
+	sc := client.ServiceClient()
+	sc.DryRun = plan.Record
+	sc.MoreHeaders = map[string]string{"X-OpenStack-Nova-API-Version": "2.26"}
+
+	var list map[string]interface{}
+	_, err := sc.Get(sc.ServiceURL("servers"), &list, nil)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, map[string]interface{}{"servers": []interface{}{}}, list)
+
+	var created map[string]interface{}
+	resp, err := sc.Post(sc.ServiceURL("servers"), map[string]interface{}{"server": map[string]string{"name": "web"}}, &created, nil)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, http.StatusCreated, resp.StatusCode)
+	th.CheckDeepEquals(t, map[string]interface{}{}, created)
+
+	resp, err = sc.Delete(sc.ServiceURL("servers", "1234"), &gophercloud.RequestOpts{OkCodes: []int{204}})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, http.StatusNoContent, resp.StatusCode)
+
+	th.CheckDeepEquals(t, map[string]int{"GET": 1}, sent)
+
+	requests := plan.Requests()
+	th.AssertEquals(t, 2, len(requests))
+
+	th.CheckEquals(t, "POST", requests[0].Method)
+	th.CheckEquals(t, sc.ServiceURL("servers"), requests[0].URL)
+	th.CheckEquals(t, `{"server":{"name":"web"}}`, string(requests[0].Body))
+	th.CheckEquals(t, "2.26", requests[0].Header.Get("X-OpenStack-Nova-API-Version"))
+	th.CheckEquals(t, "", requests[0].Header.Get("X-Auth-Token"))
+
+	th.CheckEquals(t, "DELETE", requests[1].Method)
+	th.CheckEquals(t, sc.ServiceURL("servers", "1234"), requests[1].URL)
+	th.CheckEquals(t, true, requests[1].Body == nil)
+}
+
+func TestDryRunIgnored(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	plan := new(gophercloud.RequestPlan)
+	sc := client.ServiceClient()
+	sc.DryRun = plan.Record
+
+	_, err := sc.Post(sc.ServiceURL("auth", "tokens"), map[string]string{}, nil, &gophercloud.RequestOpts{
+		IgnoreDryRun: true,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(plan.Requests()))
+}
+
+func TestDryRunLogsBodyAtDebug(t *testing.T) {
+	var info, debug bytes.Buffer
+	sc := client.ServiceClient()
+	sc.DryRun = new(gophercloud.RequestPlan).Record
+	sc.Logger = gophercloud.LoggerFunc(func(level gophercloud.LogLevel, msg string, keysAndValues ...interface{}) {
+		gophercloud.NewStdLogger(log.New(&info, "", 0), gophercloud.LogInfo).Log(level, msg, keysAndValues...)
+		gophercloud.NewStdLogger(log.New(&debug, "", 0), gophercloud.LogDebug).Log(level, msg, keysAndValues...)
+	})
+
+	_, err := sc.Post(sc.ServiceURL("users"), map[string]interface{}{
+		"user": map[string]string{"name": "admin", "password": "s3cr3t"},
+	}, nil, nil)
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, true, strings.Contains(info.String(), "dry run, request not sent"))
+	th.CheckEquals(t, false, strings.Contains(info.String(), "s3cr3t"))
+	th.CheckEquals(t, true, strings.Contains(debug.String(), "s3cr3t"))
+}
@@ -22,3 +22,24 @@ func TestGetResponseCode(t *testing.T) {
 	th.AssertEquals(t, true, ok)
 	th.AssertEquals(t, err.GetStatusCode(), 404)
 }
+
+func TestUnwrapResponseCode(t *testing.T) {
+	respErr := gophercloud.ErrUnexpectedResponseCode{
+		URL:      "http://example.com",
+		Method:   "GET",
+		Expected: []int{200},
+		Actual:   401,
+	}
+
+	err := &gophercloud.ErrErrorAfterReauthentication{
+		ErrOriginal: gophercloud.ErrDefault401{ErrUnexpectedResponseCode: respErr},
+	}
+
+	err401, ok := err.Unwrap().(gophercloud.ErrDefault401)
+	th.AssertEquals(t, true, ok)
+
+	unexpected, ok := err401.Unwrap().(gophercloud.ErrUnexpectedResponseCode)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 401, unexpected.Actual)
+	th.AssertEquals(t, "http://example.com", unexpected.URL)
+}
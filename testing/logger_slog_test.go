@@ -0,0 +1,31 @@
+//go:build go1.21
+// +build go1.21
+
+package testing
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	logger := gophercloud.NewSlogLogger(slog.New(handler))
+
+	logger.Log(gophercloud.LogDebug, "hidden")
+	logger.Log(gophercloud.LogError, "re-authentication failed", "error", "boom")
+
+	th.CheckEquals(t, "level=ERROR msg=\"re-authentication failed\" error=boom\n", buf.String())
+}
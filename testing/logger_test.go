@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+type logEntry struct {
+	Level gophercloud.LogLevel
+	Msg   string
+}
+
+type recordingLogger struct {
+	mut     sync.Mutex
+	entries []logEntry
+}
+
+func (l *recordingLogger) Log(level gophercloud.LogLevel, msg string, keysAndValues ...interface{}) {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+	l.entries = append(l.entries, logEntry{level, msg})
+}
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := gophercloud.NewStdLogger(log.New(&buf, "", 0), gophercloud.LogInfo)
+
+	logger.Log(gophercloud.LogDebug, "hidden")
+	logger.Log(gophercloud.LogWarn, "retrying", "id", "a", "attempt", 2, "dangling")
+
+	th.CheckEquals(t, "[WARN] retrying id=a attempt=2 dangling\n", buf.String())
+}
+
+func TestProviderClientLogger(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	logger := new(recordingLogger)
+	p := new(gophercloud.ProviderClient)
+	p.Logger = logger
+	p.SetToken(client.TokenID)
+	p.ReauthFunc = func() error {
+		p.SetToken("new-token")
+		return nil
+	}
+
+	_, err := p.Request("GET", th.Endpoint()+"/route", &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+
+	expected := []logEntry{
+		{gophercloud.LogDebug, "request"},
+		{gophercloud.LogInfo, "token rejected, re-authenticating before retrying the request"},
+		{gophercloud.LogInfo, "re-authenticating"},
+		{gophercloud.LogDebug, "request"},
+	}
+	th.CheckDeepEquals(t, expected, logger.entries)
+}
+
+func TestRunBulkLogger(t *testing.T) {
+	logger := new(recordingLogger)
+	opts := gophercloud.BulkOpts{Retries: 2, Logger: logger}
+
+	err := gophercloud.RunBulk(opts, []string{"a"}, func(id string) error {
+		return errors.New("busy")
+	})
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	th.CheckEquals(t, 2, len(logger.entries))
+	for _, entry := range logger.entries {
+		th.CheckEquals(t, gophercloud.LogWarn, entry.Level)
+	}
+}
+
+func TestLogLevelString(t *testing.T) {
+	th.CheckEquals(t, "DEBUG", gophercloud.LogDebug.String())
+	th.CheckEquals(t, "ERROR", gophercloud.LogError.String())
+	th.CheckEquals(t, true, strings.HasPrefix(gophercloud.LogLevel(7).String(), "LEVEL"))
+}
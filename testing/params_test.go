@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"fmt"
 	"net/url"
 	"reflect"
 	"testing"
@@ -88,6 +89,88 @@ func TestBuildQueryString(t *testing.T) {
 	}
 }
 
+func TestBuildQueryStringTypes(t *testing.T) {
+	type status string
+	since := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	opts := struct {
+		Size    int64                 `q:"size"`
+		Ratio   float64               `q:"ratio"`
+		Flags   []bool                `q:"flag"`
+		Status  []status              `q:"status"`
+		Since   *time.Time            `q:"since"`
+		Before  time.Time             `q:"before" format:"2006-01-02"`
+		Created gophercloud.TimeRange `q:"created_at"`
+		Updated gophercloud.TimeRange `q:"updated_at"`
+		M       map[string]string     `q:"m"`
+		Skipped string                `q:"-"`
+		Unset   *int                  `q:"unset"`
+	}{
+		Size:    1 << 40,
+		Ratio:   0.5,
+		Flags:   []bool{true, false},
+		Status:  []status{"active", "error"},
+		Since:   &since,
+		Before:  since,
+		Created: gophercloud.TimeRange{Start: since, End: since.Add(time.Hour)},
+		Updated: gophercloud.TimeRange{End: since},
+		M:       map[string]string{"k2": "v2", "k1": "v1"},
+		Skipped: "skipped",
+	}
+
+	actual, err := gophercloud.BuildQueryString(opts)
+	th.AssertNoErr(t, err)
+
+	expected := url.Values{
+		"size":       {"1099511627776"},
+		"ratio":      {"0.5"},
+		"flag":       {"true", "false"},
+		"status":     {"active", "error"},
+		"since":      {"2020-01-02T03:04:05Z"},
+		"before":     {"2020-01-02"},
+		"created_at": {"gte:2020-01-02T03:04:05Z", "lt:2020-01-02T04:04:05Z"},
+		"updated_at": {"lt:2020-01-02T03:04:05Z"},
+		"m":          {"{'k1':'v1', 'k2':'v2'}"},
+	}
+	th.CheckDeepEquals(t, expected, actual.Query())
+}
+
+type queryRange [2]int
+
+func (r queryRange) MarshalQuery() ([]string, error) {
+	if r[0] > r[1] {
+		return nil, fmt.Errorf("invalid range %d-%d", r[0], r[1])
+	}
+	return []string{fmt.Sprintf("%d-%d", r[0], r[1])}, nil
+}
+
+func TestBuildQueryStringMarshaler(t *testing.T) {
+	opts := struct {
+		Range queryRange `q:"range"`
+	}{
+		Range: queryRange{1, 5},
+	}
+
+	actual, err := gophercloud.BuildQueryString(opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "range=1-5", actual.RawQuery)
+
+	opts.Range = queryRange{5, 1}
+	_, err = gophercloud.BuildQueryString(opts)
+	if err == nil {
+		t.Errorf("Expected error from MarshalQuery")
+	}
+
+	unsupported := struct {
+		C []complex64 `q:"c"`
+	}{
+		C: []complex64{1},
+	}
+	_, err = gophercloud.BuildQueryString(unsupported)
+	if err == nil {
+		t.Errorf("Expected error for an unsupported type")
+	}
+}
+
 func TestBuildHeaders(t *testing.T) {
 	testStruct := struct {
 		Accept string `h:"Accept"`
@@ -274,3 +357,44 @@ func TestBuildRequestBody(t *testing.T) {
 	th.AssertDeepEquals(t, expectedComplexFields, actual)
 
 }
+
+func TestPointerHelpers(t *testing.T) {
+	th.AssertEquals(t, "", *gophercloud.String(""))
+	th.AssertEquals(t, "foo", *gophercloud.String("foo"))
+	th.AssertEquals(t, 0, *gophercloud.Int(0))
+	th.AssertEquals(t, 42, *gophercloud.Int(42))
+	th.AssertEquals(t, false, *gophercloud.Bool(false))
+	th.AssertEquals(t, true, *gophercloud.Bool(true))
+}
+
+func TestBuildRequestBodyNullable(t *testing.T) {
+	type UpdateOpts struct {
+		Name        *string               `json:"name,omitempty"`
+		Description *gophercloud.Nullable `json:"description,omitempty"`
+		Size        *gophercloud.Nullable `json:"size,omitempty"`
+		Port        *gophercloud.Nullable `json:"port,omitempty"`
+	}
+
+	opts := UpdateOpts{
+		Name:        gophercloud.String(""),
+		Description: gophercloud.Null(),
+		Size:        gophercloud.NullableValue(0),
+	}
+
+	expected := map[string]interface{}{
+		"resource": map[string]interface{}{
+			"name":        "",
+			"description": nil,
+			"size":        float64(0),
+		},
+	}
+
+	actual, err := gophercloud.BuildRequestBody(opts, "resource")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expected, actual)
+
+	th.AssertEquals(t, true, gophercloud.Null().IsNull())
+	th.AssertEquals(t, true, gophercloud.NullableValue(nil).IsNull())
+	th.AssertEquals(t, false, gophercloud.NullableValue("").IsNull())
+	th.AssertEquals(t, "foo", gophercloud.NullableValue("foo").Value())
+}
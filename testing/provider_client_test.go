@@ -3,9 +3,11 @@ package testing
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"sync"
 	"testing"
@@ -387,3 +389,62 @@ func TestRequestWithContext(t *testing.T) {
 		t.Fatalf("expecting error to contain: %q, got %q", ctx.Err().Error(), err.Error())
 	}
 }
+
+func TestRequestOptsContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "OK")
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{Context: context.Background()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{Context: ctx})
+	if urlErr, ok := err.(*url.Error); !ok || urlErr.Err != context.Canceled {
+		t.Fatalf("expecting %v, got %v", context.Canceled, err)
+	}
+
+	res, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	res.Body.Close()
+}
+
+func TestRequestStreamedBody(t *testing.T) {
+	var contentLength int64
+	var transferEncoding []string
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentLength = r.ContentLength
+		transferEncoding = r.TransferEncoding
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{}
+
+	// A reader of unknown length, which net/http would send chunked.
+	content := struct{ io.Reader }{strings.NewReader("hello")}
+	_, err := p.Request("PUT", ts.URL, &gophercloud.RequestOpts{
+		RawBody:     content,
+		MoreHeaders: map[string]string{"Content-Length": "5"},
+		OkCodes:     []int{201},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(5), contentLength)
+	th.AssertEquals(t, 0, len(transferEncoding))
+	th.AssertEquals(t, "hello", body)
+
+	_, err = p.Request("PUT", ts.URL, &gophercloud.RequestOpts{
+		RawBody:     strings.NewReader("world"),
+		MoreHeaders: map[string]string{"Transfer-Encoding": "chunked"},
+		OkCodes:     []int{201},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(-1), contentLength)
+	th.AssertDeepEquals(t, []string{"chunked"}, transferEncoding)
+	th.AssertEquals(t, "world", body)
+}
@@ -2,7 +2,10 @@ package testing
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -206,3 +209,193 @@ func TestUnmarshalSliceOfNamedStructs(t *testing.T) {
 	th.AssertEquals(t, "", actual[1].TestPerson.Name)
 	th.AssertEquals(t, "", actual[1].TestPersonExt.Location)
 }
+
+type TestPlainPerson struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// TestUnknownFieldHandler tests that strict decoding reports fields which
+// the result type does not know.
+func TestUnknownFieldHandler(t *testing.T) {
+	var dejson interface{}
+	err := json.Unmarshal([]byte(singleResponse), &dejson)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var unknown []string
+	var singleResult = gophercloud.Result{
+		Body: dejson,
+		UnknownFieldHandler: func(err gophercloud.ErrUnknownField) error {
+			unknown = append(unknown, err.Field)
+			return nil
+		},
+	}
+
+	var s struct {
+		Person TestPlainPerson `json:"person"`
+	}
+	err = singleResult.ExtractInto(&s)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Bill", s.Person.Name)
+
+	var actual TestPlainPerson
+	err = singleResult.ExtractIntoStructPtr(&actual, "person")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Bill", actual.Name)
+
+	th.AssertDeepEquals(t, []string{"location", "location"}, unknown)
+
+	singleResult.UnknownFieldHandler = func(err gophercloud.ErrUnknownField) error {
+		return err
+	}
+
+	err = singleResult.ExtractInto(&s)
+	th.AssertEquals(t, "Unknown field [location] in response body", err.Error())
+
+	// Structs composed of json.Unmarshalers are not checked.
+	var composed TestPersonWithExtensions
+	err = singleResult.ExtractIntoStructPtr(&composed, "person")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Bill unmarshalled", composed.Name)
+}
+
+func TestParseTimestamp(t *testing.T) {
+	expected := time.Date(2019, 3, 4, 5, 6, 7, 0, time.UTC)
+	expectedMilli := time.Date(2019, 3, 4, 5, 6, 7, 123456000, time.UTC)
+
+	for s, want := range map[string]time.Time{
+		"2019-03-04T05:06:07Z":             expected,
+		"2019-03-04T05:06:07.123456Z":      expectedMilli,
+		"2019-03-04T05:06:07+00:00":        expected,
+		"2019-03-04T07:06:07+02:00":        expected,
+		"2019-03-04T07:06:07.123456+0200":  expectedMilli,
+		"2019-03-04T05:06:07":              expected,
+		"2019-03-04T05:06:07.123456":       expectedMilli,
+		"2019-03-04 05:06:07+00:00":        expected,
+		"2019-03-04 05:06:07.123456-0000":  expectedMilli,
+		"2019-03-04 05:06:07":              expected,
+		"2019-03-04 05:06:07.123456":       expectedMilli,
+		"2019-03-04 03:06:07.123456-02:00": expectedMilli,
+	} {
+		actual, err := gophercloud.ParseTimestamp(s)
+		th.AssertNoErr(t, err)
+		if !actual.Equal(want) {
+			t.Errorf("Expected %s to be parsed as %s, got %s", s, want, actual)
+		}
+	}
+
+	_, err := gophercloud.ParseTimestamp("04/03/2019")
+	if err == nil {
+		t.Fatal("Expected an error for an unknown timestamp format")
+	}
+}
+
+func TestJSONRFC3339Lenient(t *testing.T) {
+	var s struct {
+		CreatedAt gophercloud.JSONRFC3339Lenient `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339Lenient `json:"updated_at"`
+		DeletedAt gophercloud.JSONRFC3339Lenient `json:"deleted_at"`
+	}
+
+	err := json.Unmarshal([]byte(`{
+		"created_at": "2019-03-04 05:06:07",
+		"updated_at": "2019-03-04T05:06:07.123456Z",
+		"deleted_at": null
+	}`), &s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, true, time.Time(s.CreatedAt).Equal(time.Date(2019, 3, 4, 5, 6, 7, 0, time.UTC)))
+	th.AssertEquals(t, true, time.Time(s.UpdatedAt).Equal(time.Date(2019, 3, 4, 5, 6, 7, 123456000, time.UTC)))
+	th.AssertEquals(t, true, time.Time(s.DeletedAt).IsZero())
+
+	err = json.Unmarshal([]byte(`{"created_at": "yesterday"}`), &s)
+	if err == nil {
+		t.Fatal("Expected an error for an unknown timestamp format")
+	}
+}
+
+type testServer struct {
+	ID      string    `json:"id"`
+	Created time.Time `json:"created"`
+	Ports   []int     `json:"ports"`
+}
+
+func TestExtractDecodeError(t *testing.T) {
+	var dejson interface{}
+	err := json.Unmarshal([]byte(`
+{
+	"servers": [
+		{"id": "a", "created": "2020-01-02T03:04:05Z", "ports": [22]},
+		{"id": "b", "created": 1577934245, "ports": [22]}
+	]
+}`), &dejson)
+	th.AssertNoErr(t, err)
+	result := gophercloud.Result{Body: dejson}
+
+	var s struct {
+		Servers []testServer `json:"servers"`
+	}
+	err = result.ExtractInto(&s)
+	if _, ok := err.(*json.UnmarshalTypeError); !ok {
+		t.Fatalf("Expected a *json.UnmarshalTypeError, got %T", err)
+	}
+	err = result.ExplainDecodeError(err, &s, "")
+	th.AssertEquals(t, "servers[1].created: expected RFC3339 string, got number 1577934245", err.Error())
+
+	decodeErr, ok := err.(gophercloud.ErrDecode)
+	th.AssertEquals(t, true, ok)
+	th.CheckEquals(t, "servers[1].created", decodeErr.Path)
+	if decodeErr.Unwrap() == nil {
+		t.Errorf("Expected the decoder error to be wrapped")
+	}
+
+	var servers []testServer
+	err = result.ExtractIntoSlicePtr(&servers, "servers")
+	err = result.ExplainDecodeError(err, &servers, "servers")
+	th.AssertEquals(t, "servers[1].created: expected RFC3339 string, got number 1577934245", err.Error())
+
+	result = gophercloud.Result{Body: map[string]interface{}{
+		"server": map[string]interface{}{"id": 1},
+	}}
+	var server testServer
+	err = result.ExtractIntoStructPtr(&server, "server")
+	err = result.ExplainDecodeError(err, &server, "server")
+	th.AssertEquals(t, "server.id: expected string, got number 1", err.Error())
+
+	result = gophercloud.Result{Body: map[string]interface{}{
+		"server": map[string]interface{}{"ports": []interface{}{22, "ssh"}},
+	}}
+	err = result.ExtractIntoStructPtr(&server, "server")
+	err = result.ExplainDecodeError(err, &server, "server")
+	th.AssertEquals(t, "server.ports[1]: expected int, got string \"ssh\"", err.Error())
+}
+
+func TestExplainDecodeErrorStreamedBody(t *testing.T) {
+	result := gophercloud.Result{Body: ioutil.NopCloser(strings.NewReader(`{"id": 1}`))}
+
+	var server testServer
+	err := result.ExtractInto(&server)
+	if _, ok := err.(*json.UnmarshalTypeError); !ok {
+		t.Fatalf("Expected a *json.UnmarshalTypeError, got %T", err)
+	}
+	// The streamed body is not kept, so the error cannot be explained.
+	if _, ok := result.ExplainDecodeError(err, &server, "").(*json.UnmarshalTypeError); !ok {
+		t.Fatalf("Expected the error to be returned unchanged")
+	}
+}
+
+func TestExtractDecodeErrorCustomUnmarshaler(t *testing.T) {
+	var dejson interface{}
+	err := json.Unmarshal([]byte(`{"people": [{"name": "Bill"}, {"name": ["Ted"]}]}`), &dejson)
+	th.AssertNoErr(t, err)
+	result := gophercloud.Result{Body: dejson}
+
+	var people []TestPersonWithExtensions
+	err = result.ExtractIntoSlicePtr(&people, "people")
+	err = result.ExplainDecodeError(err, &people, "people")
+	// The name is decoded by UnmarshalJSON only, so the error points at the
+	// person.
+	th.AssertEquals(t, "people[1]: expected testing.TestPerson, got object", err.Error())
+}
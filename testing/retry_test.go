@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func throttlingServer(throttled int, status int, retryAfter string) (*httptest.Server, *int) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= throttled {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	return ts, &calls
+}
+
+func TestRetryThrottled(t *testing.T) {
+	ts, calls := throttlingServer(2, http.StatusTooManyRequests, "0")
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		Retry: &gophercloud.RetryOpts{MaxRetries: 3},
+	}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, *calls)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	ts, calls := throttlingServer(2, http.StatusTooManyRequests, "")
+	defer ts.Close()
+
+	var retries []int
+	p := &gophercloud.ProviderClient{
+		Retry: &gophercloud.RetryOpts{
+			MaxRetries: 3,
+			Backoff: func(retry int) time.Duration {
+				retries = append(retries, retry)
+				return time.Millisecond
+			},
+		},
+	}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, *calls)
+	th.AssertDeepEquals(t, []int{1, 2}, retries)
+}
+
+func TestRetryExhausted(t *testing.T) {
+	ts, calls := throttlingServer(3, http.StatusTooManyRequests, "0")
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		Retry: &gophercloud.RetryOpts{MaxRetries: 1},
+	}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault429); !ok {
+		t.Fatalf("Expected ErrDefault429, got %T: %v", err, err)
+	}
+	th.AssertEquals(t, 2, *calls)
+}
+
+func TestRetryRequestEntityTooLarge(t *testing.T) {
+	// A date in the past means no delay.
+	ts, calls := throttlingServer(1, http.StatusRequestEntityTooLarge, "Wed, 21 Oct 2015 07:28:00 GMT")
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		Retry: &gophercloud.RetryOpts{MaxRetries: 1},
+	}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, *calls)
+
+	// Without Retry-After, a 413 is a genuine error.
+	ts2, calls2 := throttlingServer(1, http.StatusRequestEntityTooLarge, "")
+	defer ts2.Close()
+
+	_, err = p.Request("GET", ts2.URL, &gophercloud.RequestOpts{})
+	if err == nil {
+		t.Fatal("Expected an error for a 413 without Retry-After")
+	}
+	th.AssertEquals(t, 1, *calls2)
+}
+
+// failingSeeker is a request body which cannot be rewound.
+type failingSeeker struct {
+	*strings.Reader
+}
+
+func (failingSeeker) Seek(offset int64, whence int) (int64, error) {
+	return 0, errors.New("seek failed")
+}
+
+func TestRetryRewindFails(t *testing.T) {
+	ts, calls := throttlingServer(1, http.StatusTooManyRequests, "0")
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		Retry: &gophercloud.RetryOpts{MaxRetries: 1},
+	}
+
+	_, err := p.Request("PUT", ts.URL, &gophercloud.RequestOpts{
+		RawBody: failingSeeker{strings.NewReader("data")},
+		OkCodes: []int{200},
+	})
+	if _, ok := err.(gophercloud.ErrDefault429); !ok {
+		t.Fatalf("Expected ErrDefault429, got %T: %v", err, err)
+	}
+	th.AssertEquals(t, 1, *calls)
+}
+
+func TestRetryMaxDelay(t *testing.T) {
+	ts, calls := throttlingServer(1, http.StatusTooManyRequests, "3600")
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		Retry: &gophercloud.RetryOpts{MaxRetries: 1, MaxDelay: time.Millisecond},
+	}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, *calls)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := gophercloud.ExponentialBackoff(time.Second, 5*time.Second)
+
+	th.AssertEquals(t, time.Second, backoff(1))
+	th.AssertEquals(t, 2*time.Second, backoff(2))
+	th.AssertEquals(t, 4*time.Second, backoff(3))
+	th.AssertEquals(t, 5*time.Second, backoff(4))
+}
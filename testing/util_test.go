@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
@@ -53,6 +54,81 @@ func TestWaitForPredicateExceed(t *testing.T) {
 	th.AssertEquals(t, "A timeout occurred", err.Error())
 }
 
+func TestWaitForWithOptsBackoff(t *testing.T) {
+	var calls []time.Time
+	err := gophercloud.WaitForWithOpts(gophercloud.WaitForOpts{
+		Interval:    10 * time.Millisecond,
+		Backoff:     2,
+		MaxInterval: 40 * time.Millisecond,
+	}, func() (bool, error) {
+		calls = append(calls, time.Now())
+		return len(calls) == 5, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 5, len(calls))
+
+	// The intervals are 10, 20, 40, then capped at 40 milliseconds.
+	th.AssertEquals(t, true, calls[2].Sub(calls[1]) >= 20*time.Millisecond)
+	th.AssertEquals(t, true, calls[4].Sub(calls[3]) >= 40*time.Millisecond)
+}
+
+func TestWaitForWithOptsTransientError(t *testing.T) {
+	transient := errors.New("Service unavailable")
+
+	count := 0
+	err := gophercloud.WaitForWithOpts(gophercloud.WaitForOpts{
+		Interval: time.Millisecond,
+		IsTransient: func(err error) bool {
+			return err == transient
+		},
+	}, func() (bool, error) {
+		count++
+		if count < 3 {
+			return false, transient
+		}
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, count)
+
+	err = gophercloud.WaitForWithOpts(gophercloud.WaitForOpts{
+		Interval: time.Millisecond,
+		IsTransient: func(err error) bool {
+			return err == transient
+		},
+	}, func() (bool, error) {
+		return false, errors.New("Error has occurred")
+	})
+	th.AssertEquals(t, "Error has occurred", err.Error())
+}
+
+func TestWaitForWithOptsTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := gophercloud.WaitForWithOpts(gophercloud.WaitForOpts{
+		Context:  ctx,
+		Interval: 10 * time.Millisecond,
+	}, func() (bool, error) {
+		return false, nil
+	})
+	_, ok := err.(gophercloud.ErrTimeOut)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "A timeout occurred", err.Error())
+}
+
+func TestWaitForWithOptsCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := gophercloud.WaitForWithOpts(gophercloud.WaitForOpts{
+		Context: ctx,
+	}, func() (bool, error) {
+		return true, nil
+	})
+	th.AssertEquals(t, context.Canceled, err)
+}
+
 func TestNormalizeURL(t *testing.T) {
 	urls := []string{
 		"NoSlashAtEnd",
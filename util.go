@@ -1,7 +1,8 @@
 package gophercloud
 
 import (
-	"fmt"
+	"context"
+	"net/http"
 	"net/url"
 	"path/filepath"
 	"strings"
@@ -14,46 +15,109 @@ import (
 // predicate will be prematurely cancelled after the timeout.
 // Resource packages will wrap this in a more convenient function that's
 // specific to a certain resource, but it can also be useful on its own.
+// A negative timeout waits until the predicate is satisfied or fails.
 func WaitFor(timeout int, predicate func() (bool, error)) error {
+	ctx := context.Background()
+	if timeout >= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+	}
+
+	return WaitForWithOpts(WaitForOpts{Context: ctx}, predicate)
+}
+
+// WaitForOpts configures the polling done by WaitForWithOpts.
+type WaitForOpts struct {
+	// Context bounds the wait: polling stops once it is done. Use
+	// context.WithTimeout to set a deadline. Defaults to
+	// context.Background.
+	Context context.Context
+
+	// Interval is the delay before the first call of the predicate and
+	// between two calls. Defaults to one second.
+	Interval time.Duration
+
+	// Backoff multiplies the interval after every call which did not
+	// satisfy the predicate, e.g. 2 for an exponential backoff. Values
+	// lower than or equal to 1 keep the interval constant.
+	Backoff float64
+
+	// MaxInterval caps the interval grown by Backoff. Zero means no cap.
+	MaxInterval time.Duration
+
+	// IsTransient reports whether an error returned by the predicate is
+	// transient, in which case polling continues. By default, any error
+	// ends the wait.
+	IsTransient func(error) bool
+}
+
+// WaitForWithOpts polls a predicate function until it is satisfied, it
+// returns a non-transient error or opts.Context is done. If the context
+// deadline is exceeded, an ErrTimeOut is returned; if the context is
+// cancelled, its error is returned. As with WaitFor, a predicate still
+// running when the context is done is abandoned.
+func WaitForWithOpts(opts WaitForOpts, predicate func() (bool, error)) error {
 	type WaitForResult struct {
 		Success bool
 		Error   error
 	}
 
-	start := time.Now().Unix()
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
 
 	for {
-		// If a timeout is set, and that's been exceeded, shut it down.
-		if timeout >= 0 && time.Now().Unix()-start >= int64(timeout) {
-			return fmt.Errorf("A timeout occurred")
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return waitForError(ctx)
+		case <-timer.C:
 		}
 
-		time.Sleep(1 * time.Second)
-
-		var result WaitForResult
-		ch := make(chan bool, 1)
+		ch := make(chan WaitForResult, 1)
 		go func() {
-			defer close(ch)
 			satisfied, err := predicate()
-			result.Success = satisfied
-			result.Error = err
+			ch <- WaitForResult{Success: satisfied, Error: err}
 		}()
 
 		select {
-		case <-ch:
+		case result := <-ch:
 			if result.Error != nil {
-				return result.Error
-			}
-			if result.Success {
+				if opts.IsTransient == nil || !opts.IsTransient(result.Error) {
+					return result.Error
+				}
+			} else if result.Success {
 				return nil
 			}
-		// If the predicate has not finished by the timeout, cancel it.
-		case <-time.After(time.Duration(timeout) * time.Second):
-			return fmt.Errorf("A timeout occurred")
+		// If the predicate has not finished when the context is done, cancel it.
+		case <-ctx.Done():
+			return waitForError(ctx)
+		}
+
+		if opts.Backoff > 1 {
+			interval = time.Duration(float64(interval) * opts.Backoff)
+			if opts.MaxInterval > 0 && interval > opts.MaxInterval {
+				interval = opts.MaxInterval
+			}
 		}
 	}
 }
 
+func waitForError(ctx context.Context) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return ErrTimeOut{BaseError{Info: "A timeout occurred"}}
+	}
+	return ctx.Err()
+}
+
 // NormalizeURL is an internal function to be used by provider clients.
 //
 // It ensures that each endpoint URL has a closing `/`, as expected by
@@ -100,3 +164,16 @@ func NormalizePathURL(basePath, rawPath string) (string, error) {
 	return u.String(), nil
 
 }
+
+// cloneHeader returns a deep copy of h, or nil if h is nil. It replaces
+// http.Header.Clone, which needs Go 1.13.
+func cloneHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	h2 := make(http.Header, len(h))
+	for k, v := range h {
+		h2[k] = append([]string(nil), v...)
+	}
+	return h2
+}